@@ -0,0 +1,92 @@
+// package main implements a stub rate limit service that allows a fixed number of requests per second for each descriptor
+// sent by the proxies, used to test global rate limiting.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	xds_ratelimit "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"google.golang.org/grpc"
+
+	"github.com/openservicemesh/osm/pkg/logger"
+)
+
+var (
+	log               = logger.NewPretty("ratelimit-server")
+	logLevel          = flag.String("logLevel", "debug", "Log output level")
+	port              = flag.Int("port", 8081, "port on which this app is serving gRPC rate limit requests")
+	requestsPerSecond = flag.Uint("requestsPerSecond", 10, "number of requests allowed per second for each descriptor")
+)
+
+// rateLimitServer implements the rate limit service using a fixed one second window for each descriptor
+type rateLimitServer struct {
+	sync.Mutex
+	window time.Time
+	hits   map[string]uint32
+}
+
+// ShouldRateLimit returns whether the given request is over the limit of any of its descriptors
+func (s *rateLimitServer) ShouldRateLimit(_ context.Context, req *xds_ratelimit.RateLimitRequest) (*xds_ratelimit.RateLimitResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Reset the hits when a new window starts
+	if now := time.Now().Truncate(time.Second); now != s.window {
+		s.window = now
+		s.hits = make(map[string]uint32)
+	}
+
+	hitsAddend := req.GetHitsAddend()
+	if hitsAddend == 0 {
+		hitsAddend = 1
+	}
+
+	response := &xds_ratelimit.RateLimitResponse{OverallCode: xds_ratelimit.RateLimitResponse_OK}
+	for _, descriptor := range req.GetDescriptors() {
+		var entries []string
+		for _, entry := range descriptor.GetEntries() {
+			entries = append(entries, fmt.Sprintf("%s=%s", entry.GetKey(), entry.GetValue()))
+		}
+		key := fmt.Sprintf("%s|%s", req.GetDomain(), strings.Join(entries, ","))
+
+		s.hits[key] += hitsAddend
+		code := xds_ratelimit.RateLimitResponse_OK
+		if s.hits[key] > uint32(*requestsPerSecond) {
+			code = xds_ratelimit.RateLimitResponse_OVER_LIMIT
+			response.OverallCode = xds_ratelimit.RateLimitResponse_OVER_LIMIT
+		}
+		log.Debug().Msgf("Descriptor %s: %d hits, %s", key, s.hits[key], code)
+
+		response.Statuses = append(response.Statuses, &xds_ratelimit.RateLimitResponse_DescriptorStatus{Code: code})
+	}
+
+	return response, nil
+}
+
+func main() {
+	flag.Parse()
+	err := logger.SetLogLevel(*logLevel)
+	if err != nil {
+		log.Fatal().Msgf("Unknown log level: %s", *logLevel)
+	}
+
+	listenAddr := fmt.Sprintf(":%d", *port)
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatal().Err(err).Msgf("Error creating gRPC listener on address %q", listenAddr)
+	}
+
+	grpcServer := grpc.NewServer()
+	xds_ratelimit.RegisterRateLimitServiceServer(grpcServer, &rateLimitServer{})
+
+	log.Info().Msgf("Server listening on address %q", listenAddr)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatal().Err(err).Msg("Error serving gRPC rate limit requests")
+	}
+}
@@ -6,7 +6,7 @@ set -aueo pipefail
 source .env
 
 kubectl apply -f - <<EOF
-apiVersion: split.smi-spec.io/v1alpha2
+apiVersion: split.smi-spec.io/v1alpha4
 kind: TrafficSplit
 metadata:
   name: bookstore-split
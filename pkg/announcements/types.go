@@ -159,6 +159,127 @@ const (
 
 	// ---
 
+	// RetryPolicyAdded is the type of announcement emitted when we observe an addition of retries.policy.openservicemesh.io
+	RetryPolicyAdded AnnouncementType = "retry-added"
+
+	// RetryPolicyDeleted the type of announcement emitted when we observe a deletion of retries.policy.openservicemesh.io
+	RetryPolicyDeleted AnnouncementType = "retry-deleted"
+
+	// RetryPolicyUpdated is the type of announcement emitted when we observe an update to retries.policy.openservicemesh.io
+	RetryPolicyUpdated AnnouncementType = "retry-updated"
+
+	// ---
+
+	// UpstreamTrafficSettingAdded is the type of announcement emitted when we observe an addition of upstreamtrafficsettings.policy.openservicemesh.io
+	UpstreamTrafficSettingAdded AnnouncementType = "upstreamtrafficsetting-added"
+
+	// UpstreamTrafficSettingDeleted the type of announcement emitted when we observe a deletion of upstreamtrafficsettings.policy.openservicemesh.io
+	UpstreamTrafficSettingDeleted AnnouncementType = "upstreamtrafficsetting-deleted"
+
+	// UpstreamTrafficSettingUpdated is the type of announcement emitted when we observe an update to upstreamtrafficsettings.policy.openservicemesh.io
+	UpstreamTrafficSettingUpdated AnnouncementType = "upstreamtrafficsetting-updated"
+
+	// ---
+
+	// RateLimitPolicyAdded is the type of announcement emitted when we observe an addition of ratelimits.policy.openservicemesh.io
+	RateLimitPolicyAdded AnnouncementType = "ratelimit-added"
+
+	// RateLimitPolicyDeleted the type of announcement emitted when we observe a deletion of ratelimits.policy.openservicemesh.io
+	RateLimitPolicyDeleted AnnouncementType = "ratelimit-deleted"
+
+	// RateLimitPolicyUpdated is the type of announcement emitted when we observe an update to ratelimits.policy.openservicemesh.io
+	RateLimitPolicyUpdated AnnouncementType = "ratelimit-updated"
+
+	// ---
+
+	// FaultInjectionPolicyAdded is the type of announcement emitted when we observe an addition of faultinjections.policy.openservicemesh.io
+	FaultInjectionPolicyAdded AnnouncementType = "faultinjection-added"
+
+	// FaultInjectionPolicyDeleted the type of announcement emitted when we observe a deletion of faultinjections.policy.openservicemesh.io
+	FaultInjectionPolicyDeleted AnnouncementType = "faultinjection-deleted"
+
+	// FaultInjectionPolicyUpdated is the type of announcement emitted when we observe an update to faultinjections.policy.openservicemesh.io
+	FaultInjectionPolicyUpdated AnnouncementType = "faultinjection-updated"
+
+	// ---
+
+	// HeaderModifierPolicyAdded is the type of announcement emitted when we observe an addition of headermodifiers.policy.openservicemesh.io
+	HeaderModifierPolicyAdded AnnouncementType = "headermodifier-added"
+
+	// HeaderModifierPolicyDeleted the type of announcement emitted when we observe a deletion of headermodifiers.policy.openservicemesh.io
+	HeaderModifierPolicyDeleted AnnouncementType = "headermodifier-deleted"
+
+	// HeaderModifierPolicyUpdated is the type of announcement emitted when we observe an update to headermodifiers.policy.openservicemesh.io
+	HeaderModifierPolicyUpdated AnnouncementType = "headermodifier-updated"
+
+	// ---
+
+	// TrafficMirrorPolicyAdded is the type of announcement emitted when we observe an addition of trafficmirrors.policy.openservicemesh.io
+	TrafficMirrorPolicyAdded AnnouncementType = "trafficmirror-added"
+
+	// TrafficMirrorPolicyDeleted the type of announcement emitted when we observe a deletion of trafficmirrors.policy.openservicemesh.io
+	TrafficMirrorPolicyDeleted AnnouncementType = "trafficmirror-deleted"
+
+	// TrafficMirrorPolicyUpdated is the type of announcement emitted when we observe an update to trafficmirrors.policy.openservicemesh.io
+	TrafficMirrorPolicyUpdated AnnouncementType = "trafficmirror-updated"
+
+	// ---
+
+	// GRPCRouteGroupAdded is the type of announcement emitted when we observe an addition of grpcroutegroups.policy.openservicemesh.io
+	GRPCRouteGroupAdded AnnouncementType = "grpcroutegroup-added"
+
+	// GRPCRouteGroupDeleted the type of announcement emitted when we observe a deletion of grpcroutegroups.policy.openservicemesh.io
+	GRPCRouteGroupDeleted AnnouncementType = "grpcroutegroup-deleted"
+
+	// GRPCRouteGroupUpdated is the type of announcement emitted when we observe an update to grpcroutegroups.policy.openservicemesh.io
+	GRPCRouteGroupUpdated AnnouncementType = "grpcroutegroup-updated"
+
+	// ---
+
+	// CORSPolicyAdded is the type of announcement emitted when we observe an addition of corspolicies.policy.openservicemesh.io
+	CORSPolicyAdded AnnouncementType = "corspolicy-added"
+
+	// CORSPolicyDeleted the type of announcement emitted when we observe a deletion of corspolicies.policy.openservicemesh.io
+	CORSPolicyDeleted AnnouncementType = "corspolicy-deleted"
+
+	// CORSPolicyUpdated is the type of announcement emitted when we observe an update to corspolicies.policy.openservicemesh.io
+	CORSPolicyUpdated AnnouncementType = "corspolicy-updated"
+
+	// ---
+
+	// RequestAuthenticationAdded is the type of announcement emitted when we observe an addition of requestauthentications.policy.openservicemesh.io
+	RequestAuthenticationAdded AnnouncementType = "requestauthentication-added"
+
+	// RequestAuthenticationDeleted the type of announcement emitted when we observe a deletion of requestauthentications.policy.openservicemesh.io
+	RequestAuthenticationDeleted AnnouncementType = "requestauthentication-deleted"
+
+	// RequestAuthenticationUpdated is the type of announcement emitted when we observe an update to requestauthentications.policy.openservicemesh.io
+	RequestAuthenticationUpdated AnnouncementType = "requestauthentication-updated"
+
+	// ---
+
+	// AuthorizationPolicyAdded is the type of announcement emitted when we observe an addition of authorizationpolicies.policy.openservicemesh.io
+	AuthorizationPolicyAdded AnnouncementType = "authorizationpolicy-added"
+
+	// AuthorizationPolicyDeleted the type of announcement emitted when we observe a deletion of authorizationpolicies.policy.openservicemesh.io
+	AuthorizationPolicyDeleted AnnouncementType = "authorizationpolicy-deleted"
+
+	// AuthorizationPolicyUpdated is the type of announcement emitted when we observe an update to authorizationpolicies.policy.openservicemesh.io
+	AuthorizationPolicyUpdated AnnouncementType = "authorizationpolicy-updated"
+
+	// ---
+
+	// DenyPolicyAdded is the type of announcement emitted when we observe an addition of denies.policy.openservicemesh.io
+	DenyPolicyAdded AnnouncementType = "deny-added"
+
+	// DenyPolicyDeleted the type of announcement emitted when we observe a deletion of denies.policy.openservicemesh.io
+	DenyPolicyDeleted AnnouncementType = "deny-deleted"
+
+	// DenyPolicyUpdated is the type of announcement emitted when we observe an update to denies.policy.openservicemesh.io
+	DenyPolicyUpdated AnnouncementType = "deny-updated"
+
+	// ---
+
 	// MultiClusterServiceAdded is the type of announcement emitted when we observe an addition of a multiclusterservice.config.openservicemesh.io
 	MultiClusterServiceAdded AnnouncementType = "multiclusterservice-added"
 
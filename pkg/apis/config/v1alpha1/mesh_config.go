@@ -90,6 +90,17 @@ type TrafficSpec struct {
 	// InboundExternalAuthorization defines a ruleset that, if enabled, will configure a remote external authorization endpoint
 	// for all inbound and ingress traffic in the mesh.
 	InboundExternalAuthorization ExternalAuthzSpec `json:"inboundExternalAuthorization,omitempty"`
+
+	// InboundGlobalRateLimit defines a ruleset that, if enabled, will configure a remote rate limit service
+	// to rate limit all inbound and ingress HTTP traffic in the mesh.
+	InboundGlobalRateLimit GlobalRateLimitSpec `json:"inboundGlobalRateLimit,omitempty"`
+
+	// RequestTimeout defines the mesh-wide timeout for HTTP requests, applied to HTTP routes that do not
+	// specify a timeout of their own. A value of 0s disables the timeout.
+	RequestTimeout string `json:"requestTimeout,omitempty"`
+
+	// StreamIdleTimeout defines the mesh-wide idle timeout for HTTP streams. A value of 0s disables the timeout.
+	StreamIdleTimeout string `json:"streamIdleTimeout,omitempty"`
 }
 
 // ObservabilitySpec is the type to represent OSM's observability configurations.
@@ -142,6 +153,73 @@ type ExternalAuthzSpec struct {
 	FailureModeAllow bool `json:"failureModeAllow,omitempty"`
 }
 
+// GlobalRateLimitSpec is a type to represent global rate limiting configuration.
+type GlobalRateLimitSpec struct {
+	// Enable defines a boolean indicating if global rate limiting is to be enabled.
+	Enable bool `json:"enable,omitempty"`
+
+	// Address defines the remote address of the rate limit service.
+	Address string `json:"address,omitempty"`
+
+	// Port defines the destination port of the remote rate limit service.
+	Port uint16 `json:"port,omitempty"`
+
+	// Domain defines the rate limit domain sent to the rate limit service with each request.
+	Domain string `json:"domain,omitempty"`
+
+	// Timeout defines the timeout in which a response from the rate limit service is expected.
+	Timeout string `json:"timeout,omitempty"`
+
+	// FailureModeDeny defines a boolean indicating if traffic should be denied on a failure to get a
+	// response from the rate limit service.
+	FailureModeDeny bool `json:"failureModeDeny,omitempty"`
+
+	// Descriptors defines the descriptors sent to the rate limit service for each request.
+	// When unspecified, a single descriptor with a destinationCluster entry is sent.
+	Descriptors []GlobalRateLimitDescriptorSpec `json:"descriptors,omitempty"`
+}
+
+// GlobalRateLimitDescriptorSpec is a type to represent a descriptor sent to the rate limit service.
+type GlobalRateLimitDescriptorSpec struct {
+	// Entries defines the ordered list of entries that make up the descriptor.
+	Entries []GlobalRateLimitDescriptorEntrySpec `json:"entries"`
+}
+
+// GlobalRateLimitDescriptorEntryType is a type to represent the source of the value of a descriptor entry.
+type GlobalRateLimitDescriptorEntryType string
+
+const (
+	// DestinationClusterDescriptorEntry is the descriptor entry type for the destination cluster of the request
+	DestinationClusterDescriptorEntry GlobalRateLimitDescriptorEntryType = "destinationCluster"
+
+	// RemoteAddressDescriptorEntry is the descriptor entry type for the remote address of the request
+	RemoteAddressDescriptorEntry GlobalRateLimitDescriptorEntryType = "remoteAddress"
+
+	// RequestHeaderDescriptorEntry is the descriptor entry type for the value of a request header
+	RequestHeaderDescriptorEntry GlobalRateLimitDescriptorEntryType = "requestHeader"
+
+	// GenericKeyDescriptorEntry is the descriptor entry type for a static value
+	GenericKeyDescriptorEntry GlobalRateLimitDescriptorEntryType = "genericKey"
+)
+
+// GlobalRateLimitDescriptorEntrySpec is a type to represent an entry of a descriptor sent to the rate limit service.
+type GlobalRateLimitDescriptorEntrySpec struct {
+	// Type defines the source of the value of the entry, one of destinationCluster, remoteAddress, requestHeader or genericKey.
+	Type GlobalRateLimitDescriptorEntryType `json:"type"`
+
+	// Key defines the key of the entry for requestHeader and genericKey entries.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Header defines the name of the request header whose value is used for requestHeader entries.
+	// +optional
+	Header string `json:"header,omitempty"`
+
+	// Value defines the value of the entry for genericKey entries.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
 // CertificateSpec is type to reperesent OSM's certificate management configuration.
 type CertificateSpec struct {
 	// ServiceCertValidityDuration defines the service certificate validity duration.
@@ -191,4 +269,10 @@ type FeatureFlags struct {
 
 	// EnableValidatingWebhook defines if the OSM controller will create a validating webhook handler.
 	EnableValidatingWebhook bool `json:"enableValidatingWebhook,omitempty"`
+
+	// EnableRetryPolicy defines if OSM's Retry policy is enabled.
+	EnableRetryPolicy bool `json:"enableRetryPolicy,omitempty"`
+
+	// EnableLocalityAwareRouting defines if endpoints are prioritized based on their locality relative to the proxy.
+	EnableLocalityAwareRouting bool `json:"enableLocalityAwareRouting,omitempty"`
 }
@@ -102,6 +102,66 @@ func (in *FeatureFlags) DeepCopy() *FeatureFlags {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimitDescriptorEntrySpec) DeepCopyInto(out *GlobalRateLimitDescriptorEntrySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimitDescriptorEntrySpec.
+func (in *GlobalRateLimitDescriptorEntrySpec) DeepCopy() *GlobalRateLimitDescriptorEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimitDescriptorEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimitDescriptorSpec) DeepCopyInto(out *GlobalRateLimitDescriptorSpec) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]GlobalRateLimitDescriptorEntrySpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimitDescriptorSpec.
+func (in *GlobalRateLimitDescriptorSpec) DeepCopy() *GlobalRateLimitDescriptorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimitDescriptorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimitSpec) DeepCopyInto(out *GlobalRateLimitSpec) {
+	*out = *in
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]GlobalRateLimitDescriptorSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimitSpec.
+func (in *GlobalRateLimitSpec) DeepCopy() *GlobalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshConfig) DeepCopyInto(out *MeshConfig) {
 	*out = *in
@@ -371,6 +431,7 @@ func (in *TrafficSpec) DeepCopyInto(out *TrafficSpec) {
 		copy(*out, *in)
 	}
 	out.InboundExternalAuthorization = in.InboundExternalAuthorization
+	in.InboundGlobalRateLimit.DeepCopyInto(&out.InboundGlobalRateLimit)
 	return
 }
 
@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthorizationPolicy is the type used to represent an AuthorizationPolicy policy.
+// An AuthorizationPolicy policy defines the request attributes the HTTP requests received by a service must match
+// to be authorized, in addition to originating from a source allowed by an SMI TrafficTarget or by the permissive traffic policy mode.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AuthorizationPolicy struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the AuthorizationPolicy policy specification
+	// +optional
+	Spec AuthorizationPolicySpec `json:"spec,omitempty"`
+}
+
+// AuthorizationPolicySpec is the type used to represent the AuthorizationPolicy policy specification.
+type AuthorizationPolicySpec struct {
+	// Host defines the service the AuthorizationPolicy policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the AuthorizationPolicy resource.
+	Host string `json:"host"`
+
+	// Rules defines the authorization rules of the service.
+	// A request on a route selected by one or more rules is authorized if it matches the conditions of any of these rules.
+	// Requests on routes not selected by any rule are authorized based on their source only.
+	Rules []AuthorizationRule `json:"rules"`
+
+	// Shadow defines whether the rules are evaluated in shadow mode, in which the requests that would not be
+	// authorized are reported in the proxy stats and access logs without being denied.
+	// +optional
+	Shadow bool `json:"shadow,omitempty"`
+}
+
+// AuthorizationRule is the type used to represent an authorization rule.
+type AuthorizationRule struct {
+	// Routes defines the routes of the service the rule applies to, as HTTPRouteGroup matches.
+	// The rule applies to the requests on these routes, including those allowed by a broader route of a TrafficTarget
+	// or by the permissive traffic policy mode. If any of the routes cannot be found, all the requests to the service are
+	// denied, unless the policy is in shadow mode, in which case the rule is ignored.
+	// If unspecified, the rule applies to all the routes of the service.
+	// +optional
+	Routes []AuthorizationRouteRef `json:"routes,omitempty"`
+
+	// When defines the conditions the requests on the routes must match to be authorized.
+	When AuthorizationConditions `json:"when"`
+}
+
+// AuthorizationRouteRef is the type used to represent a reference to the matches of an HTTPRouteGroup resource.
+type AuthorizationRouteRef struct {
+	// HTTPRouteGroup defines the name of the HTTPRouteGroup resource in the namespace of the AuthorizationPolicy resource.
+	HTTPRouteGroup string `json:"httpRouteGroup"`
+
+	// Matches defines the names of the matches of the HTTPRouteGroup resource.
+	Matches []string `json:"matches"`
+}
+
+// AuthorizationConditions is the type used to represent the conditions on the attributes of a request.
+// A request matches the conditions if it matches all of the specified conditions.
+type AuthorizationConditions struct {
+	// Claims defines the conditions on the claims of the JWT of the request.
+	// The JWT must be verified by a RequestAuthentication policy for the claim conditions to be matched.
+	// +optional
+	Claims []ClaimCondition `json:"claims,omitempty"`
+
+	// SourceIPBlocks defines the IP addresses or CIDR ranges the request must originate from, ex. 10.0.0.0/16.
+	// +optional
+	SourceIPBlocks []string `json:"sourceIPBlocks,omitempty"`
+
+	// Headers defines the conditions on the HTTP headers of the request.
+	// +optional
+	Headers []HeaderCondition `json:"headers,omitempty"`
+}
+
+// ClaimCondition is the type used to represent a condition on a claim of a JWT.
+type ClaimCondition struct {
+	// Name defines the name of the top level claim of the JWT.
+	Name string `json:"name"`
+
+	// Values defines the values allowed for the claim.
+	// For claims whose value is a list, the list must contain one of the allowed values.
+	Values []string `json:"values"`
+}
+
+// HeaderCondition is the type used to represent a condition on an HTTP header.
+type HeaderCondition struct {
+	// Name defines the name of the HTTP header.
+	Name string `json:"name"`
+
+	// Values defines the values allowed for the HTTP header.
+	Values []string `json:"values"`
+}
+
+// AuthorizationPolicyList defines the list of AuthorizationPolicy objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AuthorizationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AuthorizationPolicy `json:"items"`
+}
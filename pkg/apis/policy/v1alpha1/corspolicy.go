@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CORSPolicy is the type used to represent a CORSPolicy policy.
+// A CORSPolicy policy defines the Cross-Origin Resource Sharing (CORS) settings enforced by the proxies of a service
+// for the HTTP requests received by the service.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type CORSPolicy struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the CORSPolicy policy specification
+	// +optional
+	Spec CORSPolicySpec `json:"spec,omitempty"`
+}
+
+// CORSPolicySpec is the type used to represent the CORSPolicy policy specification.
+type CORSPolicySpec struct {
+	// Host defines the service the CORSPolicy policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the CORSPolicy resource.
+	Host string `json:"host"`
+
+	// AllowOrigins defines the origins allowed to access the service, ex. https://example.com.
+	// The wildcard origin '*' allows all origins.
+	AllowOrigins []string `json:"allowOrigins"`
+
+	// AllowMethods defines the HTTP methods allowed in cross-origin requests,
+	// returned in the Access-Control-Allow-Methods header.
+	// +optional
+	AllowMethods []string `json:"allowMethods,omitempty"`
+
+	// AllowHeaders defines the HTTP headers allowed in cross-origin requests,
+	// returned in the Access-Control-Allow-Headers header.
+	// +optional
+	AllowHeaders []string `json:"allowHeaders,omitempty"`
+
+	// ExposeHeaders defines the HTTP response headers browsers are allowed to access,
+	// returned in the Access-Control-Expose-Headers header.
+	// +optional
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// MaxAge defines how long the results of a preflight request can be cached,
+	// returned in the Access-Control-Max-Age header.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// AllowCredentials defines whether cross-origin requests can include credentials,
+	// returned in the Access-Control-Allow-Credentials header.
+	// +optional
+	AllowCredentials bool `json:"allowCredentials,omitempty"`
+}
+
+// CORSPolicyList defines the list of CORSPolicy objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type CORSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CORSPolicy `json:"items"`
+}
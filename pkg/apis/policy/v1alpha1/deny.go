@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Deny is the type used to represent a Deny policy.
+// A Deny policy denies the traffic from the specified sources to the proxies of a service account,
+// even if an SMI TrafficTarget or the permissive traffic policy mode allows it.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type Deny struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the Deny policy specification
+	// +optional
+	Spec DenySpec `json:"spec,omitempty"`
+}
+
+// DenySpec is the type used to represent the Deny policy specification.
+type DenySpec struct {
+	// ServiceAccount defines the name of the service account in the namespace of the Deny resource
+	// the Deny policy applies to. If unspecified, the Deny policy applies to all the service accounts
+	// in the namespace of the Deny resource.
+	// +optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// Sources defines the list of sources the traffic is denied from.
+	// If unspecified, the traffic is denied from all sources.
+	// +optional
+	Sources []DenySourceSpec `json:"sources,omitempty"`
+
+	// Routes defines the HTTP routes the traffic is denied on, as HTTPRouteGroup matches.
+	// If unspecified, or if any of the routes cannot be found, all the traffic, including non-HTTP traffic, is denied.
+	// +optional
+	Routes []AuthorizationRouteRef `json:"routes,omitempty"`
+}
+
+// DenySourceSpec is the type used to represent a source in the list of Sources specified in a Deny policy specification.
+type DenySourceSpec struct {
+	// Kind defines the kind of the source, ServiceAccount or Namespace.
+	Kind string `json:"kind"`
+
+	// Name defines the name of the source for the given Kind.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace of the source for the ServiceAccount kind.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// DenyList defines the list of Deny objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DenyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Deny `json:"items"`
+}
@@ -56,6 +56,12 @@ type EgressSpec struct {
 	// Matches defines the list of object references the Egress policy should match on.
 	// +optional
 	Matches []corev1.TypedLocalObjectReference `json:"matches,omitempty"`
+
+	// HeaderModifier defines the header modifications applied by the proxies of the sources
+	// to the HTTP requests to the Hosts and their responses, ex. to remove internal headers.
+	// Only applies to the ports with the HTTP protocol.
+	// +optional
+	HeaderModifier *HTTPHeaderModifierSpec `json:"headerModifier,omitempty"`
 }
 
 // SourceSpec is the type used to represent the Source in the list of Sources specified in an Egress policy specification.
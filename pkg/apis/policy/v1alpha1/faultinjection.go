@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FaultInjection is the type used to represent a FaultInjection policy.
+// A FaultInjection policy injects delays and aborts into the requests directed to an upstream service,
+// and is used to test the resiliency of the clients of the service.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FaultInjection struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the FaultInjection policy specification
+	// +optional
+	Spec FaultInjectionSpec `json:"spec,omitempty"`
+}
+
+// FaultInjectionSpec is the type used to represent the FaultInjection policy specification.
+type FaultInjectionSpec struct {
+	// Host defines the upstream service the FaultInjection policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the FaultInjection resource.
+	Host string `json:"host"`
+
+	// Matches defines the list of object references the FaultInjection policy should match on.
+	// Only SMI HTTPRouteGroup resources in the same namespace as the policy are supported.
+	// If no matches are specified, faults are injected into all the HTTP requests to the upstream service.
+	// +optional
+	Matches []corev1.TypedLocalObjectReference `json:"matches,omitempty"`
+
+	// Delay defines the fixed delay injected before forwarding requests to the upstream service.
+	// +optional
+	Delay *FaultDelaySpec `json:"delay,omitempty"`
+
+	// Abort defines the error returned instead of forwarding requests to the upstream service.
+	// +optional
+	Abort *FaultAbortSpec `json:"abort,omitempty"`
+}
+
+// FaultDelaySpec is the type used to represent a fixed delay injected into requests.
+type FaultDelaySpec struct {
+	// Duration defines the fixed delay added to the requests.
+	Duration metav1.Duration `json:"duration"`
+
+	// Percentage defines the percentage of requests the delay is injected into, between 0 and 100.
+	Percentage uint32 `json:"percentage"`
+}
+
+// FaultAbortSpec is the type used to represent an abort injected into requests.
+// Exactly one of HTTPStatus or GRPCStatus must be specified.
+type FaultAbortSpec struct {
+	// HTTPStatus defines the HTTP status code returned for the aborted requests.
+	// +optional
+	HTTPStatus uint32 `json:"httpStatus,omitempty"`
+
+	// GRPCStatus defines the gRPC status code returned for the aborted gRPC requests.
+	// +optional
+	GRPCStatus *uint32 `json:"grpcStatus,omitempty"`
+
+	// Percentage defines the percentage of requests that are aborted, between 0 and 100.
+	Percentage uint32 `json:"percentage"`
+}
+
+// FaultInjectionList defines the list of FaultInjection objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FaultInjectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []FaultInjection `json:"items"`
+}
@@ -0,0 +1,59 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GRPCRouteGroup is the type used to represent a GRPCRouteGroup resource.
+// A GRPCRouteGroup defines matches on gRPC services and methods that can be referenced by the rules of
+// an SMI TrafficTarget and the matches of an SMI TrafficSplit, similar to an SMI HTTPRouteGroup.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GRPCRouteGroup struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the GRPCRouteGroup specification
+	// +optional
+	Spec GRPCRouteGroupSpec `json:"spec,omitempty"`
+}
+
+// GRPCRouteGroupSpec is the type used to represent the GRPCRouteGroup specification.
+type GRPCRouteGroupSpec struct {
+	// Matches defines the list of gRPC matches in the GRPCRouteGroup.
+	Matches []GRPCMatch `json:"matches"`
+}
+
+// GRPCMatch is the type used to represent a match on gRPC requests.
+type GRPCMatch struct {
+	// Name defines the name of the match, referenced by the rules of an SMI TrafficTarget.
+	Name string `json:"name"`
+
+	// Service defines the fully qualified name of the gRPC service, ex. 'helloworld.Greeter'.
+	// All services are matched when not specified.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// Method defines the name of the gRPC method of the service, ex. 'SayHello'.
+	// All methods of the service are matched when not specified.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Headers defines the request metadata to match, as a map of header names to regexes matching their values.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// GRPCRouteGroupList defines the list of GRPCRouteGroup objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GRPCRouteGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []GRPCRouteGroup `json:"items"`
+}
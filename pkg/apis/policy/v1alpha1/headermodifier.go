@@ -0,0 +1,107 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HeaderModifier is the type used to represent a HeaderModifier policy.
+// A HeaderModifier policy adds, sets and removes the headers of the HTTP requests to a service and of their responses.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type HeaderModifier struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the HeaderModifier policy specification
+	// +optional
+	Spec HeaderModifierSpec `json:"spec,omitempty"`
+}
+
+// HeaderModifierSpec is the type used to represent the HeaderModifier policy specification.
+type HeaderModifierSpec struct {
+	// Host defines the service the HeaderModifier policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the HeaderModifier resource.
+	Host string `json:"host"`
+
+	// Inbound defines the header modifications applied by the proxies of the service
+	// to all the HTTP requests received by the service and their responses.
+	// +optional
+	Inbound *HTTPHeaderModifierSpec `json:"inbound,omitempty"`
+
+	// Outbound defines the header modifications applied by the proxies of the clients of the service
+	// to all the HTTP requests sent to the service and their responses.
+	// +optional
+	Outbound *HTTPHeaderModifierSpec `json:"outbound,omitempty"`
+
+	// HTTPRoutes defines the header modifications applied to HTTP requests matching specific routes
+	// of the service and their responses, in addition to the header modifications defined by Inbound and Outbound.
+	// +optional
+	HTTPRoutes []HTTPRouteHeaderModifierSpec `json:"httpRoutes,omitempty"`
+}
+
+// HTTPRouteHeaderModifierSpec is the type used to represent the header modifications for HTTP requests
+// matching a route of the service.
+type HTTPRouteHeaderModifierSpec struct {
+	// Path defines the path regex of the route the header modifications apply to, and must match the path regex
+	// of an HTTPRouteGroup match that applies to the service.
+	Path string `json:"path"`
+
+	// Inbound defines the header modifications applied by the proxies of the service to the route.
+	// +optional
+	Inbound *HTTPHeaderModifierSpec `json:"inbound,omitempty"`
+
+	// Outbound defines the header modifications applied by the proxies of the clients of the service to the route.
+	// +optional
+	Outbound *HTTPHeaderModifierSpec `json:"outbound,omitempty"`
+}
+
+// HTTPHeaderModifierSpec is the type used to represent the modifications of the headers of HTTP requests and responses.
+type HTTPHeaderModifierSpec struct {
+	// RequestHeadersToAdd defines the headers appended to the requests, preserving existing values.
+	// +optional
+	RequestHeadersToAdd []HTTPHeaderSpec `json:"requestHeadersToAdd,omitempty"`
+
+	// RequestHeadersToSet defines the headers set on the requests, overwriting existing values.
+	// +optional
+	RequestHeadersToSet []HTTPHeaderSpec `json:"requestHeadersToSet,omitempty"`
+
+	// RequestHeadersToRemove defines the names of the headers removed from the requests.
+	// +optional
+	RequestHeadersToRemove []string `json:"requestHeadersToRemove,omitempty"`
+
+	// ResponseHeadersToAdd defines the headers appended to the responses, preserving existing values.
+	// +optional
+	ResponseHeadersToAdd []HTTPHeaderSpec `json:"responseHeadersToAdd,omitempty"`
+
+	// ResponseHeadersToSet defines the headers set on the responses, overwriting existing values.
+	// +optional
+	ResponseHeadersToSet []HTTPHeaderSpec `json:"responseHeadersToSet,omitempty"`
+
+	// ResponseHeadersToRemove defines the names of the headers removed from the responses.
+	// +optional
+	ResponseHeadersToRemove []string `json:"responseHeadersToRemove,omitempty"`
+}
+
+// HTTPHeaderSpec is the type used to represent an HTTP header.
+type HTTPHeaderSpec struct {
+	// Name defines the name of the header.
+	Name string `json:"name"`
+
+	// Value defines the value of the header.
+	Value string `json:"value"`
+}
+
+// HeaderModifierList defines the list of HeaderModifier objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type HeaderModifierList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []HeaderModifier `json:"items"`
+}
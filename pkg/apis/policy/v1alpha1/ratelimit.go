@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RateLimit is the type used to represent a RateLimit policy.
+// A RateLimit policy limits the rate of inbound connections and requests to a service.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RateLimit struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the RateLimit policy specification
+	// +optional
+	Spec RateLimitSpec `json:"spec,omitempty"`
+}
+
+// RateLimitSpec is the type used to represent the RateLimit policy specification.
+type RateLimitSpec struct {
+	// Host defines the service the RateLimit policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the RateLimit resource.
+	Host string `json:"host"`
+
+	// Local defines the local rate limits enforced independently by each proxy of the service.
+	// +optional
+	Local *LocalRateLimitSpec `json:"local,omitempty"`
+}
+
+// LocalRateLimitSpec is the type used to represent the local rate limits for a service.
+type LocalRateLimitSpec struct {
+	// TCP defines the rate limit applied to TCP connections to the service.
+	// +optional
+	TCP *TCPLocalRateLimitSpec `json:"tcp,omitempty"`
+
+	// HTTP defines the rate limit applied to all HTTP requests to the service.
+	// +optional
+	HTTP *HTTPLocalRateLimitSpec `json:"http,omitempty"`
+
+	// HTTPRoutes defines the rate limits applied to HTTP requests matching specific routes of the service,
+	// overriding the rate limit defined by HTTP.
+	// +optional
+	HTTPRoutes []HTTPRouteLocalRateLimitSpec `json:"httpRoutes,omitempty"`
+}
+
+// TCPLocalRateLimitSpec is the type used to represent the local rate limit for TCP connections.
+type TCPLocalRateLimitSpec struct {
+	// Connections defines the number of connections allowed per time unit.
+	Connections uint32 `json:"connections"`
+
+	// Unit defines the time unit the connections are allowed in, one of second, minute or hour.
+	Unit string `json:"unit"`
+
+	// Burst defines the number of connections allowed in excess of Connections for short bursts of traffic.
+	// +optional
+	Burst uint32 `json:"burst,omitempty"`
+}
+
+// HTTPLocalRateLimitSpec is the type used to represent the local rate limit for HTTP requests.
+type HTTPLocalRateLimitSpec struct {
+	// Requests defines the number of requests allowed per time unit.
+	Requests uint32 `json:"requests"`
+
+	// Unit defines the time unit the requests are allowed in, one of second, minute or hour.
+	Unit string `json:"unit"`
+
+	// Burst defines the number of requests allowed in excess of Requests for short bursts of traffic.
+	// +optional
+	Burst uint32 `json:"burst,omitempty"`
+
+	// ResponseStatusCode defines the HTTP status code returned for rate limited requests, defaults to 429.
+	// +optional
+	ResponseStatusCode uint32 `json:"responseStatusCode,omitempty"`
+}
+
+// HTTPRouteLocalRateLimitSpec is the type used to represent the local rate limit for HTTP requests
+// matching a route of the service.
+type HTTPRouteLocalRateLimitSpec struct {
+	// Path defines the path regex of the route the rate limit applies to, and must match the path regex
+	// of an HTTPRouteGroup match that applies to the service.
+	Path string `json:"path"`
+
+	// RateLimit defines the rate limit applied to HTTP requests matching the route.
+	RateLimit HTTPLocalRateLimitSpec `json:"rateLimit"`
+}
+
+// RateLimitList defines the list of RateLimit objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RateLimitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RateLimit `json:"items"`
+}
@@ -39,6 +39,28 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Egress{},
 		&EgressList{},
+		&Retry{},
+		&RetryList{},
+		&UpstreamTrafficSetting{},
+		&UpstreamTrafficSettingList{},
+		&RateLimit{},
+		&RateLimitList{},
+		&FaultInjection{},
+		&FaultInjectionList{},
+		&HeaderModifier{},
+		&HeaderModifierList{},
+		&TrafficMirror{},
+		&TrafficMirrorList{},
+		&GRPCRouteGroup{},
+		&GRPCRouteGroupList{},
+		&CORSPolicy{},
+		&CORSPolicyList{},
+		&RequestAuthentication{},
+		&RequestAuthenticationList{},
+		&AuthorizationPolicy{},
+		&AuthorizationPolicyList{},
+		&Deny{},
+		&DenyList{},
 	)
 
 	metav1.AddToGroupVersion(
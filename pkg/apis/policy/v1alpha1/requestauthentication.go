@@ -0,0 +1,111 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RequestAuthentication is the type used to represent a RequestAuthentication policy.
+// A RequestAuthentication policy defines how the proxies of a service authenticate the JSON Web Tokens (JWT)
+// presented by the HTTP requests received by the service.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RequestAuthentication struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the RequestAuthentication policy specification
+	// +optional
+	Spec RequestAuthenticationSpec `json:"spec,omitempty"`
+}
+
+// RequestAuthenticationSpec is the type used to represent the RequestAuthentication policy specification.
+type RequestAuthenticationSpec struct {
+	// Host defines the service the RequestAuthentication policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the RequestAuthentication resource.
+	Host string `json:"host"`
+
+	// JWTRules defines the JWT issuers accepted by the service.
+	// A request presenting a JWT must be authenticated by one of the rules.
+	JWTRules []JWTRule `json:"jwtRules"`
+
+	// AllowMissing defines whether requests without a JWT are allowed.
+	// Requests presenting an invalid JWT are always rejected.
+	// +optional
+	AllowMissing bool `json:"allowMissing,omitempty"`
+}
+
+// JWTRule is the type used to represent the rule to authenticate the JWTs of an issuer.
+type JWTRule struct {
+	// Issuer defines the issuer of the JWT, matched against the 'iss' claim of the JWT.
+	Issuer string `json:"issuer"`
+
+	// Audiences defines the audiences allowed to access the service, matched against the 'aud' claim of the JWT.
+	// If unspecified, the audience of the JWT is not verified.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// JWKS defines the JSON Web Key Set used to verify the signature of the JWT, as an inline JSON document.
+	// Only one of JWKS or JWKSURI must be specified.
+	// +optional
+	JWKS string `json:"jwks,omitempty"`
+
+	// JWKSURI defines the http URL of the JSON Web Key Set used to verify the signature of the JWT.
+	// The host of the URL must either be a meshed service, formatted as <service>.<namespace>[.svc[.cluster.local]],
+	// or an external host reachable over HTTP through an Egress policy.
+	// https URLs are not supported: a JSON Web Key Set only served over https must be specified inline with JWKS.
+	// Only one of JWKS or JWKSURI must be specified.
+	// +optional
+	JWKSURI string `json:"jwksUri,omitempty"`
+
+	// FromHeaders defines the HTTP headers the JWT is extracted from.
+	// If FromHeaders and FromParams are unspecified, the JWT is extracted from the 'Authorization' header
+	// with the 'Bearer ' prefix and from the 'access_token' query parameter.
+	// +optional
+	FromHeaders []JWTHeader `json:"fromHeaders,omitempty"`
+
+	// FromParams defines the query parameters the JWT is extracted from.
+	// +optional
+	FromParams []string `json:"fromParams,omitempty"`
+
+	// ForwardOriginalToken defines whether the JWT is forwarded to the service.
+	// +optional
+	ForwardOriginalToken bool `json:"forwardOriginalToken,omitempty"`
+
+	// OutputClaimToHeaders defines the claims of the JWT forwarded to the service as HTTP headers.
+	// +optional
+	OutputClaimToHeaders []ClaimToHeader `json:"outputClaimToHeaders,omitempty"`
+}
+
+// JWTHeader is the type used to represent an HTTP header the JWT is extracted from.
+type JWTHeader struct {
+	// Name defines the name of the HTTP header.
+	Name string `json:"name"`
+
+	// Prefix defines the prefix preceding the JWT in the value of the HTTP header, ex. 'Bearer '.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// ClaimToHeader is the type used to represent a claim of the JWT forwarded as an HTTP header.
+type ClaimToHeader struct {
+	// Header defines the name of the HTTP header set to the value of the claim.
+	Header string `json:"header"`
+
+	// Claim defines the name of the top level claim of the JWT.
+	Claim string `json:"claim"`
+}
+
+// RequestAuthenticationList defines the list of RequestAuthentication objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RequestAuthenticationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RequestAuthentication `json:"items"`
+}
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficMirror is the type used to represent a TrafficMirror policy.
+// A TrafficMirror policy mirrors a percentage of the HTTP requests directed to a root service to a backend service.
+// The mirrored requests are fire-and-forget: the responses of the backend service are discarded, so that a new
+// version of a service can be tested with live traffic without affecting the responses returned to the clients.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TrafficMirror struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the TrafficMirror policy specification
+	// +optional
+	Spec TrafficMirrorSpec `json:"spec,omitempty"`
+}
+
+// TrafficMirrorSpec is the type used to represent the TrafficMirror policy specification.
+type TrafficMirrorSpec struct {
+	// Host defines the root service whose requests are mirrored.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the TrafficMirror resource.
+	Host string `json:"host"`
+
+	// Backend defines the name of the service the requests are mirrored to, in the namespace of the TrafficMirror resource.
+	// Clients of the root service must be allowed to access the backend service by the mesh's access policies.
+	Backend string `json:"backend"`
+
+	// Percentage defines the percentage of requests that are mirrored, between 0 and 100.
+	Percentage uint32 `json:"percentage"`
+}
+
+// TrafficMirrorList defines the list of TrafficMirror objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TrafficMirrorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TrafficMirror `json:"items"`
+}
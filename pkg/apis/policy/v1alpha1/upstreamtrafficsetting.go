@@ -0,0 +1,266 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpstreamTrafficSetting is the type used to represent an UpstreamTrafficSetting policy.
+// An UpstreamTrafficSetting policy defines the settings applied to traffic directed to an upstream service,
+// such as the connection pool limits used for circuit breaking.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UpstreamTrafficSetting struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the UpstreamTrafficSetting policy specification
+	// +optional
+	Spec UpstreamTrafficSettingSpec `json:"spec,omitempty"`
+}
+
+// UpstreamTrafficSettingSpec is the type used to represent the UpstreamTrafficSetting policy specification.
+type UpstreamTrafficSettingSpec struct {
+	// Host defines the upstream service the UpstreamTrafficSetting policy applies to.
+	// Must be formatted as the Kubernetes service FQDN <service>.<namespace>.svc.cluster.local,
+	// where the namespace matches the namespace of the UpstreamTrafficSetting resource.
+	Host string `json:"host"`
+
+	// ConnectionSettings defines the connection pool limits for the upstream service.
+	// +optional
+	ConnectionSettings *ConnectionSettingsSpec `json:"connectionSettings,omitempty"`
+
+	// OutlierDetection defines the outlier detection settings used to eject misbehaving hosts
+	// of the upstream service from the load balancing pool.
+	// +optional
+	OutlierDetection *OutlierDetectionSpec `json:"outlierDetection,omitempty"`
+
+	// HealthCheck defines the active health checking settings used to probe the hosts of the upstream service.
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+
+	// LoadBalancer defines the load balancing settings used to distribute the traffic among the hosts
+	// of the upstream service.
+	// +optional
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+}
+
+// ConnectionSettingsSpec is the type used to represent the connection pool limits for an upstream service.
+// Connections and requests exceeding these limits are rejected by the client proxy, and a single slow
+// upstream host can not exhaust the resources of all its clients.
+type ConnectionSettingsSpec struct {
+	// TCP defines the TCP connection settings for the upstream service.
+	// +optional
+	TCP *TCPConnectionSettings `json:"tcp,omitempty"`
+
+	// HTTP defines the HTTP connection settings for the upstream service.
+	// +optional
+	HTTP *HTTPConnectionSettings `json:"http,omitempty"`
+}
+
+// TCPConnectionSettings is the type used to represent the TCP connection settings for an upstream service.
+type TCPConnectionSettings struct {
+	// MaxConnections defines the maximum number of connections the client proxy will make to the upstream service.
+	// +optional
+	MaxConnections *uint32 `json:"maxConnections,omitempty"`
+}
+
+// HTTPConnectionSettings is the type used to represent the HTTP connection settings for an upstream service.
+type HTTPConnectionSettings struct {
+	// MaxPendingRequests defines the maximum number of requests that will be queued while waiting for
+	// a connection to the upstream service.
+	// +optional
+	MaxPendingRequests *uint32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequests defines the maximum number of parallel requests to the upstream service.
+	// +optional
+	MaxRequests *uint32 `json:"maxRequests,omitempty"`
+
+	// MaxRetries defines the maximum number of parallel retries to the upstream service.
+	// +optional
+	MaxRetries *uint32 `json:"maxRetries,omitempty"`
+}
+
+// OutlierDetectionSpec is the type used to represent the outlier detection settings for an upstream service.
+// Only the detection types that are specified are enforced.
+type OutlierDetectionSpec struct {
+	// Consecutive5xxErrors defines the number of consecutive 5xx errors after which a host is ejected.
+	// +optional
+	Consecutive5xxErrors *uint32 `json:"consecutive5xxErrors,omitempty"`
+
+	// ConsecutiveGatewayErrors defines the number of consecutive gateway errors (502, 503 and 504 responses)
+	// after which a host is ejected.
+	// +optional
+	ConsecutiveGatewayErrors *uint32 `json:"consecutiveGatewayErrors,omitempty"`
+
+	// SuccessRate defines the settings to eject hosts whose success rate deviates from the success rate
+	// of the other hosts of the upstream service.
+	// +optional
+	SuccessRate *SuccessRateOutlierDetectionSpec `json:"successRate,omitempty"`
+
+	// Interval defines the time interval between ejection analysis sweeps.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// BaseEjectionTime defines the base duration a host is ejected for. The actual duration is
+	// the base duration multiplied by the number of times the host has been ejected.
+	// +optional
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent defines the maximum percentage of hosts of the upstream service that can be ejected.
+	// +optional
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// SuccessRateOutlierDetectionSpec is the type used to represent the success rate based outlier detection settings.
+type SuccessRateOutlierDetectionSpec struct {
+	// MinimumHosts defines the minimum number of hosts with enough request volume required to perform
+	// success rate based outlier detection.
+	// +optional
+	MinimumHosts *uint32 `json:"minimumHosts,omitempty"`
+
+	// RequestVolume defines the minimum number of requests a host must receive in an interval
+	// to be included in success rate based outlier detection.
+	// +optional
+	RequestVolume *uint32 `json:"requestVolume,omitempty"`
+
+	// StdevFactor defines the factor used to determine the ejection threshold, divided by a thousand.
+	// A host is ejected if its success rate is lower than mean - (stdev * StdevFactor/1000).
+	// +optional
+	StdevFactor *uint32 `json:"stdevFactor,omitempty"`
+}
+
+// HealthCheckType is the type used to represent the protocol used to actively health check hosts.
+type HealthCheckType string
+
+const (
+	// HTTPHealthCheck is the HealthCheckType for HTTP health checks
+	HTTPHealthCheck HealthCheckType = "HTTP"
+
+	// GRPCHealthCheck is the HealthCheckType for gRPC health checks
+	GRPCHealthCheck HealthCheckType = "GRPC"
+
+	// TCPHealthCheck is the HealthCheckType for TCP health checks
+	TCPHealthCheck HealthCheckType = "TCP"
+)
+
+// HealthCheckSpec is the type used to represent the active health checking settings for an upstream service.
+type HealthCheckSpec struct {
+	// Type defines the protocol used to health check the hosts of the upstream service, one of HTTP, GRPC or TCP.
+	Type HealthCheckType `json:"type"`
+
+	// Path defines the HTTP path requested during HTTP health checks.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// GRPCServiceName defines the service name sent in gRPC health check requests.
+	// +optional
+	GRPCServiceName string `json:"grpcServiceName,omitempty"`
+
+	// Interval defines the time interval between health checks.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Timeout defines the time to wait for a health check response.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// HealthyThreshold defines the number of successful health checks required before a host is marked healthy.
+	// +optional
+	HealthyThreshold *uint32 `json:"healthyThreshold,omitempty"`
+
+	// UnhealthyThreshold defines the number of failed health checks required before a host is marked unhealthy.
+	// +optional
+	UnhealthyThreshold *uint32 `json:"unhealthyThreshold,omitempty"`
+}
+
+// LoadBalancerType is the type used to represent the load balancing algorithm used to pick the host
+// of the upstream service a request or connection is sent to.
+type LoadBalancerType string
+
+const (
+	// RoundRobinLoadBalancer is the LoadBalancerType that picks the hosts in a round robin order
+	RoundRobinLoadBalancer LoadBalancerType = "RoundRobin"
+
+	// RingHashLoadBalancer is the LoadBalancerType that consistently hashes the requests to the hosts using a hash ring
+	RingHashLoadBalancer LoadBalancerType = "RingHash"
+
+	// MaglevLoadBalancer is the LoadBalancerType that consistently hashes the requests to the hosts using Maglev hashing
+	MaglevLoadBalancer LoadBalancerType = "Maglev"
+
+	// LeastRequestLoadBalancer is the LoadBalancerType that picks the host with the fewest active requests
+	// among randomly chosen hosts
+	LeastRequestLoadBalancer LoadBalancerType = "LeastRequest"
+
+	// RandomLoadBalancer is the LoadBalancerType that picks a random host
+	RandomLoadBalancer LoadBalancerType = "Random"
+)
+
+// LoadBalancerSpec is the type used to represent the load balancing settings for an upstream service.
+type LoadBalancerSpec struct {
+	// Type defines the load balancing algorithm, one of RoundRobin, LeastRequest, Random, RingHash or Maglev.
+	Type LoadBalancerType `json:"type"`
+
+	// LeastRequest defines the settings of the LeastRequest load balancing algorithm.
+	// +optional
+	LeastRequest *LeastRequestSpec `json:"leastRequest,omitempty"`
+
+	// ConsistentHash defines the keys hashed to pick a host when the RingHash or Maglev load balancing
+	// algorithm is used. Requests with the same keys are sent to the same host for as long as the set of
+	// hosts of the upstream service does not change.
+	// +optional
+	ConsistentHash *ConsistentHashSpec `json:"consistentHash,omitempty"`
+}
+
+// LeastRequestSpec is the type used to represent the settings of the LeastRequest load balancing algorithm.
+type LeastRequestSpec struct {
+	// ChoiceCount defines the number of randomly chosen hosts compared to pick the host with the fewest
+	// active requests. Must be at least 2, defaults to 2.
+	// +optional
+	ChoiceCount *uint32 `json:"choiceCount,omitempty"`
+}
+
+// ConsistentHashSpec is the type used to represent the keys hashed by consistent hash load balancers.
+// When multiple keys are specified, the hash is computed from all of them.
+type ConsistentHashSpec struct {
+	// Header defines the name of the HTTP request header whose value is hashed.
+	// +optional
+	Header string `json:"header,omitempty"`
+
+	// Cookie defines the HTTP cookie whose value is hashed.
+	// +optional
+	Cookie *CookieHashSpec `json:"cookie,omitempty"`
+
+	// SourceIP defines whether the source IP address of the client is hashed.
+	// This is the only key applicable to TCP traffic.
+	// +optional
+	SourceIP bool `json:"sourceIP,omitempty"`
+}
+
+// CookieHashSpec is the type used to represent the HTTP cookie hashed by consistent hash load balancers.
+type CookieHashSpec struct {
+	// Name defines the name of the cookie.
+	Name string `json:"name"`
+
+	// Path defines the path of the cookie generated by the proxy when the request does not carry it.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// TTL defines the time to live of the cookie. If specified, the proxy generates the cookie when the
+	// request does not carry it, so that the subsequent requests of the client are sent to the same host.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// UpstreamTrafficSettingList defines the list of UpstreamTrafficSetting objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UpstreamTrafficSettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []UpstreamTrafficSetting `json:"items"`
+}
@@ -18,10 +18,484 @@ limitations under the License.
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationConditions) DeepCopyInto(out *AuthorizationConditions) {
+	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]ClaimCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceIPBlocks != nil {
+		in, out := &in.SourceIPBlocks, &out.SourceIPBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationConditions.
+func (in *AuthorizationConditions) DeepCopy() *AuthorizationConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicy) DeepCopyInto(out *AuthorizationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicy.
+func (in *AuthorizationPolicy) DeepCopy() *AuthorizationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicyList) DeepCopyInto(out *AuthorizationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthorizationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicyList.
+func (in *AuthorizationPolicyList) DeepCopy() *AuthorizationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicySpec) DeepCopyInto(out *AuthorizationPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicySpec.
+func (in *AuthorizationPolicySpec) DeepCopy() *AuthorizationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationRouteRef) DeepCopyInto(out *AuthorizationRouteRef) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationRouteRef.
+func (in *AuthorizationRouteRef) DeepCopy() *AuthorizationRouteRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationRouteRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationRule) DeepCopyInto(out *AuthorizationRule) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AuthorizationRouteRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.When.DeepCopyInto(&out.When)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationRule.
+func (in *AuthorizationRule) DeepCopy() *AuthorizationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicy) DeepCopyInto(out *CORSPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicy.
+func (in *CORSPolicy) DeepCopy() *CORSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicyList) DeepCopyInto(out *CORSPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CORSPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicyList.
+func (in *CORSPolicyList) DeepCopy() *CORSPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CORSPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CORSPolicySpec) DeepCopyInto(out *CORSPolicySpec) {
+	*out = *in
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowMethods != nil {
+		in, out := &in.AllowMethods, &out.AllowMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExposeHeaders != nil {
+		in, out := &in.ExposeHeaders, &out.ExposeHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSPolicySpec.
+func (in *CORSPolicySpec) DeepCopy() *CORSPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CORSPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimCondition) DeepCopyInto(out *ClaimCondition) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimCondition.
+func (in *ClaimCondition) DeepCopy() *ClaimCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimToHeader) DeepCopyInto(out *ClaimToHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimToHeader.
+func (in *ClaimToHeader) DeepCopy() *ClaimToHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimToHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSettingsSpec) DeepCopyInto(out *ConnectionSettingsSpec) {
+	*out = *in
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPConnectionSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPConnectionSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSettingsSpec.
+func (in *ConnectionSettingsSpec) DeepCopy() *ConnectionSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsistentHashSpec) DeepCopyInto(out *ConsistentHashSpec) {
+	*out = *in
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(CookieHashSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsistentHashSpec.
+func (in *ConsistentHashSpec) DeepCopy() *ConsistentHashSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsistentHashSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CookieHashSpec) DeepCopyInto(out *CookieHashSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CookieHashSpec.
+func (in *CookieHashSpec) DeepCopy() *CookieHashSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CookieHashSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deny) DeepCopyInto(out *Deny) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deny.
+func (in *Deny) DeepCopy() *Deny {
+	if in == nil {
+		return nil
+	}
+	out := new(Deny)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Deny) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyList) DeepCopyInto(out *DenyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Deny, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyList.
+func (in *DenyList) DeepCopy() *DenyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DenyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenySourceSpec) DeepCopyInto(out *DenySourceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenySourceSpec.
+func (in *DenySourceSpec) DeepCopy() *DenySourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DenySourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenySpec) DeepCopyInto(out *DenySpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]DenySourceSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]AuthorizationRouteRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenySpec.
+func (in *DenySpec) DeepCopy() *DenySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DenySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Egress) DeepCopyInto(out *Egress) {
 	*out = *in
@@ -31,18 +505,1021 @@ func (in *Egress) DeepCopyInto(out *Egress) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Egress.
-func (in *Egress) DeepCopy() *Egress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Egress.
+func (in *Egress) DeepCopy() *Egress {
+	if in == nil {
+		return nil
+	}
+	out := new(Egress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Egress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressList) DeepCopyInto(out *EgressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Egress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressList.
+func (in *EgressList) DeepCopy() *EgressList {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]SourceSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]corev1.TypedLocalObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HeaderModifier != nil {
+		in, out := &in.HeaderModifier, &out.HeaderModifier
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressSpec.
+func (in *EgressSpec) DeepCopy() *EgressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultAbortSpec) DeepCopyInto(out *FaultAbortSpec) {
+	*out = *in
+	if in.GRPCStatus != nil {
+		in, out := &in.GRPCStatus, &out.GRPCStatus
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbortSpec.
+func (in *FaultAbortSpec) DeepCopy() *FaultAbortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelaySpec) DeepCopyInto(out *FaultDelaySpec) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelaySpec.
+func (in *FaultDelaySpec) DeepCopy() *FaultDelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FaultInjection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionList) DeepCopyInto(out *FaultInjectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FaultInjection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionList.
+func (in *FaultInjectionList) DeepCopy() *FaultInjectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FaultInjectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionSpec) DeepCopyInto(out *FaultInjectionSpec) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]corev1.TypedLocalObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelaySpec)
+		**out = **in
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbortSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionSpec.
+func (in *FaultInjectionSpec) DeepCopy() *FaultInjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCMatch) DeepCopyInto(out *GRPCMatch) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCMatch.
+func (in *GRPCMatch) DeepCopy() *GRPCMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteGroup) DeepCopyInto(out *GRPCRouteGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteGroup.
+func (in *GRPCRouteGroup) DeepCopy() *GRPCRouteGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRouteGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteGroupList) DeepCopyInto(out *GRPCRouteGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCRouteGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteGroupList.
+func (in *GRPCRouteGroupList) DeepCopy() *GRPCRouteGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRouteGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRouteGroupSpec) DeepCopyInto(out *GRPCRouteGroupSpec) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]GRPCMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteGroupSpec.
+func (in *GRPCRouteGroupSpec) DeepCopy() *GRPCRouteGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRouteGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPConnectionSettings) DeepCopyInto(out *HTTPConnectionSettings) {
+	*out = *in
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPConnectionSettings.
+func (in *HTTPConnectionSettings) DeepCopy() *HTTPConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderModifierSpec) DeepCopyInto(out *HTTPHeaderModifierSpec) {
+	*out = *in
+	if in.RequestHeadersToAdd != nil {
+		in, out := &in.RequestHeadersToAdd, &out.RequestHeadersToAdd
+		*out = make([]HTTPHeaderSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeadersToSet != nil {
+		in, out := &in.RequestHeadersToSet, &out.RequestHeadersToSet
+		*out = make([]HTTPHeaderSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeadersToRemove != nil {
+		in, out := &in.RequestHeadersToRemove, &out.RequestHeadersToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeadersToAdd != nil {
+		in, out := &in.ResponseHeadersToAdd, &out.ResponseHeadersToAdd
+		*out = make([]HTTPHeaderSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeadersToSet != nil {
+		in, out := &in.ResponseHeadersToSet, &out.ResponseHeadersToSet
+		*out = make([]HTTPHeaderSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseHeadersToRemove != nil {
+		in, out := &in.ResponseHeadersToRemove, &out.ResponseHeadersToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderModifierSpec.
+func (in *HTTPHeaderModifierSpec) DeepCopy() *HTTPHeaderModifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderModifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderSpec) DeepCopyInto(out *HTTPHeaderSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderSpec.
+func (in *HTTPHeaderSpec) DeepCopy() *HTTPHeaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPLocalRateLimitSpec) DeepCopyInto(out *HTTPLocalRateLimitSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLocalRateLimitSpec.
+func (in *HTTPLocalRateLimitSpec) DeepCopy() *HTTPLocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPLocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteHeaderModifierSpec) DeepCopyInto(out *HTTPRouteHeaderModifierSpec) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteHeaderModifierSpec.
+func (in *HTTPRouteHeaderModifierSpec) DeepCopy() *HTTPRouteHeaderModifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteHeaderModifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteLocalRateLimitSpec) DeepCopyInto(out *HTTPRouteLocalRateLimitSpec) {
+	*out = *in
+	out.RateLimit = in.RateLimit
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteLocalRateLimitSpec.
+func (in *HTTPRouteLocalRateLimitSpec) DeepCopy() *HTTPRouteLocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteLocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderCondition) DeepCopyInto(out *HeaderCondition) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderCondition.
+func (in *HeaderCondition) DeepCopy() *HeaderCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderModifier) DeepCopyInto(out *HeaderModifier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderModifier.
+func (in *HeaderModifier) DeepCopy() *HeaderModifier {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderModifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HeaderModifier) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderModifierList) DeepCopyInto(out *HeaderModifierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HeaderModifier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderModifierList.
+func (in *HeaderModifierList) DeepCopy() *HeaderModifierList {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderModifierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HeaderModifierList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderModifierSpec) DeepCopyInto(out *HeaderModifierSpec) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoutes != nil {
+		in, out := &in.HTTPRoutes, &out.HTTPRoutes
+		*out = make([]HTTPRouteHeaderModifierSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderModifierSpec.
+func (in *HeaderModifierSpec) DeepCopy() *HeaderModifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderModifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthyThreshold != nil {
+		in, out := &in.HealthyThreshold, &out.HealthyThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.UnhealthyThreshold != nil {
+		in, out := &in.UnhealthyThreshold, &out.UnhealthyThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTHeader) DeepCopyInto(out *JWTHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTHeader.
+func (in *JWTHeader) DeepCopy() *JWTHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTRule) DeepCopyInto(out *JWTRule) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FromHeaders != nil {
+		in, out := &in.FromHeaders, &out.FromHeaders
+		*out = make([]JWTHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.FromParams != nil {
+		in, out := &in.FromParams, &out.FromParams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputClaimToHeaders != nil {
+		in, out := &in.OutputClaimToHeaders, &out.OutputClaimToHeaders
+		*out = make([]ClaimToHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTRule.
+func (in *JWTRule) DeepCopy() *JWTRule {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeastRequestSpec) DeepCopyInto(out *LeastRequestSpec) {
+	*out = *in
+	if in.ChoiceCount != nil {
+		in, out := &in.ChoiceCount, &out.ChoiceCount
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeastRequestSpec.
+func (in *LeastRequestSpec) DeepCopy() *LeastRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LeastRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.LeastRequest != nil {
+		in, out := &in.LeastRequest, &out.LeastRequest
+		*out = new(LeastRequestSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConsistentHash != nil {
+		in, out := &in.ConsistentHash, &out.ConsistentHash
+		*out = new(ConsistentHashSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimitSpec) DeepCopyInto(out *LocalRateLimitSpec) {
+	*out = *in
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPLocalRateLimitSpec)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLocalRateLimitSpec)
+		**out = **in
+	}
+	if in.HTTPRoutes != nil {
+		in, out := &in.HTTPRoutes, &out.HTTPRoutes
+		*out = make([]HTTPRouteLocalRateLimitSpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitSpec.
+func (in *LocalRateLimitSpec) DeepCopy() *LocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectionSpec) DeepCopyInto(out *OutlierDetectionSpec) {
+	*out = *in
+	if in.Consecutive5xxErrors != nil {
+		in, out := &in.Consecutive5xxErrors, &out.Consecutive5xxErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ConsecutiveGatewayErrors != nil {
+		in, out := &in.ConsecutiveGatewayErrors, &out.ConsecutiveGatewayErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SuccessRate != nil {
+		in, out := &in.SuccessRate, &out.SuccessRate
+		*out = new(SuccessRateOutlierDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectionSpec.
+func (in *OutlierDetectionSpec) DeepCopy() *OutlierDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
+func (in *PortSpec) DeepCopy() *PortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimit) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitList) DeepCopyInto(out *RateLimitList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitList.
+func (in *RateLimitList) DeepCopy() *RateLimitList {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestAuthentication) DeepCopyInto(out *RequestAuthentication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthentication.
+func (in *RequestAuthentication) DeepCopy() *RequestAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestAuthentication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestAuthenticationList) DeepCopyInto(out *RequestAuthenticationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RequestAuthentication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationList.
+func (in *RequestAuthenticationList) DeepCopy() *RequestAuthenticationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestAuthenticationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestAuthenticationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestAuthenticationSpec) DeepCopyInto(out *RequestAuthenticationSpec) {
+	*out = *in
+	if in.JWTRules != nil {
+		in, out := &in.JWTRules, &out.JWTRules
+		*out = make([]JWTRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationSpec.
+func (in *RequestAuthenticationSpec) DeepCopy() *RequestAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestAuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Retry) DeepCopyInto(out *Retry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
+func (in *Retry) DeepCopy() *Retry {
 	if in == nil {
 		return nil
 	}
-	out := new(Egress)
+	out := new(Retry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Egress) DeepCopyObject() runtime.Object {
+func (in *Retry) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -50,13 +1527,13 @@ func (in *Egress) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EgressList) DeepCopyInto(out *EgressList) {
+func (in *RetryList) DeepCopyInto(out *RetryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Egress, len(*in))
+		*out = make([]Retry, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -64,18 +1541,18 @@ func (in *EgressList) DeepCopyInto(out *EgressList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressList.
-func (in *EgressList) DeepCopy() *EgressList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryList.
+func (in *RetryList) DeepCopy() *RetryList {
 	if in == nil {
 		return nil
 	}
-	out := new(EgressList)
+	out := new(RetryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EgressList) DeepCopyObject() runtime.Object {
+func (in *RetryList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -83,60 +1560,83 @@ func (in *EgressList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
+func (in *RetryPolicySpec) DeepCopyInto(out *RetryPolicySpec) {
 	*out = *in
-	if in.Sources != nil {
-		in, out := &in.Sources, &out.Sources
-		*out = make([]SourceSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Hosts != nil {
-		in, out := &in.Hosts, &out.Hosts
+	if in.GRPCRetryOn != nil {
+		in, out := &in.GRPCRetryOn, &out.GRPCRetryOn
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.IPAddresses != nil {
-		in, out := &in.IPAddresses, &out.IPAddresses
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]PortSpec, len(*in))
+	if in.NumRetries != nil {
+		in, out := &in.NumRetries, &out.NumRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RetryBackoffBaseInterval != nil {
+		in, out := &in.RetryBackoffBaseInterval, &out.RetryBackoffBaseInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicySpec.
+func (in *RetryPolicySpec) DeepCopy() *RetryPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetrySpec) DeepCopyInto(out *RetrySpec) {
+	*out = *in
+	out.Source = in.Source
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]RetrySrcDstSpec, len(*in))
 		copy(*out, *in)
 	}
 	if in.Matches != nil {
 		in, out := &in.Matches, &out.Matches
-		*out = make([]v1.TypedLocalObjectReference, len(*in))
+		*out = make([]corev1.TypedLocalObjectReference, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.RetryPolicy.DeepCopyInto(&out.RetryPolicy)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressSpec.
-func (in *EgressSpec) DeepCopy() *EgressSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySpec.
+func (in *RetrySpec) DeepCopy() *RetrySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EgressSpec)
+	out := new(RetrySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+func (in *RetrySrcDstSpec) DeepCopyInto(out *RetrySrcDstSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
-func (in *PortSpec) DeepCopy() *PortSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySrcDstSpec.
+func (in *RetrySrcDstSpec) DeepCopy() *RetrySrcDstSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PortSpec)
+	out := new(RetrySrcDstSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -156,3 +1656,243 @@ func (in *SourceSpec) DeepCopy() *SourceSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuccessRateOutlierDetectionSpec) DeepCopyInto(out *SuccessRateOutlierDetectionSpec) {
+	*out = *in
+	if in.MinimumHosts != nil {
+		in, out := &in.MinimumHosts, &out.MinimumHosts
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RequestVolume != nil {
+		in, out := &in.RequestVolume, &out.RequestVolume
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.StdevFactor != nil {
+		in, out := &in.StdevFactor, &out.StdevFactor
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuccessRateOutlierDetectionSpec.
+func (in *SuccessRateOutlierDetectionSpec) DeepCopy() *SuccessRateOutlierDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SuccessRateOutlierDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPConnectionSettings) DeepCopyInto(out *TCPConnectionSettings) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPConnectionSettings.
+func (in *TCPConnectionSettings) DeepCopy() *TCPConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPLocalRateLimitSpec) DeepCopyInto(out *TCPLocalRateLimitSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPLocalRateLimitSpec.
+func (in *TCPLocalRateLimitSpec) DeepCopy() *TCPLocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPLocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirror) DeepCopyInto(out *TrafficMirror) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirror.
+func (in *TrafficMirror) DeepCopy() *TrafficMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirror) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorList) DeepCopyInto(out *TrafficMirrorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorList.
+func (in *TrafficMirrorList) DeepCopy() *TrafficMirrorList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSpec) DeepCopyInto(out *TrafficMirrorSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSpec.
+func (in *TrafficMirrorSpec) DeepCopy() *TrafficMirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamTrafficSetting) DeepCopyInto(out *UpstreamTrafficSetting) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamTrafficSetting.
+func (in *UpstreamTrafficSetting) DeepCopy() *UpstreamTrafficSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamTrafficSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpstreamTrafficSetting) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamTrafficSettingList) DeepCopyInto(out *UpstreamTrafficSettingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]UpstreamTrafficSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamTrafficSettingList.
+func (in *UpstreamTrafficSettingList) DeepCopy() *UpstreamTrafficSettingList {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamTrafficSettingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpstreamTrafficSettingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamTrafficSettingSpec) DeepCopyInto(out *UpstreamTrafficSettingSpec) {
+	*out = *in
+	if in.ConnectionSettings != nil {
+		in, out := &in.ConnectionSettings, &out.ConnectionSettings
+		*out = new(ConnectionSettingsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamTrafficSettingSpec.
+func (in *UpstreamTrafficSettingSpec) DeepCopy() *UpstreamTrafficSettingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamTrafficSettingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
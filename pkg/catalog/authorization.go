@@ -0,0 +1,229 @@
+package catalog
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/pkg/errors"
+	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// applyAuthorizationPolicy adds the authorization conditions of the AuthorizationPolicy policy applied to the given service
+// to the rules of the given inbound traffic policy. The conditions of an authorization rule are added to the rules whose
+// route is covered by a route selected by the authorization rule, or to all the rules if the authorization rule does not
+// select routes. A rule is added for each selected route without a rule of its own, so that the conditions are not bypassed
+// by the requests on the selected route matching a broader route. When the routes of an authorization rule cannot be
+// resolved, the routes it selects are unknown, so all the rules are made to allow no service accounts and deny all the requests.
+// The conditions of an AuthorizationPolicy policy in shadow mode are added as shadow authorization conditions, and its
+// authorization rules whose routes cannot be resolved are skipped.
+func (mc *MeshCatalog) applyAuthorizationPolicy(svc service.MeshService, inboundPolicy *trafficpolicy.InboundTrafficPolicy) {
+	authzPolicy := mc.policyController.GetAuthorizationPolicy(svc)
+	if authzPolicy == nil {
+		return
+	}
+
+	authzRuleRouteMatches := make([][]trafficpolicy.HTTPRouteMatch, len(authzPolicy.Spec.Rules))
+	unresolvedAuthzRules := make([]bool, len(authzPolicy.Spec.Rules))
+	for i, authzRule := range authzPolicy.Spec.Rules {
+		routeMatches, err := mc.getAuthorizationRuleRouteMatches(authzPolicy, authzRule)
+		if err != nil {
+			log.Error().Err(err).Str(errcode.Kind, errcode.ErrFetchingSMIHTTPRouteGroupForTrafficTarget.String()).
+				Msgf("Error finding route matches from AuthorizationPolicy %s in namespace %s, denying all the requests to service %s",
+					authzPolicy.Name, authzPolicy.Namespace, svc)
+			unresolvedAuthzRules[i] = true
+			continue
+		}
+		authzRuleRouteMatches[i] = routeMatches
+		inboundPolicy.Rules = addAuthorizationRouteRules(inboundPolicy.Rules, routeMatches)
+	}
+
+	for i, authzRule := range authzPolicy.Spec.Rules {
+		if unresolvedAuthzRules[i] {
+			if !authzPolicy.Spec.Shadow {
+				for _, rule := range inboundPolicy.Rules {
+					rule.AllowedServiceAccounts = mapset.NewSet()
+				}
+			}
+			continue
+		}
+		for _, rule := range inboundPolicy.Rules {
+			if len(authzRuleRouteMatches[i]) != 0 && !selectsHTTPRouteMatch(authzRuleRouteMatches[i], rule.Route.HTTPRouteMatch) {
+				continue
+			}
+			if authzPolicy.Spec.Shadow {
+				rule.ShadowAuthorizationConditions = append(rule.ShadowAuthorizationConditions, *authzRule.When.DeepCopy())
+			} else {
+				rule.AuthorizationConditions = append(rule.AuthorizationConditions, *authzRule.When.DeepCopy())
+			}
+		}
+	}
+}
+
+// getAuthorizationRuleRouteMatches returns the HTTP route matches selected by the given authorization rule of the given
+// AuthorizationPolicy policy, or nil if the authorization rule does not select routes. An error is returned if any of
+// the selected matches cannot be resolved.
+func (mc *MeshCatalog) getAuthorizationRuleRouteMatches(authzPolicy *policyv1alpha1.AuthorizationPolicy, authzRule policyv1alpha1.AuthorizationRule) ([]trafficpolicy.HTTPRouteMatch, error) {
+	if len(authzRule.Routes) == 0 {
+		return nil, nil
+	}
+
+	var trafficTargetRules []access.TrafficTargetRule
+	numMatches := 0
+	for _, route := range authzRule.Routes {
+		trafficTargetRules = append(trafficTargetRules, access.TrafficTargetRule{
+			Kind:    httpRouteGroupKind,
+			Name:    route.HTTPRouteGroup,
+			Matches: route.Matches,
+		})
+		numMatches += len(route.Matches)
+	}
+
+	routeMatches, err := mc.routesFromRules(trafficTargetRules, authzPolicy.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(routeMatches) < numMatches {
+		return nil, errors.Errorf("Only %d of the %d matches of the HTTPRouteGroups referenced by the rule were found", len(routeMatches), numMatches)
+	}
+
+	return routeMatches, nil
+}
+
+// addAuthorizationRouteRules returns the given inbound rules preceded by a rule for each of the given route matches
+// without a rule of its own. The requests on such a route match are otherwise authorized by the first rule whose
+// route covers it, so the rule added for the route match allows the service accounts of that rule and routes the
+// requests to its clusters. The rule added for a route match that is not covered by any rule allows no service accounts.
+func addAuthorizationRouteRules(rules []*trafficpolicy.Rule, routeMatches []trafficpolicy.HTTPRouteMatch) []*trafficpolicy.Rule {
+	var routeRules []*trafficpolicy.Rule
+
+	for _, routeMatch := range routeMatches {
+		if containsRuleForHTTPRouteMatch(routeRules, routeMatch) || containsRuleForHTTPRouteMatch(rules, routeMatch) {
+			continue
+		}
+
+		routeRule := &trafficpolicy.Rule{
+			Route:                  trafficpolicy.RouteWeightedClusters{HTTPRouteMatch: routeMatch},
+			AllowedServiceAccounts: mapset.NewSet(),
+		}
+		for _, rule := range rules {
+			if !httpRouteMatchCovers(rule.Route.HTTPRouteMatch, routeMatch) {
+				continue
+			}
+			if rule.Route.WeightedClusters != nil {
+				routeRule.Route.WeightedClusters = rule.Route.WeightedClusters.Clone()
+			}
+			routeRule.AllowedServiceAccounts = rule.AllowedServiceAccounts.Clone()
+			break
+		}
+		if routeRule.Route.WeightedClusters == nil {
+			routeRule.Route.WeightedClusters = mapset.NewSet()
+		}
+
+		routeRules = append(routeRules, routeRule)
+	}
+
+	return append(routeRules, rules...)
+}
+
+// selectsHTTPRouteMatch returns a boolean indicating if the given HTTP route match is one of, or is covered by one of,
+// the given selected HTTP route matches
+func selectsHTTPRouteMatch(selectedMatches []trafficpolicy.HTTPRouteMatch, routeMatch trafficpolicy.HTTPRouteMatch) bool {
+	for _, selectedMatch := range selectedMatches {
+		if reflect.DeepEqual(selectedMatch, routeMatch) || httpRouteMatchCovers(selectedMatch, routeMatch) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsRuleForHTTPRouteMatch returns a boolean indicating if one of the given rules has the given HTTP route match
+func containsRuleForHTTPRouteMatch(rules []*trafficpolicy.Rule, routeMatch trafficpolicy.HTTPRouteMatch) bool {
+	for _, rule := range rules {
+		if reflect.DeepEqual(rule.Route.HTTPRouteMatch, routeMatch) {
+			return true
+		}
+	}
+	return false
+}
+
+// httpRouteMatchCovers returns a boolean indicating if the requests matching the given route match also match the given
+// covering route match, based on their paths, methods, headers and query parameters. The path of the route match is
+// matched as a literal path against a regex path of the covering route match.
+func httpRouteMatchCovers(covering, routeMatch trafficpolicy.HTTPRouteMatch) bool {
+	if !pathCovers(covering, routeMatch) || !methodsCover(covering.Methods, routeMatch.Methods) {
+		return false
+	}
+
+	for header, value := range covering.Headers {
+		if routeMatch.Headers[header] != value {
+			return false
+		}
+	}
+
+	for _, queryParam := range covering.QueryParams {
+		found := false
+		for _, routeQueryParam := range routeMatch.QueryParams {
+			if reflect.DeepEqual(queryParam, routeQueryParam) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pathCovers returns a boolean indicating if the path of the given route match is matched by the path of the given covering route match
+func pathCovers(covering, routeMatch trafficpolicy.HTTPRouteMatch) bool {
+	if covering.PathMatchType == routeMatch.PathMatchType && covering.Path == routeMatch.Path {
+		return true
+	}
+
+	switch covering.PathMatchType {
+	case trafficpolicy.PathMatchRegex:
+		if covering.Path == constants.RegexMatchAll {
+			return true
+		}
+		// Regex path matches must match the entire path
+		re, err := regexp.Compile("^(?:" + covering.Path + ")$")
+		return err == nil && re.MatchString(routeMatch.Path)
+
+	case trafficpolicy.PathMatchPrefix:
+		return strings.HasPrefix(routeMatch.Path, covering.Path)
+
+	default:
+		return false
+	}
+}
+
+// methodsCover returns a boolean indicating if the given HTTP methods are all matched by the given covering HTTP methods
+func methodsCover(covering, methods []string) bool {
+	coveringMethods := mapset.NewSet()
+	for _, method := range covering {
+		if method == constants.WildcardHTTPMethod {
+			return true
+		}
+		coveringMethods.Add(method)
+	}
+
+	if len(methods) == 0 {
+		return false
+	}
+	for _, method := range methods {
+		if !coveringMethods.Contains(method) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,314 @@
+package catalog
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/smi"
+	"github.com/openservicemesh/osm/pkg/tests"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestApplyAuthorizationPolicy(t *testing.T) {
+	svc := service.MeshService{Name: "bookstore", Namespace: tests.Namespace}
+
+	adminConditions := policyv1alpha1.AuthorizationConditions{
+		Claims: []policyv1alpha1.ClaimCondition{{Name: "role", Values: []string{"admin"}}},
+	}
+	internalConditions := policyv1alpha1.AuthorizationConditions{
+		SourceIPBlocks: []string{"10.0.0.0/8"},
+	}
+
+	testCases := []struct {
+		name                         string
+		authzPolicy                  *policyv1alpha1.AuthorizationPolicy
+		expectedBuyConditions        []policyv1alpha1.AuthorizationConditions
+		expectedSellConditions       []policyv1alpha1.AuthorizationConditions
+		expectedShadowSellConditions []policyv1alpha1.AuthorizationConditions
+		expectedDenied               bool
+	}{
+		{
+			name:                   "no AuthorizationPolicy policy for the service",
+			authzPolicy:            nil,
+			expectedBuyConditions:  nil,
+			expectedSellConditions: nil,
+		},
+		{
+			name: "authorization rules selecting routes and applying to all routes",
+			authzPolicy: &policyv1alpha1.AuthorizationPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+				Spec: policyv1alpha1.AuthorizationPolicySpec{
+					Host: "bookstore.default.svc.cluster.local",
+					Rules: []policyv1alpha1.AuthorizationRule{
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName}},
+							},
+							When: adminConditions,
+						},
+						{
+							When: internalConditions,
+						},
+					},
+				},
+			},
+			expectedBuyConditions:  []policyv1alpha1.AuthorizationConditions{internalConditions},
+			expectedSellConditions: []policyv1alpha1.AuthorizationConditions{adminConditions, internalConditions},
+		},
+		{
+			name: "authorization rules in shadow mode",
+			authzPolicy: &policyv1alpha1.AuthorizationPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+				Spec: policyv1alpha1.AuthorizationPolicySpec{
+					Host: "bookstore.default.svc.cluster.local",
+					Rules: []policyv1alpha1.AuthorizationRule{
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName}},
+							},
+							When: adminConditions,
+						},
+					},
+					Shadow: true,
+				},
+			},
+			expectedBuyConditions:        nil,
+			expectedSellConditions:       nil,
+			expectedShadowSellConditions: []policyv1alpha1.AuthorizationConditions{adminConditions},
+		},
+		{
+			name: "authorization rule selecting a route that does not exist denies all the requests",
+			authzPolicy: &policyv1alpha1.AuthorizationPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+				Spec: policyv1alpha1.AuthorizationPolicySpec{
+					Host: "bookstore.default.svc.cluster.local",
+					Rules: []policyv1alpha1.AuthorizationRule{
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: "does-not-exist", Matches: []string{tests.SellBooksMatchName}},
+							},
+							When: adminConditions,
+						},
+					},
+				},
+			},
+			expectedBuyConditions:  nil,
+			expectedSellConditions: nil,
+			expectedDenied:         true,
+		},
+		{
+			name: "authorization rule selecting a match that does not exist denies all the requests",
+			authzPolicy: &policyv1alpha1.AuthorizationPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+				Spec: policyv1alpha1.AuthorizationPolicySpec{
+					Host: "bookstore.default.svc.cluster.local",
+					Rules: []policyv1alpha1.AuthorizationRule{
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName, "does-not-exist"}},
+							},
+							When: adminConditions,
+						},
+					},
+				},
+			},
+			expectedBuyConditions:  nil,
+			expectedSellConditions: nil,
+			expectedDenied:         true,
+		},
+		{
+			name: "authorization rule selecting a route that does not exist does not loosen the routes restricted by other rules",
+			authzPolicy: &policyv1alpha1.AuthorizationPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+				Spec: policyv1alpha1.AuthorizationPolicySpec{
+					Host: "bookstore.default.svc.cluster.local",
+					Rules: []policyv1alpha1.AuthorizationRule{
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName}},
+							},
+							When: adminConditions,
+						},
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: "does-not-exist", Matches: []string{tests.BuyBooksMatchName}},
+							},
+							When: internalConditions,
+						},
+					},
+				},
+			},
+			expectedBuyConditions:  nil,
+			expectedSellConditions: []policyv1alpha1.AuthorizationConditions{adminConditions},
+			expectedDenied:         true,
+		},
+		{
+			name: "authorization rule selecting a route that does not exist in shadow mode is ignored",
+			authzPolicy: &policyv1alpha1.AuthorizationPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+				Spec: policyv1alpha1.AuthorizationPolicySpec{
+					Host: "bookstore.default.svc.cluster.local",
+					Rules: []policyv1alpha1.AuthorizationRule{
+						{
+							Routes: []policyv1alpha1.AuthorizationRouteRef{
+								{HTTPRouteGroup: "does-not-exist", Matches: []string{tests.SellBooksMatchName}},
+							},
+							When: adminConditions,
+						},
+					},
+					Shadow: true,
+				},
+			},
+			expectedBuyConditions:        nil,
+			expectedSellConditions:       nil,
+			expectedShadowSellConditions: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				meshSpec:         smi.NewFakeMeshSpecClient(),
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(svc).Return(tc.authzPolicy).Times(1)
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+
+			inboundPolicy := &trafficpolicy.InboundTrafficPolicy{
+				Rules: []*trafficpolicy.Rule{
+					{
+						Route:                  trafficpolicy.RouteWeightedClusters{HTTPRouteMatch: tests.BookstoreBuyHTTPRoute},
+						AllowedServiceAccounts: mapset.NewSet(tests.BookbuyerServiceAccount),
+					},
+					{
+						Route:                  trafficpolicy.RouteWeightedClusters{HTTPRouteMatch: tests.BookstoreSellHTTPRoute},
+						AllowedServiceAccounts: mapset.NewSet(tests.BookbuyerServiceAccount),
+					},
+				},
+			}
+
+			mc.applyAuthorizationPolicy(svc, inboundPolicy)
+			assert.Equal(tc.expectedBuyConditions, inboundPolicy.Rules[0].AuthorizationConditions)
+			assert.Equal(tc.expectedSellConditions, inboundPolicy.Rules[1].AuthorizationConditions)
+			assert.Nil(inboundPolicy.Rules[0].ShadowAuthorizationConditions)
+			assert.Equal(tc.expectedShadowSellConditions, inboundPolicy.Rules[1].ShadowAuthorizationConditions)
+			for _, rule := range inboundPolicy.Rules {
+				assert.Equal(tc.expectedDenied, rule.AllowedServiceAccounts.Cardinality() == 0)
+			}
+		})
+	}
+}
+
+func TestApplyAuthorizationPolicyWithoutRouteRule(t *testing.T) {
+	svc := service.MeshService{Name: "bookstore", Namespace: tests.Namespace}
+
+	adminConditions := policyv1alpha1.AuthorizationConditions{
+		Claims: []policyv1alpha1.ClaimCondition{{Name: "role", Values: []string{"admin"}}},
+	}
+	authzPolicy := &policyv1alpha1.AuthorizationPolicy{
+		ObjectMeta: v1.ObjectMeta{Name: "bookstore-authz", Namespace: tests.Namespace},
+		Spec: policyv1alpha1.AuthorizationPolicySpec{
+			Host: "bookstore.default.svc.cluster.local",
+			Rules: []policyv1alpha1.AuthorizationRule{
+				{
+					Routes: []policyv1alpha1.AuthorizationRouteRef{
+						{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName}},
+					},
+					When: adminConditions,
+				},
+			},
+		},
+	}
+
+	broaderRouteMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          "/s.*",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"GET", "POST"},
+	}
+
+	testCases := []struct {
+		name                       string
+		rule                       *trafficpolicy.Rule
+		expectedSellServiceAccount mapset.Set
+	}{
+		{
+			name: "permissive mode wildcard route",
+			rule: &trafficpolicy.Rule{
+				Route: trafficpolicy.RouteWeightedClusters{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
+				},
+				AllowedServiceAccounts: mapset.NewSet(wildcardServiceAccount),
+			},
+			expectedSellServiceAccount: mapset.NewSet(wildcardServiceAccount),
+		},
+		{
+			name: "TrafficTarget route broader than the selected route",
+			rule: &trafficpolicy.Rule{
+				Route: trafficpolicy.RouteWeightedClusters{
+					HTTPRouteMatch:   broaderRouteMatch,
+					WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
+				},
+				AllowedServiceAccounts: mapset.NewSet(tests.BookbuyerServiceAccount),
+			},
+			expectedSellServiceAccount: mapset.NewSet(tests.BookbuyerServiceAccount),
+		},
+		{
+			name: "no route covering the selected route",
+			rule: &trafficpolicy.Rule{
+				Route: trafficpolicy.RouteWeightedClusters{
+					HTTPRouteMatch:   tests.BookstoreBuyHTTPRoute,
+					WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
+				},
+				AllowedServiceAccounts: mapset.NewSet(tests.BookbuyerServiceAccount),
+			},
+			expectedSellServiceAccount: mapset.NewSet(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				meshSpec:         smi.NewFakeMeshSpecClient(),
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(svc).Return(authzPolicy).Times(1)
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+
+			inboundPolicy := &trafficpolicy.InboundTrafficPolicy{
+				Rules: []*trafficpolicy.Rule{tc.rule},
+			}
+
+			mc.applyAuthorizationPolicy(svc, inboundPolicy)
+
+			// A rule for the selected route precedes the given rule
+			assert.Len(inboundPolicy.Rules, 2)
+			sellRule := inboundPolicy.Rules[0]
+			assert.Equal(tests.BookstoreSellHTTPRoute, sellRule.Route.HTTPRouteMatch)
+			assert.True(tc.expectedSellServiceAccount.Equal(sellRule.AllowedServiceAccounts))
+			assert.Equal([]policyv1alpha1.AuthorizationConditions{adminConditions}, sellRule.AuthorizationConditions)
+
+			assert.Equal(tc.rule, inboundPolicy.Rules[1])
+			assert.Nil(inboundPolicy.Rules[1].AuthorizationConditions)
+		})
+	}
+}
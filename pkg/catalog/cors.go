@@ -0,0 +1,17 @@
+package catalog
+
+import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// GetCORSPolicy returns the CORSPolicySpec for the given service.
+// If no CORSPolicy policy applies to the service, nil is returned.
+func (mc *MeshCatalog) GetCORSPolicy(svc service.MeshService) *policyv1alpha1.CORSPolicySpec {
+	corsPolicy := mc.policyController.GetCORSPolicy(svc)
+	if corsPolicy == nil {
+		return nil
+	}
+
+	return corsPolicy.Spec.DeepCopy()
+}
@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetCORSPolicy(t *testing.T) {
+	svc := service.MeshService{Name: "s1", Namespace: "test"}
+
+	testCases := []struct {
+		name         string
+		corsPolicy   *policyv1alpha1.CORSPolicy
+		expectedSpec *policyv1alpha1.CORSPolicySpec
+	}{
+		{
+			name:         "no CORSPolicy policy for the service",
+			corsPolicy:   nil,
+			expectedSpec: nil,
+		},
+		{
+			name: "CORSPolicy policy found for the service",
+			corsPolicy: &policyv1alpha1.CORSPolicy{
+				Spec: policyv1alpha1.CORSPolicySpec{
+					Host:             "s1.test.svc.cluster.local",
+					AllowOrigins:     []string{"https://example.com"},
+					AllowCredentials: true,
+				},
+			},
+			expectedSpec: &policyv1alpha1.CORSPolicySpec{
+				Host:             "s1.test.svc.cluster.local",
+				AllowOrigins:     []string{"https://example.com"},
+				AllowCredentials: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetCORSPolicy(svc).Return(tc.corsPolicy).Times(1)
+
+			actual := mc.GetCORSPolicy(svc)
+			assert.Equal(tc.expectedSpec, actual)
+		})
+	}
+}
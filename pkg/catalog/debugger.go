@@ -3,8 +3,9 @@ package catalog
 import (
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
-	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/identity"
 )
 
@@ -28,3 +29,8 @@ func (mc *MeshCatalog) ListMonitoredNamespaces() []string {
 
 	return namespaces
 }
+
+// ListUpstreamTrafficSettings returns all UpstreamTrafficSetting policies OSM is aware of.
+func (mc *MeshCatalog) ListUpstreamTrafficSettings() []*policyv1alpha1.UpstreamTrafficSetting {
+	return mc.policyController.ListUpstreamTrafficSettings()
+}
@@ -0,0 +1,84 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+
+	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// namespaceKind is the kind of a Deny policy source denying the traffic from all the service accounts in a namespace
+	namespaceKind = "Namespace"
+)
+
+// ListInboundDenyRules returns the deny rules for the Deny policies applied to the given destination service identity
+// Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
+func (mc *MeshCatalog) ListInboundDenyRules(upstream identity.ServiceIdentity) []*trafficpolicy.DenyRule {
+	var denyRules []*trafficpolicy.DenyRule
+
+	for _, deny := range mc.policyController.ListDenyPolicies(upstream.ToK8sServiceAccount()) {
+		denyRule := &trafficpolicy.DenyRule{
+			Name: fmt.Sprintf("%s/%s", deny.Namespace, deny.Name),
+		}
+
+		for _, source := range deny.Spec.Sources {
+			switch source.Kind {
+			case serviceAccountKind:
+				namespace := source.Namespace
+				if namespace == "" {
+					namespace = deny.Namespace
+				}
+				denyRule.Sources = append(denyRule.Sources, identity.K8sServiceAccount{
+					Name:      source.Name,
+					Namespace: namespace,
+				})
+
+			case namespaceKind:
+				denyRule.SourceNamespaces = append(denyRule.SourceNamespaces, source.Name)
+
+			default:
+				log.Error().Msgf("Ignoring source %s of kind %s in Deny policy %s: unsupported kind", source.Name, source.Kind, denyRule.Name)
+			}
+		}
+
+		if len(deny.Spec.Sources) != 0 && len(denyRule.Sources) == 0 && len(denyRule.SourceNamespaces) == 0 {
+			// None of the sources is valid, do not deny the traffic from all sources
+			log.Error().Msgf("Skipping Deny policy %s: no valid sources", denyRule.Name)
+			continue
+		}
+
+		if len(deny.Spec.Routes) != 0 {
+			var trafficTargetRules []access.TrafficTargetRule
+			numMatches := 0
+			for _, route := range deny.Spec.Routes {
+				trafficTargetRules = append(trafficTargetRules, access.TrafficTargetRule{
+					Kind:    httpRouteGroupKind,
+					Name:    route.HTTPRouteGroup,
+					Matches: route.Matches,
+				})
+				numMatches += len(route.Matches)
+			}
+
+			routeMatches, err := mc.routesFromRules(trafficTargetRules, deny.Namespace)
+			if err == nil && len(routeMatches) < numMatches {
+				err = errors.Errorf("Only %d of the %d matches of the HTTPRouteGroups referenced by the policy were found", len(routeMatches), numMatches)
+			}
+			if err != nil {
+				// The routes could not be resolved, deny all the traffic from the sources rather than allowing it on the routes
+				log.Error().Err(err).Str(errcode.Kind, errcode.ErrFetchingSMIHTTPRouteGroupForTrafficTarget.String()).
+					Msgf("Error finding route matches from Deny policy %s, denying all the traffic from its sources", denyRule.Name)
+			} else {
+				denyRule.HTTPRouteMatches = routeMatches
+			}
+		}
+
+		denyRules = append(denyRules, denyRule)
+	}
+
+	return denyRules
+}
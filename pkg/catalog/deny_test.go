@@ -0,0 +1,151 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/smi"
+	"github.com/openservicemesh/osm/pkg/tests"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestListInboundDenyRules(t *testing.T) {
+	testCases := []struct {
+		name              string
+		denyPolicies      []*policyv1alpha1.Deny
+		expectedDenyRules []*trafficpolicy.DenyRule
+	}{
+		{
+			name:              "no Deny policy for the service identity",
+			denyPolicies:      nil,
+			expectedDenyRules: nil,
+		},
+		{
+			name: "Deny policy without sources and routes",
+			denyPolicies: []*policyv1alpha1.Deny{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "deny-all", Namespace: tests.Namespace},
+				},
+			},
+			expectedDenyRules: []*trafficpolicy.DenyRule{
+				{
+					Name: "default/deny-all",
+				},
+			},
+		},
+		{
+			name: "Deny policy with service account and namespace sources and routes",
+			denyPolicies: []*policyv1alpha1.Deny{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "deny-sell", Namespace: tests.Namespace},
+					Spec: policyv1alpha1.DenySpec{
+						ServiceAccount: tests.BookstoreServiceAccountName,
+						Sources: []policyv1alpha1.DenySourceSpec{
+							{Kind: "ServiceAccount", Name: tests.BookbuyerServiceAccountName},
+							{Kind: "ServiceAccount", Name: "thief", Namespace: "untrusted"},
+							{Kind: "Namespace", Name: "test"},
+						},
+						Routes: []policyv1alpha1.AuthorizationRouteRef{
+							{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName}},
+						},
+					},
+				},
+			},
+			expectedDenyRules: []*trafficpolicy.DenyRule{
+				{
+					Name: "default/deny-sell",
+					Sources: []identity.K8sServiceAccount{
+						tests.BookbuyerServiceAccount,
+						{Name: "thief", Namespace: "untrusted"},
+					},
+					SourceNamespaces: []string{"test"},
+					HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{tests.BookstoreSellHTTPRoute},
+				},
+			},
+		},
+		{
+			name: "Deny policy with routes that do not exist denies all the traffic from its sources",
+			denyPolicies: []*policyv1alpha1.Deny{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "deny-unknown-route", Namespace: tests.Namespace},
+					Spec: policyv1alpha1.DenySpec{
+						Sources: []policyv1alpha1.DenySourceSpec{
+							{Kind: "ServiceAccount", Name: tests.BookbuyerServiceAccountName},
+						},
+						Routes: []policyv1alpha1.AuthorizationRouteRef{
+							{HTTPRouteGroup: "does-not-exist", Matches: []string{tests.SellBooksMatchName}},
+						},
+					},
+				},
+			},
+			expectedDenyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:    "default/deny-unknown-route",
+					Sources: []identity.K8sServiceAccount{tests.BookbuyerServiceAccount},
+				},
+			},
+		},
+		{
+			name: "Deny policy with matches that do not exist denies all the traffic from its sources",
+			denyPolicies: []*policyv1alpha1.Deny{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "deny-unknown-match", Namespace: tests.Namespace},
+					Spec: policyv1alpha1.DenySpec{
+						Sources: []policyv1alpha1.DenySourceSpec{
+							{Kind: "ServiceAccount", Name: tests.BookbuyerServiceAccountName},
+						},
+						Routes: []policyv1alpha1.AuthorizationRouteRef{
+							{HTTPRouteGroup: tests.RouteGroupName, Matches: []string{tests.SellBooksMatchName, "does-not-exist"}},
+						},
+					},
+				},
+			},
+			expectedDenyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:    "default/deny-unknown-match",
+					Sources: []identity.K8sServiceAccount{tests.BookbuyerServiceAccount},
+				},
+			},
+		},
+		{
+			name: "Deny policy with only invalid sources is skipped",
+			denyPolicies: []*policyv1alpha1.Deny{
+				{
+					ObjectMeta: v1.ObjectMeta{Name: "deny-invalid", Namespace: tests.Namespace},
+					Spec: policyv1alpha1.DenySpec{
+						Sources: []policyv1alpha1.DenySourceSpec{
+							{Kind: "Pod", Name: "bookbuyer"},
+						},
+					},
+				},
+			},
+			expectedDenyRules: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				meshSpec:         smi.NewFakeMeshSpecClient(),
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().ListDenyPolicies(tests.BookstoreServiceAccount).Return(tc.denyPolicies).Times(1)
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+
+			actual := mc.ListInboundDenyRules(tests.BookstoreServiceIdentity)
+			assert.Equal(tc.expectedDenyRules, actual)
+		})
+	}
+}
@@ -42,6 +42,17 @@ func (mc *MeshCatalog) dispatcher() {
 		a.IngressAdded, a.IngressDeleted, a.IngressUpdated, // Ingress
 		a.TCPRouteAdded, a.TCPRouteDeleted, a.TCPRouteUpdated, // TCProute
 		a.EgressAdded, a.EgressDeleted, a.EgressUpdated, // Egress
+		a.RetryPolicyAdded, a.RetryPolicyDeleted, a.RetryPolicyUpdated, // Retry
+		a.UpstreamTrafficSettingAdded, a.UpstreamTrafficSettingDeleted, a.UpstreamTrafficSettingUpdated, // UpstreamTrafficSetting
+		a.RateLimitPolicyAdded, a.RateLimitPolicyDeleted, a.RateLimitPolicyUpdated, // RateLimit
+		a.FaultInjectionPolicyAdded, a.FaultInjectionPolicyDeleted, a.FaultInjectionPolicyUpdated, // FaultInjection
+		a.HeaderModifierPolicyAdded, a.HeaderModifierPolicyDeleted, a.HeaderModifierPolicyUpdated, // HeaderModifier
+		a.TrafficMirrorPolicyAdded, a.TrafficMirrorPolicyDeleted, a.TrafficMirrorPolicyUpdated, // TrafficMirror
+		a.GRPCRouteGroupAdded, a.GRPCRouteGroupDeleted, a.GRPCRouteGroupUpdated, // GRPCRouteGroup
+		a.CORSPolicyAdded, a.CORSPolicyDeleted, a.CORSPolicyUpdated, // CORSPolicy
+		a.RequestAuthenticationAdded, a.RequestAuthenticationDeleted, a.RequestAuthenticationUpdated, // RequestAuthentication
+		a.AuthorizationPolicyAdded, a.AuthorizationPolicyDeleted, a.AuthorizationPolicyUpdated, // AuthorizationPolicy
+		a.DenyPolicyAdded, a.DenyPolicyDeleted, a.DenyPolicyUpdated, // Deny
 	)
 
 	// State and channels for event-coalescing
@@ -194,9 +194,10 @@ func (mc *MeshCatalog) buildHTTPRouteConfigs(egressPolicy *policyV1alpha1.Egress
 
 		// Hostnames and routing rules are computed for the given host, build an HTTP route config for it
 		hostSpecificRouteConfig := &trafficpolicy.EgressHTTPRouteConfig{
-			Name:         host,
-			Hostnames:    hostnames,
-			RoutingRules: httpRoutingRules,
+			Name:           host,
+			Hostnames:      hostnames,
+			RoutingRules:   httpRoutingRules,
+			HeaderModifier: egressPolicy.Spec.HeaderModifier.DeepCopy(),
 		}
 
 		routeConfigs = append(routeConfigs, hostSpecificRouteConfig)
@@ -210,6 +211,10 @@ func getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup *smiSpecs.HTTPRouteGro
 		return nil
 	}
 
+	requestTimeouts := getRouteTimeoutsFromAnnotation(httpRouteGroup, constants.RequestTimeoutAnnotation)
+	idleTimeouts := getRouteTimeoutsFromAnnotation(httpRouteGroup, constants.IdleTimeoutAnnotation)
+	queryParams := getRouteQueryParamsFromAnnotation(httpRouteGroup)
+
 	var matches []trafficpolicy.HTTPRouteMatch
 	for _, match := range httpRouteGroup.Spec.Matches {
 		httpRouteMatch := trafficpolicy.HTTPRouteMatch{
@@ -217,6 +222,7 @@ func getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup *smiSpecs.HTTPRouteGro
 			PathMatchType: trafficpolicy.PathMatchRegex,
 			Methods:       match.Methods,
 			Headers:       match.Headers,
+			QueryParams:   queryParams[match.Name],
 		}
 
 		// When pathRegex and/or methods are not defined, they should be wildcarded
@@ -226,6 +232,12 @@ func getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup *smiSpecs.HTTPRouteGro
 		if len(httpRouteMatch.Methods) == 0 {
 			httpRouteMatch.Methods = []string{constants.WildcardHTTPMethod}
 		}
+		if timeout, ok := requestTimeouts[match.Name]; ok {
+			httpRouteMatch.Timeout = &timeout
+		}
+		if idleTimeout, ok := idleTimeouts[match.Name]; ok {
+			httpRouteMatch.IdleTimeout = &idleTimeout
+		}
 
 		matches = append(matches, httpRouteMatch)
 	}
@@ -3,6 +3,7 @@ package catalog
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	mapset "github.com/deckarep/golang-set"
 	"github.com/golang/mock/gomock"
@@ -16,6 +17,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
 	policyV1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/policy"
 
@@ -648,6 +650,57 @@ func TestBuildHTTPRouteConfigs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "egress policy with header modifications",
+			egressPolicy: &policyV1alpha1.Egress{
+				Spec: policyV1alpha1.EgressSpec{
+					Hosts: []string{
+						"foo.com",
+					},
+					Ports: []policyV1alpha1.PortSpec{
+						{
+							Number:   80,
+							Protocol: "http",
+						},
+					},
+					HeaderModifier: &policyV1alpha1.HTTPHeaderModifierSpec{
+						RequestHeadersToRemove: []string{"x-internal-token"},
+					},
+				},
+			},
+			egressPort:      80,
+			httpRouteGroups: nil,
+			expectedRouteConfigs: []*trafficpolicy.EgressHTTPRouteConfig{
+				{
+					Name: "foo.com",
+					Hostnames: []string{
+						"foo.com",
+						"foo.com:80",
+					},
+					RoutingRules: []*trafficpolicy.EgressHTTPRoutingRule{
+						{
+							Route: trafficpolicy.RouteWeightedClusters{
+								HTTPRouteMatch: trafficpolicy.WildCardRouteMatch,
+								WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+									service.WeightedCluster{ClusterName: service.ClusterName("foo.com:80"), Weight: 100},
+								}),
+							},
+							AllowedDestinationIPRanges: nil,
+						},
+					},
+					HeaderModifier: &policyV1alpha1.HTTPHeaderModifierSpec{
+						RequestHeadersToRemove: []string{"x-internal-token"},
+					},
+				},
+			},
+			expectedClusterConfigs: []*trafficpolicy.EgressClusterConfig{
+				{
+					Name: "foo.com:80",
+					Host: "foo.com",
+					Port: 80,
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -672,6 +725,9 @@ func TestBuildHTTPRouteConfigs(t *testing.T) {
 func TestGetHTTPRouteMatchesFromHTTPRouteGroup(t *testing.T) {
 	assert := tassert.New(t)
 
+	requestTimeout := 30 * time.Second
+	idleTimeout := time.Minute
+
 	testCases := []struct {
 		name            string
 		httpRouteGroup  *specs.HTTPRouteGroup
@@ -712,6 +768,49 @@ func TestGetHTTPRouteMatchesFromHTTPRouteGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "HTTP route matches with timeouts",
+			httpRouteGroup: &specs.HTTPRouteGroup{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "specs.smi-spec.io/v1alpha4",
+					Kind:       "HTTPRouteGroup",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.RequestTimeoutAnnotation: "match-1=30s",
+						constants.IdleTimeoutAnnotation:    "match-2=1m",
+					},
+				},
+				Spec: spec.HTTPRouteGroupSpec{
+					Matches: []specs.HTTPMatch{
+						{
+							Name:      "match-1",
+							PathRegex: "/foo",
+							Methods:   []string{"GET"},
+						},
+						{
+							Name:      "match-2",
+							PathRegex: "/bar",
+							Methods:   []string{"GET"},
+						},
+					},
+				},
+			},
+			expectedMatches: []trafficpolicy.HTTPRouteMatch{
+				{
+					Path:          "/foo",
+					PathMatchType: trafficpolicy.PathMatchRegex,
+					Methods:       []string{"GET"},
+					Timeout:       &requestTimeout,
+				},
+				{
+					Path:          "/bar",
+					PathMatchType: trafficpolicy.PathMatchRegex,
+					Methods:       []string{"GET"},
+					IdleTimeout:   &idleTimeout,
+				},
+			},
+		},
 		{
 			name:            "nil HTTPRouteGroup",
 			httpRouteGroup:  nil,
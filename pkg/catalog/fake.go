@@ -95,6 +95,16 @@ func NewFakeMeshCatalog(kubeClient kubernetes.Interface, meshConfigClient versio
 
 		return vv
 	}).AnyTimes()
+	mockKubeController.EXPECT().GetEndpoints(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockKubeController.EXPECT().GetNamespace(gomock.Any()).DoAndReturn(func(ns string) *corev1.Namespace {
+		// play pretend this call queries a controller cache
+		vv, err := kubeClient.CoreV1().Namespaces().Get(context.Background(), ns, metav1.GetOptions{})
+		if err != nil {
+			return nil
+		}
+
+		return vv
+	}).AnyTimes()
 	mockKubeController.EXPECT().ListPods().DoAndReturn(func() []*corev1.Pod {
 		vv, err := kubeClient.CoreV1().Pods("").List(context.Background(), metav1.ListOptions{})
 		if err != nil {
@@ -118,6 +128,18 @@ func NewFakeMeshCatalog(kubeClient kubernetes.Interface, meshConfigClient versio
 	mockKubeController.EXPECT().IsMetricsEnabled(gomock.Any()).Return(true).AnyTimes()
 
 	mockPolicyController.EXPECT().ListEgressPoliciesForSourceIdentity(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListRetryPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListDenyPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetRequestAuthenticationPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
 
 	return NewMeshCatalog(mockKubeController, meshSpec, certManager,
 		mockIngressMonitor, mockPolicyController, stop, cfg, serviceProviders, endpointProviders)
@@ -228,6 +250,7 @@ func newFakeMeshCatalog() *MeshCatalog {
 
 		return vv
 	}).AnyTimes()
+	mockKubeController.EXPECT().GetEndpoints(gomock.Any()).Return(nil, nil).AnyTimes()
 	mockKubeController.EXPECT().IsMonitoredNamespace(tests.BookstoreV1Service.Namespace).Return(true).AnyTimes()
 	mockKubeController.EXPECT().IsMonitoredNamespace(tests.BookstoreV2Service.Namespace).Return(true).AnyTimes()
 	mockKubeController.EXPECT().IsMonitoredNamespace(tests.BookbuyerService.Namespace).Return(true).AnyTimes()
@@ -235,6 +258,18 @@ func newFakeMeshCatalog() *MeshCatalog {
 	mockKubeController.EXPECT().ListMonitoredNamespaces().Return(listExpectedNs, nil).AnyTimes()
 
 	mockPolicyController.EXPECT().ListEgressPoliciesForSourceIdentity(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListRetryPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListDenyPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetRequestAuthenticationPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
 
 	return NewMeshCatalog(mockKubeController, meshSpec, certManager,
 		mockIngressMonitor, mockPolicyController, stop, cfg, serviceProviders, endpointProviders)
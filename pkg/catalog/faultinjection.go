@@ -0,0 +1,72 @@
+package catalog
+
+import (
+	"fmt"
+
+	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// applyFaultInjectionPolicies returns the given outbound routes to the upstream service with the FaultInjection
+// policies for the upstream service applied to them.
+// A FaultInjection policy without matches applies to all the given routes, while a FaultInjection policy with
+// HTTPRouteGroup matches applies to the routes whose match is covered by a selected match, and results in new routes
+// derived from the routes whose match overlaps a selected match, see applyToHTTPRouteMatch.
+// If multiple FaultInjection policies select the same requests, the first one found is applied.
+func (mc *MeshCatalog) applyFaultInjectionPolicies(upstreamSvc service.MeshService, routes []*trafficpolicy.RouteWeightedClusters) []*trafficpolicy.RouteWeightedClusters {
+	injectedIntoAllRequests := false
+
+	for _, faultInjection := range mc.policyController.ListFaultInjectionPolicies(upstreamSvc) {
+		if faultInjection.Spec.Delay == nil && faultInjection.Spec.Abort == nil {
+			log.Error().Msgf("FaultInjection policy %s/%s does not specify a delay or an abort, skipping it", faultInjection.Namespace, faultInjection.Name)
+			continue
+		}
+
+		if len(faultInjection.Spec.Matches) == 0 {
+			if injectedIntoAllRequests {
+				log.Warn().Msgf("Skipping FaultInjection policy %s/%s as faults are already injected into all the requests to upstream service %s",
+					faultInjection.Namespace, faultInjection.Name, upstreamSvc)
+				continue
+			}
+			// The routes of the requests selected by a preceding FaultInjection policy with matches keep its faults
+			for _, route := range routes {
+				if route.FaultInjection == nil {
+					route.FaultInjection = faultInjection.Spec.DeepCopy()
+				}
+			}
+			injectedIntoAllRequests = true
+			continue
+		}
+
+		// A TypedLocalObjectReference (Spec.Matches) is a reference to another object in the same namespace
+		var httpRouteMatches []trafficpolicy.HTTPRouteMatch
+		for _, match := range faultInjection.Spec.Matches {
+			if !matchesAPIGroup(match.APIGroup, smiSpecs.SchemeGroupVersion) || match.Kind != httpRouteGroupKind {
+				log.Error().Msgf("Unsupported match object %v specified in FaultInjection policy %s/%s, ignoring it", match, faultInjection.Namespace, faultInjection.Name)
+				continue
+			}
+			httpRouteName := fmt.Sprintf("%s/%s", faultInjection.Namespace, match.Name)
+			httpRouteGroup := mc.meshSpec.GetHTTPRouteGroup(httpRouteName)
+			if httpRouteGroup == nil {
+				log.Error().Msgf("Error fetching HTTPRouteGroup resource %s referenced in FaultInjection policy %s/%s", httpRouteName, faultInjection.Namespace, faultInjection.Name)
+				continue
+			}
+			httpRouteMatches = append(httpRouteMatches, getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup)...)
+		}
+
+		faultInjectionSpec := faultInjection.Spec
+		for _, httpRouteMatch := range httpRouteMatches {
+			routes = applyToHTTPRouteMatch(routes, httpRouteMatch,
+				func(route *trafficpolicy.RouteWeightedClusters) bool {
+					return route.FaultInjection != nil
+				},
+				func(route *trafficpolicy.RouteWeightedClusters) {
+					route.FaultInjection = faultInjectionSpec.DeepCopy()
+				})
+		}
+	}
+
+	return routes
+}
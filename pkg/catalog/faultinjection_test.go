@@ -0,0 +1,339 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/smi"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestApplyFaultInjectionPolicies(t *testing.T) {
+	upstreamSvc := service.MeshService{Name: "s1", Namespace: "test"}
+	weightedCluster := service.WeightedCluster{ClusterName: "test/s1/local", Weight: 100}
+
+	apiGroup := smiSpecs.SchemeGroupVersion.String()
+	httpRouteGroup := &smiSpecs.HTTPRouteGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-routes",
+			Namespace: "test",
+		},
+		Spec: smiSpecs.HTTPRouteGroupSpec{
+			Matches: []smiSpecs.HTTPMatch{
+				{
+					Name:      "checkout",
+					PathRegex: "/checkout",
+					Methods:   []string{"POST"},
+				},
+			},
+		},
+	}
+
+	delayFault := policyv1alpha1.FaultInjectionSpec{
+		Host: "s1.test.svc.cluster.local",
+		Delay: &policyv1alpha1.FaultDelaySpec{
+			Duration:   metav1.Duration{Duration: 5 * time.Second},
+			Percentage: 50,
+		},
+	}
+	otherDelayFault := policyv1alpha1.FaultInjectionSpec{
+		Host: "s1.test.svc.cluster.local",
+		Delay: &policyv1alpha1.FaultDelaySpec{
+			Duration: metav1.Duration{Duration: time.Second},
+		},
+	}
+	abortFault := policyv1alpha1.FaultInjectionSpec{
+		Host: "s1.test.svc.cluster.local",
+		Matches: []corev1.TypedLocalObjectReference{
+			{APIGroup: &apiGroup, Kind: "HTTPRouteGroup", Name: "s1-routes"},
+		},
+		Abort: &policyv1alpha1.FaultAbortSpec{
+			HTTPStatus: 503,
+			Percentage: 10,
+		},
+	}
+
+	// The API group of a match may be omitted, or specify the group only
+	groupOnly := smiSpecs.SchemeGroupVersion.Group
+	abortFaultWithoutAPIGroup := *abortFault.DeepCopy()
+	abortFaultWithoutAPIGroup.Matches[0].APIGroup = nil
+	abortFaultWithGroupOnly := *abortFault.DeepCopy()
+	abortFaultWithGroupOnly.Matches[0].APIGroup = &groupOnly
+	unsupportedAPIGroup := "specs.example.io"
+	abortFaultWithUnsupportedAPIGroup := *abortFault.DeepCopy()
+	abortFaultWithUnsupportedAPIGroup.Matches[0].APIGroup = &unsupportedAPIGroup
+
+	checkoutRouteMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          "/checkout",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"POST"},
+	}
+
+	canaryCluster := service.WeightedCluster{ClusterName: "test/s1-v2/local", Weight: 100}
+	canaryRouteMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          ".*",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"*"},
+		Headers:       map[string]string{"x-canary": "true"},
+	}
+	canaryCheckoutRouteMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          "/checkout",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"POST"},
+		Headers:       map[string]string{"x-canary": "true"},
+	}
+
+	testCases := []struct {
+		name            string
+		faultInjections []*policyv1alpha1.FaultInjection
+		routes          []*trafficpolicy.RouteWeightedClusters
+		expectedRoutes  []*trafficpolicy.RouteWeightedClusters
+	}{
+		{
+			name:            "no FaultInjection policy for the upstream service",
+			faultInjections: nil,
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy without delay or abort is ignored",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{Spec: policyv1alpha1.FaultInjectionSpec{Host: "s1.test.svc.cluster.local"}},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy without matches applies to the wildcard route",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{Spec: delayFault},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &delayFault,
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy without matches is skipped when faults are already injected into all the requests",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{Spec: delayFault},
+				{Spec: otherDelayFault},
+			},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   canaryRouteMatch,
+					WeightedClusters: mapset.NewSet(canaryCluster),
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   canaryRouteMatch,
+					WeightedClusters: mapset.NewSet(canaryCluster),
+					FaultInjection:   &delayFault,
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &delayFault,
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy without matches does not override the faults of a preceding FaultInjection policy with matches",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+					Spec:       abortFault,
+				},
+				{Spec: delayFault},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   checkoutRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &abortFault,
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &delayFault,
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy with matches adds a route preceding the wildcard route",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+					Spec:       abortFault,
+				},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+						Path:          "/checkout",
+						PathMatchType: trafficpolicy.PathMatchRegex,
+						Methods:       []string{"POST"},
+					},
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &abortFault,
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy with a match without API group",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+					Spec:       abortFaultWithoutAPIGroup,
+				},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   checkoutRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &abortFaultWithoutAPIGroup,
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy with a match with the API group without version",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+					Spec:       abortFaultWithGroupOnly,
+				},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   checkoutRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &abortFaultWithGroupOnly,
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy with a match with an unsupported API group is ignored",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+					Spec:       abortFaultWithUnsupportedAPIGroup,
+				},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+		{
+			name: "FaultInjection policy with matches adds routes derived from the overlapping TrafficSplit match route and wildcard route",
+			faultInjections: []*policyv1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+					Spec:       abortFault,
+				},
+			},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   canaryRouteMatch,
+					WeightedClusters: mapset.NewSet(canaryCluster),
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   canaryCheckoutRouteMatch,
+					WeightedClusters: mapset.NewSet(canaryCluster),
+					FaultInjection:   &abortFault,
+				},
+				{
+					HTTPRouteMatch:   canaryRouteMatch,
+					WeightedClusters: mapset.NewSet(canaryCluster),
+				},
+				{
+					HTTPRouteMatch:   checkoutRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+					FaultInjection:   &abortFault,
+				},
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(weightedCluster),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+
+			mc := &MeshCatalog{
+				meshSpec:         mockMeshSpec,
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().ListFaultInjectionPolicies(upstreamSvc).Return(tc.faultInjections).Times(1)
+			mockMeshSpec.EXPECT().GetHTTPRouteGroup("test/s1-routes").Return(httpRouteGroup).AnyTimes()
+
+			routes := tc.routes
+			if routes == nil {
+				routes = []*trafficpolicy.RouteWeightedClusters{
+					{
+						HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+						WeightedClusters: mapset.NewSet(weightedCluster),
+					},
+				}
+			}
+
+			actual := mc.applyFaultInjectionPolicies(upstreamSvc, routes)
+			assert.Equal(tc.expectedRoutes, actual)
+		})
+	}
+}
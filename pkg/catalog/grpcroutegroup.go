@@ -0,0 +1,50 @@
+package catalog
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// grpcNameRegex matches any gRPC service or method name in the path of a gRPC request
+	grpcNameRegex = "[^/]+"
+)
+
+// getHTTPRouteMatchFromGRPCMatch returns the HTTP route match corresponding to the given gRPC match.
+// gRPC requests are HTTP/2 POST requests whose path is formatted as /<package>.<service>/<method>, so
+// the service and method of the match are converted into a path regex.
+func getHTTPRouteMatchFromGRPCMatch(match policyv1alpha1.GRPCMatch) trafficpolicy.HTTPRouteMatch {
+	serviceRegex := grpcNameRegex
+	if match.Service != "" {
+		serviceRegex = regexp.QuoteMeta(match.Service)
+	}
+	methodRegex := grpcNameRegex
+	if match.Method != "" {
+		methodRegex = regexp.QuoteMeta(match.Method)
+	}
+
+	return trafficpolicy.HTTPRouteMatch{
+		Path:          fmt.Sprintf("/%s/%s", serviceRegex, methodRegex),
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{http.MethodPost},
+		Headers:       match.Headers,
+	}
+}
+
+// getHTTPRouteMatchesFromGRPCRouteGroup returns the HTTP route matches corresponding to the matches of the given GRPCRouteGroup
+func getHTTPRouteMatchesFromGRPCRouteGroup(grpcRouteGroup *policyv1alpha1.GRPCRouteGroup) []trafficpolicy.HTTPRouteMatch {
+	if grpcRouteGroup == nil {
+		return nil
+	}
+
+	var matches []trafficpolicy.HTTPRouteMatch
+	for _, match := range grpcRouteGroup.Spec.Matches {
+		matches = append(matches, getHTTPRouteMatchFromGRPCMatch(match))
+	}
+
+	return matches
+}
@@ -0,0 +1,84 @@
+package catalog
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetHTTPRouteMatchFromGRPCMatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		match    policyv1alpha1.GRPCMatch
+		expected trafficpolicy.HTTPRouteMatch
+	}{
+		{
+			name:  "service and method",
+			match: policyv1alpha1.GRPCMatch{Name: "say-hello", Service: "helloworld.Greeter", Method: "SayHello"},
+			expected: trafficpolicy.HTTPRouteMatch{
+				Path:          `/helloworld\.Greeter/SayHello`,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{"POST"},
+			},
+		},
+		{
+			name:  "all methods of a service",
+			match: policyv1alpha1.GRPCMatch{Name: "greeter", Service: "helloworld.Greeter"},
+			expected: trafficpolicy.HTTPRouteMatch{
+				Path:          `/helloworld\.Greeter/[^/]+`,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{"POST"},
+			},
+		},
+		{
+			name: "all services with metadata",
+			match: policyv1alpha1.GRPCMatch{
+				Name:    "canary",
+				Headers: map[string]string{"x-canary": "true"},
+			},
+			expected: trafficpolicy.HTTPRouteMatch{
+				Path:          `/[^/]+/[^/]+`,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{"POST"},
+				Headers:       map[string]string{"x-canary": "true"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(tc.expected, getHTTPRouteMatchFromGRPCMatch(tc.match))
+		})
+	}
+}
+
+func TestGetHTTPRouteMatchesFromGRPCRouteGroup(t *testing.T) {
+	assert := tassert.New(t)
+
+	assert.Nil(getHTTPRouteMatchesFromGRPCRouteGroup(nil))
+
+	grpcRouteGroup := &policyv1alpha1.GRPCRouteGroup{
+		Spec: policyv1alpha1.GRPCRouteGroupSpec{
+			Matches: []policyv1alpha1.GRPCMatch{
+				{Name: "say-hello", Service: "helloworld.Greeter", Method: "SayHello"},
+				{Name: "health", Service: "grpc.health.v1.Health"},
+			},
+		},
+	}
+	assert.Equal([]trafficpolicy.HTTPRouteMatch{
+		{
+			Path:          `/helloworld\.Greeter/SayHello`,
+			PathMatchType: trafficpolicy.PathMatchRegex,
+			Methods:       []string{"POST"},
+		},
+		{
+			Path:          `/grpc\.health\.v1\.Health/[^/]+`,
+			PathMatchType: trafficpolicy.PathMatchRegex,
+			Methods:       []string{"POST"},
+		},
+	}, getHTTPRouteMatchesFromGRPCRouteGroup(grpcRouteGroup))
+}
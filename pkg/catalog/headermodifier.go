@@ -0,0 +1,17 @@
+package catalog
+
+import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// getHeaderModifierPolicy returns the HeaderModifierSpec for the given service.
+// If no HeaderModifier policy applies to the service, nil is returned.
+func (mc *MeshCatalog) getHeaderModifierPolicy(svc service.MeshService) *policyv1alpha1.HeaderModifierSpec {
+	headerModifier := mc.policyController.GetHeaderModifierPolicy(svc)
+	if headerModifier == nil {
+		return nil
+	}
+
+	return headerModifier.Spec.DeepCopy()
+}
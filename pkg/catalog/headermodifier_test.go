@@ -0,0 +1,63 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetHeaderModifierPolicy(t *testing.T) {
+	svc := service.MeshService{Name: "s1", Namespace: "test"}
+
+	testCases := []struct {
+		name           string
+		headerModifier *policyv1alpha1.HeaderModifier
+		expectedSpec   *policyv1alpha1.HeaderModifierSpec
+	}{
+		{
+			name:           "no HeaderModifier policy for the service",
+			headerModifier: nil,
+			expectedSpec:   nil,
+		},
+		{
+			name: "HeaderModifier policy found for the service",
+			headerModifier: &policyv1alpha1.HeaderModifier{
+				Spec: policyv1alpha1.HeaderModifierSpec{
+					Host: "s1.test.svc.cluster.local",
+					Inbound: &policyv1alpha1.HTTPHeaderModifierSpec{
+						ResponseHeadersToSet: []policyv1alpha1.HTTPHeaderSpec{{Name: "x-frame-options", Value: "DENY"}},
+					},
+				},
+			},
+			expectedSpec: &policyv1alpha1.HeaderModifierSpec{
+				Host: "s1.test.svc.cluster.local",
+				Inbound: &policyv1alpha1.HTTPHeaderModifierSpec{
+					ResponseHeadersToSet: []policyv1alpha1.HTTPHeaderSpec{{Name: "x-frame-options", Value: "DENY"}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(svc).Return(tc.headerModifier).Times(1)
+
+			actual := mc.getHeaderModifierPolicy(svc)
+			assert.Equal(tc.expectedSpec, actual)
+		})
+	}
+}
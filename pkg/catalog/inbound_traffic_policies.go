@@ -22,11 +22,11 @@ const (
 )
 
 // ListInboundTrafficPolicies returns all inbound traffic policies
-// 1. from service discovery for permissive mode
+// 1. from service discovery when permissive mode is enabled for the given service account
 // 2. for the given service account and upstream services from SMI Traffic Target and Traffic Split
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 func (mc *MeshCatalog) ListInboundTrafficPolicies(upstreamIdentity identity.ServiceIdentity, upstreamServices []service.MeshService) []*trafficpolicy.InboundTrafficPolicy {
-	if mc.configurator.IsPermissiveTrafficPolicyMode() {
+	if mc.IsPermissiveTrafficPolicyMode(upstreamIdentity) {
 		var inboundPolicies []*trafficpolicy.InboundTrafficPolicy
 		for _, svc := range upstreamServices {
 			inboundPolicies = trafficpolicy.MergeInboundPolicies(DisallowPartialHostnamesMatch, inboundPolicies, mc.buildInboundPermissiveModePolicies(svc)...)
@@ -47,7 +47,7 @@ func (mc *MeshCatalog) listInboundPoliciesFromTrafficTargets(upstreamIdentity id
 	upstreamServiceAccount := upstreamIdentity.ToK8sServiceAccount()
 	var inboundPolicies []*trafficpolicy.InboundTrafficPolicy
 
-	for _, t := range mc.meshSpec.ListTrafficTargets() { // loop through all traffic targets
+	for _, t := range mc.listEnforcedTrafficTargets() { // loop through all enforced traffic targets
 		if !isValidTrafficTarget(t) {
 			continue
 		}
@@ -73,7 +73,7 @@ func (mc *MeshCatalog) listInboundPoliciesForTrafficSplits(upstreamIdentity iden
 	upstreamServiceAccount := upstreamIdentity.ToK8sServiceAccount()
 	var inboundPolicies []*trafficpolicy.InboundTrafficPolicy
 
-	for _, t := range mc.meshSpec.ListTrafficTargets() { // loop through all traffic targets
+	for _, t := range mc.listEnforcedTrafficTargets() { // loop through all enforced traffic targets
 		if !isValidTrafficTarget(t) {
 			continue
 		}
@@ -111,6 +111,9 @@ func (mc *MeshCatalog) listInboundPoliciesForTrafficSplits(upstreamIdentity iden
 					continue
 				}
 				servicePolicy := trafficpolicy.NewInboundTrafficPolicy(apexService.FQDN(), hostnames)
+				servicePolicy.RateLimit = mc.GetRateLimitPolicy(upstreamSvc)
+				servicePolicy.HeaderModifier = mc.getHeaderModifierPolicy(upstreamSvc)
+				servicePolicy.CORS = mc.GetCORSPolicy(upstreamSvc)
 				weightedCluster := getDefaultWeightedClusterForService(upstreamSvc)
 
 				for _, sourceServiceAccount := range trafficTargetIdentitiesToSvcAccounts(t.Spec.Sources) {
@@ -122,11 +125,16 @@ func (mc *MeshCatalog) listInboundPoliciesForTrafficSplits(upstreamIdentity iden
 							servicePolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(routeMatch, []service.WeightedCluster{weightedCluster}), sourceServiceAccount)
 						} else {
 							servicePolicyWithHostHeader := trafficpolicy.NewInboundTrafficPolicy(routeMatch.Headers[hostHeaderKey], []string{routeMatch.Headers[hostHeaderKey]})
+							servicePolicyWithHostHeader.RateLimit = servicePolicy.RateLimit
+							servicePolicyWithHostHeader.HeaderModifier = servicePolicy.HeaderModifier
+							servicePolicyWithHostHeader.CORS = servicePolicy.CORS
 							servicePolicyWithHostHeader.AddRule(*trafficpolicy.NewRouteWeightedCluster(routeMatch, []service.WeightedCluster{weightedCluster}), sourceServiceAccount)
+							mc.applyAuthorizationPolicy(upstreamSvc, servicePolicyWithHostHeader)
 							inboundPolicies = trafficpolicy.MergeInboundPolicies(AllowPartialHostnamesMatch, inboundPolicies, servicePolicyWithHostHeader)
 						}
 					}
 				}
+				mc.applyAuthorizationPolicy(upstreamSvc, servicePolicy)
 				inboundPolicies = trafficpolicy.MergeInboundPolicies(AllowPartialHostnamesMatch, inboundPolicies, servicePolicy)
 			}
 		}
@@ -151,8 +159,12 @@ func (mc *MeshCatalog) buildInboundPolicies(t *access.TrafficTarget, svc service
 			Msgf("Error getting service hostnames for service %s", svc)
 		return inboundPolicies
 	}
+	hostnames = append(hostnames, mc.getMirroredHostnames(svc)...)
 
 	servicePolicy := trafficpolicy.NewInboundTrafficPolicy(svc.FQDN(), hostnames)
+	servicePolicy.RateLimit = mc.GetRateLimitPolicy(svc)
+	servicePolicy.HeaderModifier = mc.getHeaderModifierPolicy(svc)
+	servicePolicy.CORS = mc.GetCORSPolicy(svc)
 	weightedCluster := getDefaultWeightedClusterForService(svc)
 
 	for _, sourceServiceAccount := range trafficTargetIdentitiesToSvcAccounts(t.Spec.Sources) {
@@ -164,11 +176,16 @@ func (mc *MeshCatalog) buildInboundPolicies(t *access.TrafficTarget, svc service
 				servicePolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(routeMatch, []service.WeightedCluster{weightedCluster}), sourceServiceAccount)
 			} else {
 				servicePolicyWithHostHeader := trafficpolicy.NewInboundTrafficPolicy(routeMatch.Headers[hostHeaderKey], []string{routeMatch.Headers[hostHeaderKey]})
+				servicePolicyWithHostHeader.RateLimit = servicePolicy.RateLimit
+				servicePolicyWithHostHeader.HeaderModifier = servicePolicy.HeaderModifier
+				servicePolicyWithHostHeader.CORS = servicePolicy.CORS
 				servicePolicyWithHostHeader.AddRule(*trafficpolicy.NewRouteWeightedCluster(routeMatch, []service.WeightedCluster{weightedCluster}), sourceServiceAccount)
+				mc.applyAuthorizationPolicy(svc, servicePolicyWithHostHeader)
 				inboundPolicies = trafficpolicy.MergeInboundPolicies(AllowPartialHostnamesMatch, inboundPolicies, servicePolicyWithHostHeader)
 			}
 		}
 	}
+	mc.applyAuthorizationPolicy(svc, servicePolicy)
 
 	inboundPolicies = trafficpolicy.MergeInboundPolicies(AllowPartialHostnamesMatch, inboundPolicies, servicePolicy)
 
@@ -184,13 +201,18 @@ func (mc *MeshCatalog) buildInboundPermissiveModePolicies(svc service.MeshServic
 			Msgf("Error getting service hostnames for service %s", svc)
 		return inboundPolicies
 	}
+	hostnames = append(hostnames, mc.getMirroredHostnames(svc)...)
 
 	servicePolicy := trafficpolicy.NewInboundTrafficPolicy(svc.FQDN(), hostnames)
+	servicePolicy.RateLimit = mc.GetRateLimitPolicy(svc)
+	servicePolicy.HeaderModifier = mc.getHeaderModifierPolicy(svc)
+	servicePolicy.CORS = mc.GetCORSPolicy(svc)
 	weightedCluster := getDefaultWeightedClusterForService(svc)
 
 	// Add a wildcard route to accept traffic from any service account (wildcard service account)
 	// A wildcard service account will program an RBAC policy for this rule that allows ANY downstream service account
 	servicePolicy.AddRule(*trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, []service.WeightedCluster{weightedCluster}), wildcardServiceAccount)
+	mc.applyAuthorizationPolicy(svc, servicePolicy)
 	inboundPolicies = append(inboundPolicies, servicePolicy)
 
 	return inboundPolicies
@@ -212,7 +234,7 @@ func (mc *MeshCatalog) routesFromRules(rules []access.TrafficTargetRule, traffic
 	}
 
 	for _, rule := range rules {
-		trafficSpecName := mc.getTrafficSpecName("HTTPRouteGroup", trafficTargetNamespace, rule.Name)
+		trafficSpecName := mc.getTrafficSpecName(rule.Kind, trafficTargetNamespace, rule.Name)
 		for _, match := range rule.Matches {
 			matchedRoute, found := specMatchRoute[trafficSpecName][trafficpolicy.TrafficSpecMatchName(match)]
 			if found {
@@ -239,6 +261,11 @@ func (mc *MeshCatalog) getHTTPPathsPerRoute() (map[trafficpolicy.TrafficSpecName
 		// since this method gets only specs related to HTTPRouteGroups added HTTPTraffic to the specKey by default
 		specKey := mc.getTrafficSpecName(httpRouteGroupKind, trafficSpecs.Namespace, trafficSpecs.Name)
 		routePolicies[specKey] = make(map[trafficpolicy.TrafficSpecMatchName]trafficpolicy.HTTPRouteMatch)
+		requestTimeouts := getRouteTimeoutsFromAnnotation(trafficSpecs, constants.RequestTimeoutAnnotation)
+		idleTimeouts := getRouteTimeoutsFromAnnotation(trafficSpecs, constants.IdleTimeoutAnnotation)
+		rewrites := getRouteRewritesFromAnnotation(trafficSpecs)
+		redirects := getRouteRedirectsFromAnnotation(trafficSpecs)
+		queryParams := getRouteQueryParamsFromAnnotation(trafficSpecs)
 		for _, trafficSpecsMatches := range trafficSpecs.Spec.Matches {
 			serviceRoute := trafficpolicy.HTTPRouteMatch{
 				Path:          trafficSpecsMatches.PathRegex,
@@ -254,9 +281,32 @@ func (mc *MeshCatalog) getHTTPPathsPerRoute() (map[trafficpolicy.TrafficSpecName
 			if len(serviceRoute.Methods) == 0 {
 				serviceRoute.Methods = []string{constants.WildcardHTTPMethod}
 			}
+			if timeout, ok := requestTimeouts[trafficSpecsMatches.Name]; ok {
+				serviceRoute.Timeout = &timeout
+			}
+			if idleTimeout, ok := idleTimeouts[trafficSpecsMatches.Name]; ok {
+				serviceRoute.IdleTimeout = &idleTimeout
+			}
+			if rewrite, ok := rewrites[trafficSpecsMatches.Name]; ok {
+				serviceRoute.Rewrite = rewrite
+			}
+			if redirect, ok := redirects[trafficSpecsMatches.Name]; ok {
+				serviceRoute.Redirect = redirect
+			}
+			if params, ok := queryParams[trafficSpecsMatches.Name]; ok {
+				serviceRoute.QueryParams = params
+			}
 			routePolicies[specKey][trafficpolicy.TrafficSpecMatchName(trafficSpecsMatches.Name)] = serviceRoute
 		}
 	}
+
+	for _, grpcRouteGroup := range mc.policyController.ListGRPCRouteGroups() {
+		specKey := mc.getTrafficSpecName(grpcRouteGroupKind, grpcRouteGroup.Namespace, grpcRouteGroup.Name)
+		routePolicies[specKey] = make(map[trafficpolicy.TrafficSpecMatchName]trafficpolicy.HTTPRouteMatch)
+		for _, grpcMatch := range grpcRouteGroup.Spec.Matches {
+			routePolicies[specKey][trafficpolicy.TrafficSpecMatchName(grpcMatch.Name)] = getHTTPRouteMatchFromGRPCMatch(grpcMatch)
+		}
+	}
 	log.Debug().Msgf("Constructed HTTP path routes: %+v", routePolicies)
 	return routePolicies, nil
 }
@@ -4,21 +4,24 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	mapset "github.com/deckarep/golang-set"
 	"github.com/golang/mock/gomock"
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
-	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	tassert "github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/policy"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/smi"
 	"github.com/openservicemesh/osm/pkg/tests"
@@ -489,6 +492,7 @@ func TestListInboundTrafficPolicies(t *testing.T) {
 
 			mockKubeController := k8s.NewMockController(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
@@ -499,8 +503,16 @@ func TestListInboundTrafficPolicies(t *testing.T) {
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
 				configurator:       mockConfigurator,
+				policyController:   mockPolicyController,
 			}
 
+			mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
+
 			var services []*corev1.Service
 			for _, meshSvc := range tc.meshServices {
 				k8sService := tests.NewServiceFixture(meshSvc.Name, meshSvc.Namespace, map[string]string{})
@@ -526,6 +538,7 @@ func TestListInboundTrafficPolicies(t *testing.T) {
 			}
 
 			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).AnyTimes()
+			mockKubeController.EXPECT().GetNamespace(gomock.Any()).Return(nil).AnyTimes()
 
 			for _, ms := range tc.meshServices {
 				locality := service.LocalCluster
@@ -985,6 +998,7 @@ func TestListInboundPoliciesForTrafficSplits(t *testing.T) {
 
 			mockKubeController := k8s.NewMockController(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 
@@ -993,8 +1007,16 @@ func TestListInboundPoliciesForTrafficSplits(t *testing.T) {
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				policyController:   mockPolicyController,
 			}
 
+			mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
+
 			for _, meshSvc := range tc.meshServices {
 				k8sService := tests.NewServiceFixture(meshSvc.Name, meshSvc.Namespace, map[string]string{})
 				mockKubeController.EXPECT().GetService(meshSvc).Return(k8sService).AnyTimes()
@@ -1306,6 +1328,7 @@ func TestBuildInboundPolicies(t *testing.T) {
 
 			mockKubeController := k8s.NewMockController(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 
@@ -1314,8 +1337,16 @@ func TestBuildInboundPolicies(t *testing.T) {
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				policyController:   mockPolicyController,
 			}
 
+			mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
+
 			destK8sService := tests.NewServiceFixture(tc.inboundService.Name, tc.inboundService.Namespace, map[string]string{})
 			mockKubeController.EXPECT().GetService(tc.inboundService).Return(destK8sService).AnyTimes()
 
@@ -1400,6 +1431,7 @@ func TestBuildInboundPermissiveModePolicies(t *testing.T) {
 
 			mockKubeController := k8s.NewMockController(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 
@@ -1408,8 +1440,16 @@ func TestBuildInboundPermissiveModePolicies(t *testing.T) {
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				policyController:   mockPolicyController,
 			}
 
+			mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
+
 			k8sService := tests.NewServiceFixture(tc.meshService.Name, tc.meshService.Namespace, map[string]string{})
 
 			mockEndpointProvider.EXPECT().GetID().Return("fake").AnyTimes()
@@ -1654,6 +1694,7 @@ func TestListInboundPoliciesFromTrafficTargets(t *testing.T) {
 
 			mockKubeController := k8s.NewMockController(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 
@@ -1662,8 +1703,16 @@ func TestListInboundPoliciesFromTrafficTargets(t *testing.T) {
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				policyController:   mockPolicyController,
 			}
 
+			mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
+
 			for _, destMeshSvc := range tc.upstreamServices {
 				destK8sService := tests.NewServiceFixture(destMeshSvc.Name, destMeshSvc.Namespace, map[string]string{})
 				mockKubeController.EXPECT().GetService(destMeshSvc).Return(destK8sService).AnyTimes()
@@ -1714,7 +1763,27 @@ func TestListInboundPoliciesFromTrafficTargets(t *testing.T) {
 
 func TestRoutesFromRules(t *testing.T) {
 	assert := tassert.New(t)
-	mc := MeshCatalog{meshSpec: smi.NewFakeMeshSpecClient()}
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mc := MeshCatalog{
+		meshSpec:         smi.NewFakeMeshSpecClient(),
+		policyController: mockPolicyController,
+	}
+
+	grpcRouteGroup := &policyv1alpha1.GRPCRouteGroup{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: tests.Namespace,
+			Name:      "greeter-routes",
+		},
+		Spec: policyv1alpha1.GRPCRouteGroupSpec{
+			Matches: []policyv1alpha1.GRPCMatch{
+				{Name: "say-hello", Service: "helloworld.Greeter", Method: "SayHello"},
+			},
+		},
+	}
+	mockPolicyController.EXPECT().ListGRPCRouteGroups().Return([]*policyv1alpha1.GRPCRouteGroup{grpcRouteGroup}).AnyTimes()
 
 	testCases := []struct {
 		name           string
@@ -1746,6 +1815,36 @@ func TestRoutesFromRules(t *testing.T) {
 			namespace:      tests.Namespace,
 			expectedRoutes: nil,
 		},
+		{
+			name: "grpc route group and match name exist",
+			rules: []access.TrafficTargetRule{
+				{
+					Kind:    "GRPCRouteGroup",
+					Name:    "greeter-routes",
+					Matches: []string{"say-hello"},
+				},
+			},
+			namespace: tests.Namespace,
+			expectedRoutes: []trafficpolicy.HTTPRouteMatch{
+				{
+					Path:          `/helloworld\.Greeter/SayHello`,
+					PathMatchType: trafficpolicy.PathMatchRegex,
+					Methods:       []string{"POST"},
+				},
+			},
+		},
+		{
+			name: "grpc route group referenced with the kind of an http route group",
+			rules: []access.TrafficTargetRule{
+				{
+					Kind:    "HTTPRouteGroup",
+					Name:    "greeter-routes",
+					Matches: []string{"say-hello"},
+				},
+			},
+			namespace:      tests.Namespace,
+			expectedRoutes: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1926,6 +2025,54 @@ func TestGetHTTPPathsPerRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "HTTP route with timeout annotations",
+			trafficSpec: spec.HTTPRouteGroup{
+				TypeMeta: v1.TypeMeta{
+					APIVersion: "specs.smi-spec.io/v1alpha4",
+					Kind:       "HTTPRouteGroup",
+				},
+				ObjectMeta: v1.ObjectMeta{
+					Namespace: "default",
+					Name:      tests.RouteGroupName,
+					Annotations: map[string]string{
+						constants.RequestTimeoutAnnotation: tests.BuyBooksMatchName + "=0s",
+						constants.IdleTimeoutAnnotation:    tests.BuyBooksMatchName + "=10m",
+					},
+				},
+
+				Spec: spec.HTTPRouteGroupSpec{
+					Matches: []spec.HTTPMatch{
+						{
+							Name:      tests.BuyBooksMatchName,
+							PathRegex: tests.BookstoreBuyPath,
+							Methods:   []string{"GET"},
+						},
+						{
+							Name:      tests.SellBooksMatchName,
+							PathRegex: tests.BookstoreSellPath,
+							Methods:   []string{"GET"},
+						},
+					},
+				},
+			},
+			expectedHTTPPathsPerRoute: map[trafficpolicy.TrafficSpecName]map[trafficpolicy.TrafficSpecMatchName]trafficpolicy.HTTPRouteMatch{
+				"HTTPRouteGroup/default/bookstore-service-routes": {
+					trafficpolicy.TrafficSpecMatchName(tests.BuyBooksMatchName): {
+						Path:          tests.BookstoreBuyPath,
+						PathMatchType: trafficpolicy.PathMatchRegex,
+						Methods:       []string{"GET"},
+						Timeout:       durationPtr(0),
+						IdleTimeout:   durationPtr(10 * time.Minute),
+					},
+					trafficpolicy.TrafficSpecMatchName(tests.SellBooksMatchName): {
+						Path:          tests.BookstoreSellPath,
+						PathMatchType: trafficpolicy.PathMatchRegex,
+						Methods:       []string{"GET"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1935,6 +2082,7 @@ func TestGetHTTPPathsPerRoute(t *testing.T) {
 
 			mockKubeController := k8s.NewMockController(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 
@@ -1943,8 +2091,16 @@ func TestGetHTTPPathsPerRoute(t *testing.T) {
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				policyController:   mockPolicyController,
 			}
 
+			mockPolicyController.EXPECT().GetRateLimitPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetAuthorizationPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(gomock.Any()).Return(nil).AnyTimes()
+
 			mockMeshSpec.EXPECT().ListHTTPTrafficSpecs().Return([]*spec.HTTPRouteGroup{&tc.trafficSpec}).AnyTimes()
 			actual, err := mc.getHTTPPathsPerRoute()
 			assert.Nil(err)
@@ -1962,3 +2118,7 @@ func TestGetTrafficSpecName(t *testing.T) {
 	expected := trafficpolicy.TrafficSpecName(fmt.Sprintf("HTTPRouteGroup/%s/%s", tests.Namespace, tests.RouteGroupName))
 	assert.Equal(actual, expected)
 }
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -54,6 +54,14 @@ func (mc *MeshCatalog) GetIngressPoliciesForService(svc service.MeshService) ([]
 		inboundTrafficPolicies = append(inboundTrafficPolicies, v1beta1Policies...)
 	}
 
+	// Apply the CORS settings of the service to the ingress traffic
+	if len(inboundTrafficPolicies) > 0 {
+		cors := mc.GetCORSPolicy(svc)
+		for _, policy := range inboundTrafficPolicies {
+			policy.CORS = cors
+		}
+	}
+
 	return inboundTrafficPolicies, nil
 }
 
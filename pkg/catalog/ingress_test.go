@@ -15,6 +15,7 @@ import (
 
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/ingress"
+	"github.com/openservicemesh/osm/pkg/policy"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
@@ -1745,9 +1746,12 @@ func TestGetIngressPoliciesForService(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockIngressMonitor := ingress.NewMockMonitor(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
 	meshCatalog := &MeshCatalog{
-		ingressMonitor: mockIngressMonitor,
+		ingressMonitor:   mockIngressMonitor,
+		policyController: mockPolicyController,
 	}
+	mockPolicyController.EXPECT().GetCORSPolicy(gomock.Any()).Return(nil).AnyTimes()
 
 	type testCase struct {
 		name                    string
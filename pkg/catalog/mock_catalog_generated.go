@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	endpoint "github.com/openservicemesh/osm/pkg/endpoint"
 	identity "github.com/openservicemesh/osm/pkg/identity"
 	k8s "github.com/openservicemesh/osm/pkg/k8s"
@@ -38,6 +39,20 @@ func (m *MockMeshCataloger) EXPECT() *MockMeshCatalogerMockRecorder {
 	return m.recorder
 }
 
+// GetCORSPolicy mocks base method
+func (m *MockMeshCataloger) GetCORSPolicy(arg0 service.MeshService) *v1alpha1.CORSPolicySpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCORSPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.CORSPolicySpec)
+	return ret0
+}
+
+// GetCORSPolicy indicates an expected call of GetCORSPolicy
+func (mr *MockMeshCatalogerMockRecorder) GetCORSPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCORSPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetCORSPolicy), arg0)
+}
+
 // GetEgressTrafficPolicy mocks base method
 func (m *MockMeshCataloger) GetEgressTrafficPolicy(arg0 identity.ServiceIdentity) (*trafficpolicy.EgressTrafficPolicy, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +68,34 @@ func (mr *MockMeshCatalogerMockRecorder) GetEgressTrafficPolicy(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetEgressTrafficPolicy), arg0)
 }
 
+// GetHTTPUpgradeTypesForPort mocks base method
+func (m *MockMeshCataloger) GetHTTPUpgradeTypesForPort(arg0 service.MeshService, arg1 uint32) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHTTPUpgradeTypesForPort", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetHTTPUpgradeTypesForPort indicates an expected call of GetHTTPUpgradeTypesForPort
+func (mr *MockMeshCatalogerMockRecorder) GetHTTPUpgradeTypesForPort(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHTTPUpgradeTypesForPort", reflect.TypeOf((*MockMeshCataloger)(nil).GetHTTPUpgradeTypesForPort), arg0, arg1)
+}
+
+// GetHTTPUpgradeTypesForTargetPort mocks base method
+func (m *MockMeshCataloger) GetHTTPUpgradeTypesForTargetPort(arg0 service.MeshService, arg1 uint32) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHTTPUpgradeTypesForTargetPort", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetHTTPUpgradeTypesForTargetPort indicates an expected call of GetHTTPUpgradeTypesForTargetPort
+func (mr *MockMeshCatalogerMockRecorder) GetHTTPUpgradeTypesForTargetPort(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHTTPUpgradeTypesForTargetPort", reflect.TypeOf((*MockMeshCataloger)(nil).GetHTTPUpgradeTypesForTargetPort), arg0, arg1)
+}
+
 // GetIngressPoliciesForService mocks base method
 func (m *MockMeshCataloger) GetIngressPoliciesForService(arg0 service.MeshService) ([]*trafficpolicy.InboundTrafficPolicy, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +140,34 @@ func (mr *MockMeshCatalogerMockRecorder) GetPortToProtocolMappingForService(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortToProtocolMappingForService", reflect.TypeOf((*MockMeshCataloger)(nil).GetPortToProtocolMappingForService), arg0)
 }
 
+// GetRateLimitPolicy mocks base method
+func (m *MockMeshCataloger) GetRateLimitPolicy(arg0 service.MeshService) *v1alpha1.RateLimitSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.RateLimitSpec)
+	return ret0
+}
+
+// GetRateLimitPolicy indicates an expected call of GetRateLimitPolicy
+func (mr *MockMeshCatalogerMockRecorder) GetRateLimitPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetRateLimitPolicy), arg0)
+}
+
+// GetRequestAuthenticationPolicy mocks base method
+func (m *MockMeshCataloger) GetRequestAuthenticationPolicy(arg0 service.MeshService) *v1alpha1.RequestAuthenticationSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthenticationPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.RequestAuthenticationSpec)
+	return ret0
+}
+
+// GetRequestAuthenticationPolicy indicates an expected call of GetRequestAuthenticationPolicy
+func (mr *MockMeshCatalogerMockRecorder) GetRequestAuthenticationPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthenticationPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetRequestAuthenticationPolicy), arg0)
+}
+
 // GetResolvableServiceEndpoints mocks base method
 func (m *MockMeshCataloger) GetResolvableServiceEndpoints(arg0 service.MeshService) ([]endpoint.Endpoint, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +213,20 @@ func (mr *MockMeshCatalogerMockRecorder) GetTargetPortToProtocolMappingForServic
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetPortToProtocolMappingForService", reflect.TypeOf((*MockMeshCataloger)(nil).GetTargetPortToProtocolMappingForService), arg0)
 }
 
+// GetUpstreamTrafficSetting mocks base method
+func (m *MockMeshCataloger) GetUpstreamTrafficSetting(arg0 service.MeshService) *v1alpha1.UpstreamTrafficSettingSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamTrafficSetting", arg0)
+	ret0, _ := ret[0].(*v1alpha1.UpstreamTrafficSettingSpec)
+	return ret0
+}
+
+// GetUpstreamTrafficSetting indicates an expected call of GetUpstreamTrafficSetting
+func (mr *MockMeshCatalogerMockRecorder) GetUpstreamTrafficSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamTrafficSetting", reflect.TypeOf((*MockMeshCataloger)(nil).GetUpstreamTrafficSetting), arg0)
+}
+
 // GetWeightedClustersForUpstream mocks base method
 func (m *MockMeshCataloger) GetWeightedClustersForUpstream(arg0 service.MeshService) []service.WeightedCluster {
 	m.ctrl.T.Helper()
@@ -156,6 +241,20 @@ func (mr *MockMeshCatalogerMockRecorder) GetWeightedClustersForUpstream(arg0 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWeightedClustersForUpstream", reflect.TypeOf((*MockMeshCataloger)(nil).GetWeightedClustersForUpstream), arg0)
 }
 
+// IsPermissiveTrafficPolicyMode mocks base method
+func (m *MockMeshCataloger) IsPermissiveTrafficPolicyMode(arg0 identity.ServiceIdentity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPermissiveTrafficPolicyMode", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPermissiveTrafficPolicyMode indicates an expected call of IsPermissiveTrafficPolicyMode
+func (mr *MockMeshCatalogerMockRecorder) IsPermissiveTrafficPolicyMode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPermissiveTrafficPolicyMode", reflect.TypeOf((*MockMeshCataloger)(nil).IsPermissiveTrafficPolicyMode), arg0)
+}
+
 // ListEndpointsForServiceIdentity mocks base method
 func (m *MockMeshCataloger) ListEndpointsForServiceIdentity(arg0 identity.ServiceIdentity, arg1 service.MeshService) ([]endpoint.Endpoint, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +270,20 @@ func (mr *MockMeshCatalogerMockRecorder) ListEndpointsForServiceIdentity(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointsForServiceIdentity", reflect.TypeOf((*MockMeshCataloger)(nil).ListEndpointsForServiceIdentity), arg0, arg1)
 }
 
+// ListInboundDenyRules mocks base method
+func (m *MockMeshCataloger) ListInboundDenyRules(arg0 identity.ServiceIdentity) []*trafficpolicy.DenyRule {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInboundDenyRules", arg0)
+	ret0, _ := ret[0].([]*trafficpolicy.DenyRule)
+	return ret0
+}
+
+// ListInboundDenyRules indicates an expected call of ListInboundDenyRules
+func (mr *MockMeshCatalogerMockRecorder) ListInboundDenyRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInboundDenyRules", reflect.TypeOf((*MockMeshCataloger)(nil).ListInboundDenyRules), arg0)
+}
+
 // ListInboundServiceIdentities mocks base method
 func (m *MockMeshCataloger) ListInboundServiceIdentities(arg0 identity.ServiceIdentity) ([]identity.ServiceIdentity, error) {
 	m.ctrl.T.Helper()
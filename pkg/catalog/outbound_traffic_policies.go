@@ -1,10 +1,16 @@
 package catalog
 
 import (
+	"fmt"
+
 	mapset "github.com/deckarep/golang-set"
 	"github.com/pkg/errors"
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
@@ -14,20 +20,19 @@ import (
 )
 
 // ListOutboundTrafficPolicies returns all outbound traffic policies
-// 1. from service discovery for permissive mode
+// 1. from service discovery when permissive mode is enabled for the given service account
 // 2. for the given service account from SMI Traffic Target and Traffic Split
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 func (mc *MeshCatalog) ListOutboundTrafficPolicies(downstreamIdentity identity.ServiceIdentity) []*trafficpolicy.OutboundTrafficPolicy {
-	downstreamServiceAccount := downstreamIdentity.ToK8sServiceAccount()
-	if mc.configurator.IsPermissiveTrafficPolicyMode() {
+	if mc.IsPermissiveTrafficPolicyMode(downstreamIdentity) {
 		var outboundPolicies []*trafficpolicy.OutboundTrafficPolicy
-		mergedPolicies := trafficpolicy.MergeOutboundPolicies(DisallowPartialHostnamesMatch, outboundPolicies, mc.buildOutboundPermissiveModePolicies(downstreamServiceAccount.Namespace)...)
+		mergedPolicies := trafficpolicy.MergeOutboundPolicies(DisallowPartialHostnamesMatch, outboundPolicies, mc.buildOutboundPermissiveModePolicies(downstreamIdentity)...)
 		outboundPolicies = mergedPolicies
 		return outboundPolicies
 	}
 
 	outbound := mc.listOutboundPoliciesForTrafficTargets(downstreamIdentity)
-	outboundPoliciesFromSplits := mc.listOutboundTrafficPoliciesForTrafficSplits(downstreamServiceAccount.Namespace)
+	outboundPoliciesFromSplits := mc.listOutboundTrafficPoliciesForTrafficSplits(downstreamIdentity)
 	outbound = trafficpolicy.MergeOutboundPolicies(AllowPartialHostnamesMatch, outbound, outboundPoliciesFromSplits...)
 
 	return outbound
@@ -40,7 +45,7 @@ func (mc *MeshCatalog) listOutboundPoliciesForTrafficTargets(downstreamIdentity
 	downstreamServiceAccount := downstreamIdentity.ToK8sServiceAccount()
 	var outboundPolicies []*trafficpolicy.OutboundTrafficPolicy
 
-	for _, t := range mc.meshSpec.ListTrafficTargets() { // loop through all traffic targets
+	for _, t := range mc.listEnforcedTrafficTargets() { // loop through all enforced traffic targets
 		if !isValidTrafficTarget(t) {
 			continue
 		}
@@ -57,17 +62,31 @@ func (mc *MeshCatalog) listOutboundPoliciesForTrafficTargets(downstreamIdentity
 	return outboundPolicies
 }
 
-func (mc *MeshCatalog) listOutboundTrafficPoliciesForTrafficSplits(sourceNamespace string) []*trafficpolicy.OutboundTrafficPolicy {
+// listOutboundTrafficPoliciesForTrafficSplits returns the outbound traffic policies for the apex services of the SMI TrafficSplit resources.
+// A TrafficSplit without matches splits the requests to the apex service across its backends, while a TrafficSplit with
+// HTTPRouteGroup matches only splits the requests matching them, ex. the requests carrying a canary header.
+// Requests that do not match any TrafficSplit for the apex service are routed to the apex service.
+// Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
+func (mc *MeshCatalog) listOutboundTrafficPoliciesForTrafficSplits(downstreamIdentity identity.ServiceIdentity) []*trafficpolicy.OutboundTrafficPolicy {
+	sourceNamespace := downstreamIdentity.ToK8sServiceAccount().Namespace
 	var outboundPoliciesFromSplits []*trafficpolicy.OutboundTrafficPolicy
 
-	apexServices := mapset.NewSet()
+	// Multiple TrafficSplits with matches can apply to an apex service, so the TrafficSplits are grouped by apex service
+	var apexServices []service.MeshService
+	splitsForApexService := make(map[service.MeshService][]*smiSplit.TrafficSplit)
 	for _, split := range mc.meshSpec.ListTrafficSplits() {
 		svc := service.MeshService{
 			Name:          k8s.GetServiceFromHostname(split.Spec.Service),
 			Namespace:     split.Namespace,
 			ClusterDomain: constants.LocalDomain,
 		}
+		if _, ok := splitsForApexService[svc]; !ok {
+			apexServices = append(apexServices, svc)
+		}
+		splitsForApexService[svc] = append(splitsForApexService[svc], split)
+	}
 
+	for _, svc := range apexServices {
 		locality := service.LocalCluster
 		if svc.Namespace == sourceNamespace {
 			locality = service.LocalNS
@@ -79,36 +98,95 @@ func (mc *MeshCatalog) listOutboundTrafficPoliciesForTrafficSplits(sourceNamespa
 			continue
 		}
 		policy := trafficpolicy.NewOutboundTrafficPolicy(svc.FQDN(), hostnames)
+		policy.HeaderModifier = mc.getHeaderModifierPolicy(svc)
+		policy.LoadBalancer = mc.getLoadBalancer(svc)
+		retryPolicy := mc.getRetryPolicy(downstreamIdentity, svc)
+
+		var routes []*trafficpolicy.RouteWeightedClusters
+		var wildcardRoute *trafficpolicy.RouteWeightedClusters
+		for _, split := range splitsForApexService[svc] {
+			var weightedClusters []service.WeightedCluster
+			for _, backend := range split.Spec.Backends {
+				ms := service.MeshService{
+					Name:          backend.Service,
+					Namespace:     split.ObjectMeta.Namespace,
+					ClusterDomain: constants.LocalDomain,
+				}
+				wc := service.WeightedCluster{
+					ClusterName: service.ClusterName(ms.String()),
+					Weight:      backend.Weight,
+				}
+				weightedClusters = append(weightedClusters, wc)
+			}
 
-		var weightedClusters []service.WeightedCluster
-		for _, backend := range split.Spec.Backends {
-			ms := service.MeshService{
-				Name:          backend.Service,
-				Namespace:     split.ObjectMeta.Namespace,
-				ClusterDomain: constants.LocalDomain,
+			if len(split.Spec.Matches) > 0 {
+				for _, httpRouteMatch := range mc.getHTTPRouteMatchesForTrafficSplit(split) {
+					rwc := trafficpolicy.NewRouteWeightedCluster(httpRouteMatch, weightedClusters)
+					rwc.RetryPolicy = retryPolicy
+					routes = append(routes, rwc)
+				}
+				continue
 			}
-			wc := service.WeightedCluster{
-				ClusterName: service.ClusterName(ms.String()),
-				Weight:      backend.Weight,
+
+			if wildcardRoute != nil {
+				// TODO: enhancement(#2759)
+				log.Error().Str(errcode.Kind, errcode.ErrMultipleSMISplitPerServiceUnsupported.String()).
+					Msgf("Skipping Traffic Split policy %s in namespaces %s as there is already a traffic split policy for apex service %v", split.Name, split.Namespace, svc)
+				continue
 			}
-			weightedClusters = append(weightedClusters, wc)
+			wildcardRoute = trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, weightedClusters)
+			wildcardRoute.RetryPolicy = retryPolicy
 		}
 
-		rwc := trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, weightedClusters)
-		policy.Routes = []*trafficpolicy.RouteWeightedClusters{rwc}
-
-		if apexServices.Contains(svc) {
-			// TODO: enhancement(#2759)
-			log.Error().Str(errcode.Kind, errcode.ErrMultipleSMISplitPerServiceUnsupported.String()).
-				Msgf("Skipping Traffic Split policy %s in namespaces %s as there is already a traffic split policy for apex service %v", split.Name, split.Namespace, svc)
-		} else {
-			outboundPoliciesFromSplits = append(outboundPoliciesFromSplits, policy)
-			apexServices.Add(svc)
+		// Requests that do not match any TrafficSplit with matches are routed to the apex service
+		if wildcardRoute == nil {
+			wildcardRoute = trafficpolicy.NewRouteWeightedCluster(trafficpolicy.WildCardRouteMatch, []service.WeightedCluster{getDefaultWeightedClusterForService(svc)})
+			wildcardRoute.RetryPolicy = retryPolicy
 		}
+		routes = append(routes, wildcardRoute)
+
+		policy.Routes = mc.applyFaultInjectionPolicies(svc, routes)
+		policy.Routes = mc.applyRouteRetryPolicies(downstreamIdentity, svc, policy.Routes)
+		mc.applyTrafficMirrorPolicy(downstreamIdentity, svc, policy.Routes)
+		outboundPoliciesFromSplits = append(outboundPoliciesFromSplits, policy)
 	}
 	return outboundPoliciesFromSplits
 }
 
+// getHTTPRouteMatchesForTrafficSplit returns the HTTP route matches of the HTTPRouteGroups and GRPCRouteGroups referenced by
+// the matches of the given TrafficSplit. A TypedLocalObjectReference (Spec.Matches) is a reference to another object in the same namespace.
+func (mc *MeshCatalog) getHTTPRouteMatchesForTrafficSplit(split *smiSplit.TrafficSplit) []trafficpolicy.HTTPRouteMatch {
+	var httpRouteMatches []trafficpolicy.HTTPRouteMatch
+
+	for _, match := range split.Spec.Matches {
+		routeName := fmt.Sprintf("%s/%s", split.Namespace, match.Name)
+
+		// The API group is optional for the matches of a TrafficSplit
+		switch {
+		case match.Kind == httpRouteGroupKind && matchesAPIGroup(match.APIGroup, smiSpecs.SchemeGroupVersion):
+			httpRouteGroup := mc.meshSpec.GetHTTPRouteGroup(routeName)
+			if httpRouteGroup == nil {
+				log.Error().Msgf("Error fetching HTTPRouteGroup resource %s referenced in TrafficSplit %s/%s", routeName, split.Namespace, split.Name)
+				continue
+			}
+			httpRouteMatches = append(httpRouteMatches, getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup)...)
+
+		case match.Kind == grpcRouteGroupKind && matchesAPIGroup(match.APIGroup, policyv1alpha1.SchemeGroupVersion):
+			grpcRouteGroup := mc.policyController.GetGRPCRouteGroup(routeName)
+			if grpcRouteGroup == nil {
+				log.Error().Msgf("Error fetching GRPCRouteGroup resource %s referenced in TrafficSplit %s/%s", routeName, split.Namespace, split.Name)
+				continue
+			}
+			httpRouteMatches = append(httpRouteMatches, getHTTPRouteMatchesFromGRPCRouteGroup(grpcRouteGroup)...)
+
+		default:
+			log.Error().Msgf("Unsupported match object %v specified in TrafficSplit %s/%s, ignoring it", match, split.Namespace, split.Name)
+		}
+	}
+
+	return httpRouteMatches
+}
+
 // ListOutboundServicesForIdentity list the services the given service account is allowed to initiate outbound connections to
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 func (mc *MeshCatalog) ListOutboundServicesForIdentity(serviceIdentity identity.ServiceIdentity) []service.MeshService {
@@ -123,12 +201,12 @@ func (mc *MeshCatalog) ListOutboundServicesForIdentity(serviceIdentity identity.
 		}
 		return services
 	}
-	if mc.configurator.IsPermissiveTrafficPolicyMode() {
+	if mc.IsPermissiveTrafficPolicyMode(serviceIdentity) {
 		return mc.listMeshServices()
 	}
 
 	serviceSet := mapset.NewSet()
-	for _, t := range mc.meshSpec.ListTrafficTargets() { // loop through all traffic targets
+	for _, t := range mc.listEnforcedTrafficTargets() { // loop through all enforced traffic targets
 		for _, source := range t.Spec.Sources {
 			if source.Name == ident.Name && source.Namespace == ident.Namespace { // found outbound
 				sa := identity.K8sServiceAccount{
@@ -156,7 +234,9 @@ func (mc *MeshCatalog) ListOutboundServicesForIdentity(serviceIdentity identity.
 	return allowedServices
 }
 
-func (mc *MeshCatalog) buildOutboundPermissiveModePolicies(sourceNamespace string) []*trafficpolicy.OutboundTrafficPolicy {
+// Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
+func (mc *MeshCatalog) buildOutboundPermissiveModePolicies(downstreamIdentity identity.ServiceIdentity) []*trafficpolicy.OutboundTrafficPolicy {
+	sourceNamespace := downstreamIdentity.ToK8sServiceAccount().Namespace
 	var outPolicies []*trafficpolicy.OutboundTrafficPolicy
 
 	destServices := mc.listMeshServices()
@@ -174,12 +254,18 @@ func (mc *MeshCatalog) buildOutboundPermissiveModePolicies(sourceNamespace strin
 		}
 
 		weightedCluster := getDefaultWeightedClusterForService(destService)
+		retryPolicy := mc.getRetryPolicy(downstreamIdentity, destService)
 		policy := trafficpolicy.NewOutboundTrafficPolicy(destService.FQDN(), hostnames)
-		if err := policy.AddRoute(trafficpolicy.WildCardRouteMatch, weightedCluster); err != nil {
+		policy.HeaderModifier = mc.getHeaderModifierPolicy(destService)
+		policy.LoadBalancer = mc.getLoadBalancer(destService)
+		if err := policy.AddRoute(trafficpolicy.WildCardRouteMatch, retryPolicy, weightedCluster); err != nil {
 			log.Error().Err(err).Str(errcode.Kind, errcode.ErrAddingRouteToOutboundTrafficPolicy.String()).
 				Msgf("Error adding route to outbound policy in permissive mode for destination %s", destService)
 			continue
 		}
+		policy.Routes = mc.applyFaultInjectionPolicies(destService, policy.Routes)
+		policy.Routes = mc.applyRouteRetryPolicies(downstreamIdentity, destService, policy.Routes)
+		mc.applyTrafficMirrorPolicy(downstreamIdentity, destService, policy.Routes)
 		outPolicies = append(outPolicies, policy)
 	}
 	return outPolicies
@@ -222,8 +308,11 @@ func (mc *MeshCatalog) buildOutboundPolicies(sourceServiceIdentity identity.Serv
 				continue
 			}
 			weightedCluster := getDefaultWeightedClusterForService(destService)
+			retryPolicy := mc.getRetryPolicy(sourceServiceIdentity, destService)
 
 			policy := trafficpolicy.NewOutboundTrafficPolicy(destService.FQDN(), hostnames)
+			policy.HeaderModifier = mc.getHeaderModifierPolicy(destService)
+			policy.LoadBalancer = mc.getLoadBalancer(destService)
 			needWildCardRoute := false
 			for _, routeMatch := range routeMatches {
 				// If the traffic target has a route with host headers
@@ -231,22 +320,30 @@ func (mc *MeshCatalog) buildOutboundPolicies(sourceServiceIdentity identity.Serv
 				// else the hosnames will be hostnames corresponding to the service
 				if _, ok := routeMatch.Headers[hostHeaderKey]; ok {
 					policyWithHostHeader := trafficpolicy.NewOutboundTrafficPolicy(routeMatch.Headers[hostHeaderKey], []string{routeMatch.Headers[hostHeaderKey]})
-					if err := policyWithHostHeader.AddRoute(trafficpolicy.WildCardRouteMatch, weightedCluster); err != nil {
+					policyWithHostHeader.HeaderModifier = policy.HeaderModifier
+					policyWithHostHeader.LoadBalancer = policy.LoadBalancer
+					if err := policyWithHostHeader.AddRoute(trafficpolicy.WildCardRouteMatch, retryPolicy, weightedCluster); err != nil {
 						log.Error().Err(err).Str(errcode.Kind, errcode.ErrAddingRouteToOutboundTrafficPolicy.String()).
 							Msgf("Error adding Route to outbound policy for source %s/%s and destination %s/%s with host header %s", source.Namespace, source.Name, destService.Namespace, destService.Name, routeMatch.Headers[hostHeaderKey])
 						continue
 					}
+					policyWithHostHeader.Routes = mc.applyFaultInjectionPolicies(destService, policyWithHostHeader.Routes)
+					policyWithHostHeader.Routes = mc.applyRouteRetryPolicies(sourceServiceIdentity, destService, policyWithHostHeader.Routes)
+					mc.applyTrafficMirrorPolicy(sourceServiceIdentity, destService, policyWithHostHeader.Routes)
 					outboundPolicies = trafficpolicy.MergeOutboundPolicies(AllowPartialHostnamesMatch, outboundPolicies, policyWithHostHeader)
 				} else {
 					needWildCardRoute = true
 				}
 			}
 			if needWildCardRoute {
-				if err := policy.AddRoute(trafficpolicy.WildCardRouteMatch, weightedCluster); err != nil {
+				if err := policy.AddRoute(trafficpolicy.WildCardRouteMatch, retryPolicy, weightedCluster); err != nil {
 					log.Error().Err(err).Str(errcode.Kind, errcode.ErrAddingRouteToOutboundTrafficPolicy.String()).
 						Msgf("Error adding Route to outbound policy for source %s/%s and destination %s/%s", source.Namespace, source.Name, destService.Namespace, destService.Name)
 					continue
 				}
+				policy.Routes = mc.applyFaultInjectionPolicies(destService, policy.Routes)
+				policy.Routes = mc.applyRouteRetryPolicies(sourceServiceIdentity, destService, policy.Routes)
+				mc.applyTrafficMirrorPolicy(sourceServiceIdentity, destService, policy.Routes)
 			}
 
 			outboundPolicies = trafficpolicy.MergeOutboundPolicies(AllowPartialHostnamesMatch, outboundPolicies, policy)
@@ -268,7 +365,8 @@ func (mc *MeshCatalog) getDestinationServicesFromTrafficTarget(t *access.Traffic
 }
 
 // GetWeightedClustersForUpstream returns Envoy cluster weights for the given
-// upstream service, the apex service of a TrafficSplit.
+// upstream service, the apex service of a TrafficSplit. TrafficSplits with matches
+// are ignored, as they only split the HTTP requests matching their HTTPRouteGroups.
 func (mc *MeshCatalog) GetWeightedClustersForUpstream(upstream service.MeshService) []service.WeightedCluster {
 	var weightedClusters []service.WeightedCluster
 	apexServices := mapset.NewSet()
@@ -284,6 +382,11 @@ func (mc *MeshCatalog) GetWeightedClustersForUpstream(upstream service.MeshServi
 			continue
 		}
 
+		if len(split.Spec.Matches) > 0 {
+			// The backends of a TrafficSplit with matches only receive the requests matching the HTTPRouteGroup matches
+			continue
+		}
+
 		if apexServices.Contains(split.Spec.Service) {
 			log.Error().Str(errcode.Kind, errcode.ErrMultipleSMISplitPerServiceUnsupported.String()).
 				Msgf("Skipping traffic split policy %s/%s as there is already a corresponding policy for apex service %s", split.Namespace, split.Name, split.Spec.Service)
@@ -362,3 +465,9 @@ func (mc *MeshCatalog) ListMeshServicesForIdentity(identity identity.ServiceIden
 
 	return dstServices
 }
+
+// matchesAPIGroup returns a boolean indicating if the given optional API group refers to the given group version,
+// either by its group or its group and version.
+func matchesAPIGroup(apiGroup *string, groupVersion schema.GroupVersion) bool {
+	return apiGroup == nil || *apiGroup == groupVersion.Group || *apiGroup == groupVersion.String()
+}
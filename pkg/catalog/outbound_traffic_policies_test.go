@@ -7,17 +7,19 @@ import (
 	"github.com/golang/mock/gomock"
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
-	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	tassert "github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/policy"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/smi"
 	"github.com/openservicemesh/osm/pkg/tests"
@@ -376,12 +378,20 @@ func TestListOutboundTrafficPolicies(t *testing.T) {
 				mockKubeController.EXPECT().GetService(tests.BookstoreApexService).Return(tests.NewServiceFixture(tests.BookstoreApexService.Name, tests.BookstoreApexService.Namespace, map[string]string{})).AnyTimes()
 			}
 
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+
 			mc := MeshCatalog{
 				kubeController:     mockKubeController,
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
 				configurator:       mockConfigurator,
+				policyController:   mockPolicyController,
 			}
 
 			expectedServices := tc.meshServices
@@ -396,6 +406,7 @@ func TestListOutboundTrafficPolicies(t *testing.T) {
 			mockServiceProvider.EXPECT().ListServices().Return(tc.meshServices, nil).AnyTimes()
 
 			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).AnyTimes()
+			mockKubeController.EXPECT().GetNamespace(gomock.Any()).Return(nil).AnyTimes()
 			outbound := mc.ListOutboundTrafficPolicies(tc.downstreamSA)
 			assert.ElementsMatch(tc.expectedOutbound, outbound)
 		})
@@ -492,6 +503,95 @@ func TestListOutboundTrafficPoliciesForTrafficSplits(t *testing.T) {
 		},
 	}
 
+	canaryRouteGroup := &spec.HTTPRouteGroup{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "canary-routes",
+			Namespace: "bar",
+		},
+		Spec: spec.HTTPRouteGroupSpec{
+			Matches: []spec.HTTPMatch{
+				{
+					Name:    "canary",
+					Headers: map[string]string{"x-canary": "true"},
+				},
+			},
+		},
+	}
+
+	canaryMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          constants.RegexMatchAll,
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{constants.WildcardHTTPMethod},
+		Headers:       map[string]string{"x-canary": "true"},
+	}
+
+	testCanarySplit := split.TrafficSplit{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: "bar",
+		},
+		Spec: split.TrafficSplitSpec{
+			Service: "apex-split-1",
+			Backends: []split.TrafficSplitBackend{
+				{
+					Service: tests.BookstoreV2ServiceName,
+					Weight:  100,
+				},
+			},
+			Matches: []corev1.TypedLocalObjectReference{
+				{
+					Kind: "HTTPRouteGroup",
+					Name: "canary-routes",
+				},
+			},
+		},
+	}
+
+	grpcCanaryRouteGroup := &policyv1alpha1.GRPCRouteGroup{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "greeter-canary-routes",
+			Namespace: "bar",
+		},
+		Spec: policyv1alpha1.GRPCRouteGroupSpec{
+			Matches: []policyv1alpha1.GRPCMatch{
+				{
+					Name:    "canary",
+					Service: "helloworld.Greeter",
+					Headers: map[string]string{"x-canary": "true"},
+				},
+			},
+		},
+	}
+
+	grpcCanaryMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          `/helloworld\.Greeter/[^/]+`,
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"POST"},
+		Headers:       map[string]string{"x-canary": "true"},
+	}
+
+	policyAPIGroup := policyv1alpha1.SchemeGroupVersion.String()
+	testGRPCCanarySplit := split.TrafficSplit{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: "bar",
+		},
+		Spec: split.TrafficSplitSpec{
+			Service: "apex-split-1",
+			Backends: []split.TrafficSplitBackend{
+				{
+					Service: tests.BookstoreV2ServiceName,
+					Weight:  100,
+				},
+			},
+			Matches: []corev1.TypedLocalObjectReference{
+				{
+					APIGroup: &policyAPIGroup,
+					Kind:     "GRPCRouteGroup",
+					Name:     "greeter-canary-routes",
+				},
+			},
+		},
+	}
+
 	testSplit3NamespacedHostnames := []string{
 		"apex-split-1.baz",
 		"apex-split-1.baz.svc",
@@ -709,6 +809,103 @@ func TestListOutboundTrafficPoliciesForTrafficSplits(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:            "traffic split with matches precedes the traffic split without matches",
+			sourceNamespace: "foo",
+			trafficsplits:   []*split.TrafficSplit{&testSplit1, &testCanarySplit},
+			apexMeshServices: []service.MeshService{
+				{
+					Name:          "apex-split-1",
+					Namespace:     "bar",
+					ClusterDomain: constants.LocalDomain,
+				},
+			},
+			expectedPolicies: []*trafficpolicy.OutboundTrafficPolicy{
+				{
+					Name:      "apex-split-1.bar.local",
+					Hostnames: testSplit1NamespacedHostnames,
+					Routes: []*trafficpolicy.RouteWeightedClusters{
+						{
+							HTTPRouteMatch: canaryMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: "bar/bookstore-v2/local", Weight: 100},
+							}),
+						},
+						{
+							HTTPRouteMatch: tests.WildCardRouteMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: "bar/bookstore-v1/local", Weight: 10},
+								service.WeightedCluster{ClusterName: "bar/bookstore-v2/local", Weight: 90},
+							}),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:            "requests not matching a traffic split with matches are routed to the apex service",
+			sourceNamespace: "foo",
+			trafficsplits:   []*split.TrafficSplit{&testCanarySplit},
+			apexMeshServices: []service.MeshService{
+				{
+					Name:          "apex-split-1",
+					Namespace:     "bar",
+					ClusterDomain: constants.LocalDomain,
+				},
+			},
+			expectedPolicies: []*trafficpolicy.OutboundTrafficPolicy{
+				{
+					Name:      "apex-split-1.bar.local",
+					Hostnames: testSplit1NamespacedHostnames,
+					Routes: []*trafficpolicy.RouteWeightedClusters{
+						{
+							HTTPRouteMatch: canaryMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: "bar/bookstore-v2/local", Weight: 100},
+							}),
+						},
+						{
+							HTTPRouteMatch: tests.WildCardRouteMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: "bar/apex-split-1/local", Weight: constants.ClusterWeightAcceptAll},
+							}),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:            "traffic split with a GRPCRouteGroup match routes matching gRPC requests to the backends",
+			sourceNamespace: "foo",
+			trafficsplits:   []*split.TrafficSplit{&testGRPCCanarySplit},
+			apexMeshServices: []service.MeshService{
+				{
+					Name:          "apex-split-1",
+					Namespace:     "bar",
+					ClusterDomain: constants.LocalDomain,
+				},
+			},
+			expectedPolicies: []*trafficpolicy.OutboundTrafficPolicy{
+				{
+					Name:      "apex-split-1.bar.local",
+					Hostnames: testSplit1NamespacedHostnames,
+					Routes: []*trafficpolicy.RouteWeightedClusters{
+						{
+							HTTPRouteMatch: grpcCanaryMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: "bar/bookstore-v2/local", Weight: 100},
+							}),
+						},
+						{
+							HTTPRouteMatch: tests.WildCardRouteMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: "bar/apex-split-1/local", Weight: constants.ClusterWeightAcceptAll},
+							}),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -720,18 +917,31 @@ func TestListOutboundTrafficPoliciesForTrafficSplits(t *testing.T) {
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{}).AnyTimes()
 
 			for _, ms := range tc.apexMeshServices {
 				apexK8sService := tests.NewServiceFixture(ms.Name, ms.Namespace, map[string]string{})
 				mockKubeController.EXPECT().GetService(ms).Return(apexK8sService).AnyTimes()
 			}
 			mockMeshSpec.EXPECT().ListTrafficSplits().Return(tc.trafficsplits).AnyTimes()
+			mockMeshSpec.EXPECT().GetHTTPRouteGroup("bar/canary-routes").Return(canaryRouteGroup).AnyTimes()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetGRPCRouteGroup("bar/greeter-canary-routes").Return(grpcCanaryRouteGroup).AnyTimes()
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
 
 			mc := MeshCatalog{
 				kubeController:     mockKubeController,
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				configurator:       mockConfigurator,
+				policyController:   mockPolicyController,
 			}
 
 			for _, ms := range tc.apexMeshServices {
@@ -746,7 +956,7 @@ func TestListOutboundTrafficPoliciesForTrafficSplits(t *testing.T) {
 				}
 			}
 
-			actual := mc.listOutboundTrafficPoliciesForTrafficSplits(tc.sourceNamespace)
+			actual := mc.listOutboundTrafficPoliciesForTrafficSplits(identity.K8sServiceAccount{Name: "foo", Namespace: tc.sourceNamespace}.ToServiceIdentity())
 
 			assert.ElementsMatch(tc.expectedPolicies, actual)
 		})
@@ -811,12 +1021,23 @@ func TestBuildOutboundPermissiveModePolicies(t *testing.T) {
 	mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
 	mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 	mockServiceProvider := service.NewMockProvider(mockCtrl)
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+	mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{}).AnyTimes()
+
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
 
 	mc := MeshCatalog{
 		kubeController:     mockKubeController,
 		meshSpec:           mockMeshSpec,
 		endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 		serviceProviders:   []service.Provider{mockServiceProvider},
+		configurator:       mockConfigurator,
+		policyController:   mockPolicyController,
 	}
 
 	testCases := []struct {
@@ -922,7 +1143,7 @@ func TestBuildOutboundPermissiveModePolicies(t *testing.T) {
 					mockServiceProvider.EXPECT().GetHostnamesForService(ms, locality).Return(tests.ExpectedHostnames[ms.Name+"-namespaced"], nil).AnyTimes()
 				}
 			}
-			actual := mc.buildOutboundPermissiveModePolicies(tc.sourceNamespace)
+			actual := mc.buildOutboundPermissiveModePolicies(identity.K8sServiceAccount{Name: "foo", Namespace: tc.sourceNamespace}.ToServiceIdentity())
 			assert.Len(actual, len(tc.expectedOutboundPolicies))
 			assert.ElementsMatch(tc.expectedOutboundPolicies, actual)
 		})
@@ -1003,12 +1224,23 @@ func TestBuildOutboundPolicies(t *testing.T) {
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{}).AnyTimes()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
 
 			mc := MeshCatalog{
 				kubeController:     mockKubeController,
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				configurator:       mockConfigurator,
+				policyController:   mockPolicyController,
 			}
 
 			destK8sService := tests.NewServiceFixture(tc.destMeshService.Name, tc.destMeshService.Namespace, map[string]string{})
@@ -1121,6 +1353,8 @@ func TestListOutboundPoliciesForTrafficTargets(t *testing.T) {
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{}).AnyTimes()
 
 			for _, ms := range tc.apexMeshServices {
 				apexK8sService := tests.NewServiceFixture(ms.Name, ms.Namespace, map[string]string{})
@@ -1136,11 +1370,20 @@ func TestListOutboundPoliciesForTrafficTargets(t *testing.T) {
 			mockKubeController.EXPECT().GetService(tests.BookstoreV2Service).Return(tests.NewServiceFixture(tests.BookstoreV2Service.Name, tests.BookstoreV2Service.Namespace, map[string]string{})).AnyTimes()
 			mockKubeController.EXPECT().GetService(tests.BookstoreApexService).Return(tests.NewServiceFixture(tests.BookstoreApexService.Name, tests.BookstoreApexService.Namespace, map[string]string{})).AnyTimes()
 
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+
 			mc := MeshCatalog{
 				kubeController:     mockKubeController,
 				meshSpec:           mockMeshSpec,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
+				configurator:       mockConfigurator,
+				policyController:   mockPolicyController,
 			}
 
 			meshServices := []service.MeshService{
@@ -1170,6 +1413,13 @@ func TestGetDestinationServicesFromTrafficTarget(t *testing.T) {
 	mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 	mockServiceProvider := service.NewMockProvider(mockCtrl)
 
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+
 	mc := MeshCatalog{
 		kubeController:     mockKubeController,
 		endpointsProviders: []endpoint.Provider{mockEndpointProvider},
@@ -1412,6 +1662,13 @@ func TestGetOutboundTCPFilter(t *testing.T) {
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
 			mockMeshSpec.EXPECT().ListTrafficSplits().Return(tc.trafficSplits).Times(1)
 
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+
 			mc := MeshCatalog{
 				meshSpec: mockMeshSpec,
 			}
@@ -1435,6 +1692,13 @@ func TestListMeshServicesForIdentity(t *testing.T) {
 	mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{EnableMulticlusterMode: true}).AnyTimes()
 	mockConfigurator.EXPECT().GetOSMNamespace().Return("osm-system").AnyTimes()
 
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetHeaderModifierPolicy(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListGRPCRouteGroups().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetTrafficMirrorPolicy(gomock.Any()).Return(nil).AnyTimes()
+
 	mc := MeshCatalog{
 		meshSpec:         mockMeshSpec,
 		kubeController:   mockController,
@@ -1524,6 +1788,7 @@ func TestListMeshServicesForIdentity(t *testing.T) {
 			}
 
 			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(true).Times(1)
+			mockController.EXPECT().GetNamespace(tc.id.ToK8sServiceAccount().Namespace).Return(nil).Times(1)
 			mockServiceProvider.EXPECT().ListServices().Return(meshServices, nil).Times(1)
 			if len(tc.trafficSplits) > 0 {
 				mockMeshSpec.EXPECT().ListTrafficSplits().Return(tc.trafficSplits).Times(1)
@@ -0,0 +1,36 @@
+package catalog
+
+import (
+	"strconv"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/identity"
+)
+
+// IsPermissiveTrafficPolicyMode returns whether the permissive traffic policy mode is enabled for the given service identity.
+// The mode is set per namespace by the permissive traffic policy mode annotation on the namespace of the service identity,
+// and defaults to the mesh-wide permissive traffic policy mode when the namespace is not annotated.
+// Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
+func (mc *MeshCatalog) IsPermissiveTrafficPolicyMode(svcIdentity identity.ServiceIdentity) bool {
+	meshWidePermissiveMode := mc.configurator.IsPermissiveTrafficPolicyMode()
+
+	namespace := svcIdentity.ToK8sServiceAccount().Namespace
+	ns := mc.kubeController.GetNamespace(namespace)
+	if ns == nil {
+		return meshWidePermissiveMode
+	}
+
+	value, ok := ns.Annotations[constants.PermissiveTrafficPolicyModeAnnotation]
+	if !ok {
+		return meshWidePermissiveMode
+	}
+
+	permissiveMode, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Error().Err(err).Msgf("Invalid value %q for annotation %s on Namespace %s, using the mesh-wide permissive traffic policy mode",
+			value, constants.PermissiveTrafficPolicyModeAnnotation, namespace)
+		return meshWidePermissiveMode
+	}
+
+	return permissiveMode
+}
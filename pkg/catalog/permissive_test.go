@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
+)
+
+func TestIsPermissiveTrafficPolicyMode(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		namespace              *corev1.Namespace
+		meshWidePermissiveMode bool
+		expected               bool
+	}{
+		{
+			name:                   "mesh-wide mode when the namespace is not found",
+			namespace:              nil,
+			meshWidePermissiveMode: true,
+			expected:               true,
+		},
+		{
+			name: "mesh-wide mode when the namespace is not annotated",
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "ns-1"},
+			},
+			meshWidePermissiveMode: false,
+			expected:               false,
+		},
+		{
+			name: "namespace opts into permissive mode",
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ns-1",
+					Annotations: map[string]string{constants.PermissiveTrafficPolicyModeAnnotation: "true"},
+				},
+			},
+			meshWidePermissiveMode: false,
+			expected:               true,
+		},
+		{
+			name: "namespace opts out of permissive mode",
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ns-1",
+					Annotations: map[string]string{constants.PermissiveTrafficPolicyModeAnnotation: "false"},
+				},
+			},
+			meshWidePermissiveMode: true,
+			expected:               false,
+		},
+		{
+			name: "mesh-wide mode when the annotation is invalid",
+			namespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ns-1",
+					Annotations: map[string]string{constants.PermissiveTrafficPolicyModeAnnotation: "maybe"},
+				},
+			},
+			meshWidePermissiveMode: true,
+			expected:               true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockKubeController := k8s.NewMockController(mockCtrl)
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			mc := &MeshCatalog{
+				kubeController: mockKubeController,
+				configurator:   mockConfigurator,
+			}
+
+			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.meshWidePermissiveMode).Times(1)
+			mockKubeController.EXPECT().GetNamespace("ns-1").Return(tc.namespace).Times(1)
+
+			actual := mc.IsPermissiveTrafficPolicyMode(identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity())
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
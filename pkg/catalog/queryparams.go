@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"encoding/json"
+	"regexp"
+
+	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// routeQueryParam is the type used to represent a query parameter match specified in the query params annotation
+// on an HTTPRouteGroup. Exactly one of Exact, Regex or Present must be specified.
+type routeQueryParam struct {
+	Name    string  `json:"name"`
+	Exact   *string `json:"exact,omitempty"`
+	Regex   *string `json:"regex,omitempty"`
+	Present bool    `json:"present,omitempty"`
+}
+
+// getRouteQueryParamsFromAnnotation returns a mapping of match names to the query parameter matches specified in the
+// query params annotation on the HTTPRouteGroup. The annotation value is a JSON object mapping match names to lists of
+// query parameter matches, ex. {"v2-api": [{"name": "version", "exact": "v2"}, {"name": "debug", "present": true}]}.
+// Matches with an invalid query parameter match are logged and ignored.
+func getRouteQueryParamsFromAnnotation(routeGroup *spec.HTTPRouteGroup) map[string][]trafficpolicy.QueryParamMatch {
+	value, ok := routeGroup.Annotations[constants.QueryParamsAnnotation]
+	if !ok {
+		return nil
+	}
+
+	var annotatedQueryParams map[string][]routeQueryParam
+	if err := json.Unmarshal([]byte(value), &annotatedQueryParams); err != nil {
+		log.Error().Err(err).Msgf("Invalid value for annotation %s on HTTPRouteGroup %s/%s, expected a JSON object mapping match names to query parameter matches; ignoring",
+			constants.QueryParamsAnnotation, routeGroup.Namespace, routeGroup.Name)
+		return nil
+	}
+
+	queryParams := make(map[string][]trafficpolicy.QueryParamMatch)
+	for matchName, params := range annotatedQueryParams {
+		var matches []trafficpolicy.QueryParamMatch
+		valid := true
+		for _, param := range params {
+			match, ok := getQueryParamMatch(param)
+			if !ok {
+				log.Error().Msgf("Invalid query parameter match %+v for match %s in annotation %s on HTTPRouteGroup %s/%s, a name and exactly one of exact, regex or present are required; ignoring",
+					param, matchName, constants.QueryParamsAnnotation, routeGroup.Namespace, routeGroup.Name)
+				valid = false
+				break
+			}
+			matches = append(matches, match)
+		}
+
+		// A match with an invalid query parameter match is ignored altogether, since matching only
+		// the remaining query parameters would match more requests than intended
+		if valid {
+			queryParams[matchName] = matches
+		}
+	}
+
+	return queryParams
+}
+
+// getQueryParamMatch returns the query parameter match corresponding to the given annotated query parameter,
+// and a boolean indicating if the annotated query parameter is valid
+func getQueryParamMatch(param routeQueryParam) (trafficpolicy.QueryParamMatch, bool) {
+	if param.Name == "" {
+		return trafficpolicy.QueryParamMatch{}, false
+	}
+
+	switch {
+	case param.Exact != nil && param.Regex == nil && !param.Present:
+		return trafficpolicy.QueryParamMatch{Name: param.Name, Value: *param.Exact, MatchType: trafficpolicy.QueryParamMatchExact}, true
+
+	case param.Regex != nil && param.Exact == nil && !param.Present:
+		if _, err := regexp.Compile(*param.Regex); err != nil {
+			return trafficpolicy.QueryParamMatch{}, false
+		}
+		return trafficpolicy.QueryParamMatch{Name: param.Name, Value: *param.Regex, MatchType: trafficpolicy.QueryParamMatchRegex}, true
+
+	case param.Present && param.Exact == nil && param.Regex == nil:
+		return trafficpolicy.QueryParamMatch{Name: param.Name, MatchType: trafficpolicy.QueryParamMatchPresent}, true
+
+	default:
+		return trafficpolicy.QueryParamMatch{}, false
+	}
+}
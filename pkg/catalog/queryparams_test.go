@@ -0,0 +1,123 @@
+package catalog
+
+import (
+	"testing"
+
+	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetRouteQueryParamsFromAnnotation(t *testing.T) {
+	testCases := []struct {
+		name                string
+		annotations         map[string]string
+		expectedQueryParams map[string][]trafficpolicy.QueryParamMatch
+	}{
+		{
+			name:                "annotation not set",
+			annotations:         nil,
+			expectedQueryParams: nil,
+		},
+		{
+			name: "malformed annotation is ignored",
+			annotations: map[string]string{
+				constants.QueryParamsAnnotation: "v2-api=version=v2",
+			},
+			expectedQueryParams: nil,
+		},
+		{
+			name: "valid query parameter matches",
+			annotations: map[string]string{
+				constants.QueryParamsAnnotation: `{"v2-api": [{"name": "version", "exact": "v2"}, {"name": "debug", "present": true}], "beta": [{"name": "version", "regex": "v[3-9]-beta"}]}`,
+			},
+			expectedQueryParams: map[string][]trafficpolicy.QueryParamMatch{
+				"v2-api": {
+					{Name: "version", Value: "v2", MatchType: trafficpolicy.QueryParamMatchExact},
+					{Name: "debug", MatchType: trafficpolicy.QueryParamMatchPresent},
+				},
+				"beta": {
+					{Name: "version", Value: "v[3-9]-beta", MatchType: trafficpolicy.QueryParamMatchRegex},
+				},
+			},
+		},
+		{
+			name: "exact match on an empty value",
+			annotations: map[string]string{
+				constants.QueryParamsAnnotation: `{"no-version": [{"name": "version", "exact": ""}]}`,
+			},
+			expectedQueryParams: map[string][]trafficpolicy.QueryParamMatch{
+				"no-version": {
+					{Name: "version", Value: "", MatchType: trafficpolicy.QueryParamMatchExact},
+				},
+			},
+		},
+		{
+			name: "matches with invalid query parameter matches are ignored",
+			annotations: map[string]string{
+				constants.QueryParamsAnnotation: `{"no-name": [{"exact": "v2"}], "both": [{"name": "version", "exact": "v2", "regex": "v.*"}], "none": [{"name": "version"}], "invalid-regex": [{"name": "version", "exact": "v1"}, {"name": "id", "regex": "(["}], "valid": [{"name": "version", "exact": "v1"}]}`,
+			},
+			expectedQueryParams: map[string][]trafficpolicy.QueryParamMatch{
+				"valid": {
+					{Name: "version", Value: "v1", MatchType: trafficpolicy.QueryParamMatchExact},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			routeGroup := &spec.HTTPRouteGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bookstore-service-routes",
+					Namespace:   "default",
+					Annotations: tc.annotations,
+				},
+			}
+
+			actual := getRouteQueryParamsFromAnnotation(routeGroup)
+			assert.Equal(tc.expectedQueryParams, actual)
+		})
+	}
+}
+
+func TestGetHTTPRouteMatchesFromHTTPRouteGroupWithQueryParams(t *testing.T) {
+	assert := tassert.New(t)
+
+	routeGroup := &spec.HTTPRouteGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore-service-routes",
+			Namespace: "default",
+			Annotations: map[string]string{
+				constants.QueryParamsAnnotation: `{"v2-api": [{"name": "version", "exact": "v2"}]}`,
+			},
+		},
+		Spec: spec.HTTPRouteGroupSpec{
+			Matches: []spec.HTTPMatch{
+				{Name: "v2-api", PathRegex: "/books"},
+				{Name: "all", PathRegex: "/books"},
+			},
+		},
+	}
+
+	assert.Equal([]trafficpolicy.HTTPRouteMatch{
+		{
+			Path:          "/books",
+			PathMatchType: trafficpolicy.PathMatchRegex,
+			Methods:       []string{constants.WildcardHTTPMethod},
+			QueryParams: []trafficpolicy.QueryParamMatch{
+				{Name: "version", Value: "v2", MatchType: trafficpolicy.QueryParamMatchExact},
+			},
+		},
+		{
+			Path:          "/books",
+			PathMatchType: trafficpolicy.PathMatchRegex,
+			Methods:       []string{constants.WildcardHTTPMethod},
+		},
+	}, getHTTPRouteMatchesFromHTTPRouteGroup(routeGroup))
+}
@@ -0,0 +1,17 @@
+package catalog
+
+import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// GetRateLimitPolicy returns the RateLimitSpec for the given service.
+// If no RateLimit policy applies to the service, nil is returned.
+func (mc *MeshCatalog) GetRateLimitPolicy(svc service.MeshService) *policyv1alpha1.RateLimitSpec {
+	rateLimit := mc.policyController.GetRateLimitPolicy(svc)
+	if rateLimit == nil {
+		return nil
+	}
+
+	return rateLimit.Spec.DeepCopy()
+}
@@ -0,0 +1,69 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetRateLimitPolicy(t *testing.T) {
+	svc := service.MeshService{Name: "s1", Namespace: "test"}
+
+	testCases := []struct {
+		name         string
+		rateLimit    *policyv1alpha1.RateLimit
+		expectedSpec *policyv1alpha1.RateLimitSpec
+	}{
+		{
+			name:         "no RateLimit policy for the service",
+			rateLimit:    nil,
+			expectedSpec: nil,
+		},
+		{
+			name: "RateLimit policy found for the service",
+			rateLimit: &policyv1alpha1.RateLimit{
+				Spec: policyv1alpha1.RateLimitSpec{
+					Host: "s1.test.svc.cluster.local",
+					Local: &policyv1alpha1.LocalRateLimitSpec{
+						HTTP: &policyv1alpha1.HTTPLocalRateLimitSpec{
+							Requests: 10,
+							Unit:     "second",
+						},
+					},
+				},
+			},
+			expectedSpec: &policyv1alpha1.RateLimitSpec{
+				Host: "s1.test.svc.cluster.local",
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					HTTP: &policyv1alpha1.HTTPLocalRateLimitSpec{
+						Requests: 10,
+						Unit:     "second",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetRateLimitPolicy(svc).Return(tc.rateLimit).Times(1)
+
+			actual := mc.GetRateLimitPolicy(svc)
+			assert.Equal(tc.expectedSpec, actual)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package catalog
+
+import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// GetRequestAuthenticationPolicy returns the RequestAuthenticationSpec for the given service.
+// If no RequestAuthentication policy applies to the service, nil is returned.
+func (mc *MeshCatalog) GetRequestAuthenticationPolicy(svc service.MeshService) *policyv1alpha1.RequestAuthenticationSpec {
+	requestAuthn := mc.policyController.GetRequestAuthenticationPolicy(svc)
+	if requestAuthn == nil {
+		return nil
+	}
+
+	return requestAuthn.Spec.DeepCopy()
+}
@@ -0,0 +1,71 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetRequestAuthenticationPolicy(t *testing.T) {
+	svc := service.MeshService{Name: "s1", Namespace: "test"}
+
+	testCases := []struct {
+		name         string
+		requestAuthn *policyv1alpha1.RequestAuthentication
+		expectedSpec *policyv1alpha1.RequestAuthenticationSpec
+	}{
+		{
+			name:         "no RequestAuthentication policy for the service",
+			requestAuthn: nil,
+			expectedSpec: nil,
+		},
+		{
+			name: "RequestAuthentication policy found for the service",
+			requestAuthn: &policyv1alpha1.RequestAuthentication{
+				Spec: policyv1alpha1.RequestAuthenticationSpec{
+					Host: "s1.test.svc.cluster.local",
+					JWTRules: []policyv1alpha1.JWTRule{
+						{
+							Issuer:    "https://issuer.example.com",
+							Audiences: []string{"s1"},
+							JWKS:      `{"keys": []}`,
+						},
+					},
+				},
+			},
+			expectedSpec: &policyv1alpha1.RequestAuthenticationSpec{
+				Host: "s1.test.svc.cluster.local",
+				JWTRules: []policyv1alpha1.JWTRule{
+					{
+						Issuer:    "https://issuer.example.com",
+						Audiences: []string{"s1"},
+						JWKS:      `{"keys": []}`,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetRequestAuthenticationPolicy(svc).Return(tc.requestAuthn).Times(1)
+
+			actual := mc.GetRequestAuthenticationPolicy(svc)
+			assert.Equal(tc.expectedSpec, actual)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package catalog
+
+import (
+	"fmt"
+	"reflect"
+
+	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// retryDestinationKindService is the Service kind for a destination defined in a Retry policy
+	retryDestinationKindService = "Service"
+)
+
+// getRetryPolicy returns the RetryPolicySpec for the given downstream identity and upstream service
+// that applies to all the requests to the upstream service, ie. from the Retry policies without matches.
+// If multiple Retry policies match, the first one found is returned.
+func (mc *MeshCatalog) getRetryPolicy(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService) *policyv1alpha1.RetryPolicySpec {
+	for _, retry := range mc.listRetryPoliciesForUpstream(downstreamIdentity, upstreamSvc) {
+		if len(retry.Spec.Matches) == 0 {
+			return retry.Spec.RetryPolicy.DeepCopy()
+		}
+	}
+
+	return nil
+}
+
+// applyRouteRetryPolicies returns the given outbound routes to the upstream service with the Retry policies with
+// HTTPRouteGroup matches for the given downstream identity and upstream service applied to them.
+// The retry policy is set on the routes whose match is covered by a match selected by the Retry policy, and new routes
+// are derived from the routes whose match overlaps a selected match, see applyToHTTPRouteMatch.
+// If multiple Retry policies select the same requests, the first one found is applied.
+func (mc *MeshCatalog) applyRouteRetryPolicies(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService, routes []*trafficpolicy.RouteWeightedClusters) []*trafficpolicy.RouteWeightedClusters {
+	retriedRoutes := make(map[*trafficpolicy.RouteWeightedClusters]bool)
+
+	for _, retry := range mc.listRetryPoliciesForUpstream(downstreamIdentity, upstreamSvc) {
+		if len(retry.Spec.Matches) == 0 {
+			continue
+		}
+
+		retryPolicy := retry.Spec.RetryPolicy
+		for _, httpRouteMatch := range mc.getRetryHTTPRouteMatches(retry) {
+			routes = applyToHTTPRouteMatch(routes, httpRouteMatch,
+				func(route *trafficpolicy.RouteWeightedClusters) bool {
+					return retriedRoutes[route]
+				},
+				func(route *trafficpolicy.RouteWeightedClusters) {
+					route.RetryPolicy = retryPolicy.DeepCopy()
+					retriedRoutes[route] = true
+				})
+		}
+	}
+
+	return routes
+}
+
+// applyToHTTPRouteMatch returns the given routes with the given apply function applied to the requests matching the given
+// HTTP route match, without changing how the requests are routed. The apply function is called on the routes whose match
+// is covered by the HTTP route match, and on a new route derived from each route whose match overlaps the HTTP route match,
+// which precedes the route it is derived from and matches the requests matching both. The routes that the applied function
+// reports as already applied are left unchanged, and no route is derived from them.
+// A route is not derived if the overlap of the matches cannot be expressed as an HTTP route match, or if the requests
+// it would match are all matched by a preceding route.
+func applyToHTTPRouteMatch(routes []*trafficpolicy.RouteWeightedClusters, httpRouteMatch trafficpolicy.HTTPRouteMatch,
+	applied func(*trafficpolicy.RouteWeightedClusters) bool, apply func(*trafficpolicy.RouteWeightedClusters)) []*trafficpolicy.RouteWeightedClusters {
+	var result []*trafficpolicy.RouteWeightedClusters
+
+	for i, route := range routes {
+		if httpRouteMatchCovers(httpRouteMatch, route.HTTPRouteMatch) {
+			if !applied(route) {
+				apply(route)
+			}
+			result = append(result, route)
+			continue
+		}
+
+		if overlap, ok := intersectHTTPRouteMatches(route.HTTPRouteMatch, httpRouteMatch); ok && !applied(route) && !coveredByRoutes(result, overlap) {
+			derivedRoute := *route
+			derivedRoute.HTTPRouteMatch = overlap
+			derivedRoute.WeightedClusters = route.WeightedClusters.Clone()
+			apply(&derivedRoute)
+			result = append(result, &derivedRoute)
+		}
+		result = append(result, route)
+
+		// The requests matching the HTTP route match never reach the routes following a route that covers it
+		if httpRouteMatchCovers(route.HTTPRouteMatch, httpRouteMatch) {
+			return append(result, routes[i+1:]...)
+		}
+	}
+
+	return result
+}
+
+// coveredByRoutes returns a boolean indicating if the given HTTP route match is covered by the match of one of the given routes
+func coveredByRoutes(routes []*trafficpolicy.RouteWeightedClusters, httpRouteMatch trafficpolicy.HTTPRouteMatch) bool {
+	for _, route := range routes {
+		if httpRouteMatchCovers(route.HTTPRouteMatch, httpRouteMatch) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectHTTPRouteMatches returns the HTTP route match matching the requests that match both the given route match and
+// the given HTTP route match, with the timeouts, rewrite and redirect of the route match, and a boolean indicating if such
+// a match exists. The intersection is only computed when the path of one of the matches covers the path of the other.
+func intersectHTTPRouteMatches(routeMatch, httpRouteMatch trafficpolicy.HTTPRouteMatch) (trafficpolicy.HTTPRouteMatch, bool) {
+	intersection := routeMatch
+
+	switch {
+	case pathCovers(httpRouteMatch, routeMatch):
+	case pathCovers(routeMatch, httpRouteMatch):
+		intersection.Path = httpRouteMatch.Path
+		intersection.PathMatchType = httpRouteMatch.PathMatchType
+	default:
+		return trafficpolicy.HTTPRouteMatch{}, false
+	}
+
+	switch {
+	case methodsCover(httpRouteMatch.Methods, routeMatch.Methods):
+	case methodsCover(routeMatch.Methods, httpRouteMatch.Methods):
+		intersection.Methods = httpRouteMatch.Methods
+	default:
+		intersection.Methods = nil
+		for _, method := range routeMatch.Methods {
+			if methodsCover(httpRouteMatch.Methods, []string{method}) {
+				intersection.Methods = append(intersection.Methods, method)
+			}
+		}
+		if len(intersection.Methods) == 0 {
+			return trafficpolicy.HTTPRouteMatch{}, false
+		}
+	}
+
+	if len(httpRouteMatch.Headers) != 0 {
+		intersection.Headers = make(map[string]string)
+		for header, value := range routeMatch.Headers {
+			intersection.Headers[header] = value
+		}
+		for header, value := range httpRouteMatch.Headers {
+			if routeValue, ok := intersection.Headers[header]; ok && routeValue != value {
+				return trafficpolicy.HTTPRouteMatch{}, false
+			}
+			intersection.Headers[header] = value
+		}
+	}
+
+	intersection.QueryParams = append([]trafficpolicy.QueryParamMatch(nil), routeMatch.QueryParams...)
+	for _, queryParam := range httpRouteMatch.QueryParams {
+		found := false
+		for _, routeQueryParam := range routeMatch.QueryParams {
+			if reflect.DeepEqual(queryParam, routeQueryParam) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			intersection.QueryParams = append(intersection.QueryParams, queryParam)
+		}
+	}
+
+	return intersection, true
+}
+
+// listRetryPoliciesForUpstream returns the Retry policies for the given downstream identity that have the given
+// upstream service as a destination
+func (mc *MeshCatalog) listRetryPoliciesForUpstream(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService) []*policyv1alpha1.Retry {
+	if !mc.configurator.GetFeatureFlags().EnableRetryPolicy {
+		return nil
+	}
+
+	var retryPolicies []*policyv1alpha1.Retry
+	for _, retry := range mc.policyController.ListRetryPolicies(downstreamIdentity.ToK8sServiceAccount()) {
+		for _, dest := range retry.Spec.Destinations {
+			if dest.Kind != retryDestinationKindService {
+				log.Error().Msgf("Retry policy %s/%s destinations must be of kind %s, found %s", retry.Namespace, retry.Name, retryDestinationKindService, dest.Kind)
+				continue
+			}
+			if dest.Name == upstreamSvc.Name && dest.Namespace == upstreamSvc.Namespace {
+				retryPolicies = append(retryPolicies, retry)
+				break
+			}
+		}
+	}
+
+	return retryPolicies
+}
+
+// getRetryHTTPRouteMatches returns the HTTP route matches of the HTTPRouteGroup resources referenced in the given Retry policy
+func (mc *MeshCatalog) getRetryHTTPRouteMatches(retry *policyv1alpha1.Retry) []trafficpolicy.HTTPRouteMatch {
+	var httpRouteMatches []trafficpolicy.HTTPRouteMatch
+
+	// A TypedLocalObjectReference (Spec.Matches) is a reference to another object in the same namespace
+	for _, match := range retry.Spec.Matches {
+		if !matchesAPIGroup(match.APIGroup, smiSpecs.SchemeGroupVersion) || match.Kind != httpRouteGroupKind {
+			log.Error().Msgf("Unsupported match object %v specified in Retry policy %s/%s, ignoring it", match, retry.Namespace, retry.Name)
+			continue
+		}
+		httpRouteName := fmt.Sprintf("%s/%s", retry.Namespace, match.Name)
+		httpRouteGroup := mc.meshSpec.GetHTTPRouteGroup(httpRouteName)
+		if httpRouteGroup == nil {
+			log.Error().Msgf("Error fetching HTTPRouteGroup resource %s referenced in Retry policy %s/%s", httpRouteName, retry.Namespace, retry.Name)
+			continue
+		}
+		httpRouteMatches = append(httpRouteMatches, getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup)...)
+	}
+
+	return httpRouteMatches
+}
@@ -0,0 +1,291 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/smi"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetRetryPolicy(t *testing.T) {
+	var numRetries uint32 = 5
+	retryPolicySpec := policyv1alpha1.RetryPolicySpec{
+		RetryOn:       "5xx",
+		PerTryTimeout: &metav1.Duration{Duration: time.Second},
+		NumRetries:    &numRetries,
+	}
+
+	downstreamIdentity := identity.K8sServiceAccount{Name: "sa-1", Namespace: "test"}.ToServiceIdentity()
+	upstreamSvc := service.MeshService{Name: "s1", Namespace: "test"}
+
+	testCases := []struct {
+		name                string
+		enableRetryPolicy   bool
+		retryPolicies       []*policyv1alpha1.Retry
+		expectedRetryPolicy *policyv1alpha1.RetryPolicySpec
+	}{
+		{
+			name:                "retry policy feature flag is disabled",
+			enableRetryPolicy:   false,
+			expectedRetryPolicy: nil,
+		},
+		{
+			name:              "no retry policy for the upstream service",
+			enableRetryPolicy: true,
+			retryPolicies: []*policyv1alpha1.Retry{
+				{
+					Spec: policyv1alpha1.RetrySpec{
+						Destinations: []policyv1alpha1.RetrySrcDstSpec{
+							{Kind: "Service", Name: "s2", Namespace: "test"},
+						},
+						RetryPolicy: retryPolicySpec,
+					},
+				},
+			},
+			expectedRetryPolicy: nil,
+		},
+		{
+			name:              "destination of unsupported kind is ignored",
+			enableRetryPolicy: true,
+			retryPolicies: []*policyv1alpha1.Retry{
+				{
+					Spec: policyv1alpha1.RetrySpec{
+						Destinations: []policyv1alpha1.RetrySrcDstSpec{
+							{Kind: "ServiceAccount", Name: "s1", Namespace: "test"},
+						},
+						RetryPolicy: retryPolicySpec,
+					},
+				},
+			},
+			expectedRetryPolicy: nil,
+		},
+		{
+			name:              "retry policy found for the upstream service",
+			enableRetryPolicy: true,
+			retryPolicies: []*policyv1alpha1.Retry{
+				{
+					Spec: policyv1alpha1.RetrySpec{
+						Destinations: []policyv1alpha1.RetrySrcDstSpec{
+							{Kind: "Service", Name: "s2", Namespace: "test"},
+							{Kind: "Service", Name: "s1", Namespace: "test"},
+						},
+						RetryPolicy: retryPolicySpec,
+					},
+				},
+			},
+			expectedRetryPolicy: &retryPolicySpec,
+		},
+		{
+			name:              "retry policy with matches does not apply to all the requests",
+			enableRetryPolicy: true,
+			retryPolicies: []*policyv1alpha1.Retry{
+				{
+					Spec: policyv1alpha1.RetrySpec{
+						Destinations: []policyv1alpha1.RetrySrcDstSpec{
+							{Kind: "Service", Name: "s1", Namespace: "test"},
+						},
+						Matches: []corev1.TypedLocalObjectReference{
+							{Kind: "HTTPRouteGroup", Name: "s1-routes"},
+						},
+						RetryPolicy: retryPolicySpec,
+					},
+				},
+			},
+			expectedRetryPolicy: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCfg := configurator.NewMockConfigurator(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
+
+			mc := &MeshCatalog{
+				configurator:     mockCfg,
+				policyController: mockPolicyController,
+			}
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{EnableRetryPolicy: tc.enableRetryPolicy}).Times(1)
+			if tc.enableRetryPolicy {
+				mockPolicyController.EXPECT().ListRetryPolicies(downstreamIdentity.ToK8sServiceAccount()).Return(tc.retryPolicies).Times(1)
+			}
+
+			actual := mc.getRetryPolicy(downstreamIdentity, upstreamSvc)
+			assert.Equal(tc.expectedRetryPolicy, actual)
+		})
+	}
+}
+
+func TestApplyRouteRetryPolicies(t *testing.T) {
+	var numRetries uint32 = 5
+	serviceRetryPolicySpec := policyv1alpha1.RetryPolicySpec{
+		RetryOn: "connect-failure",
+	}
+	routeRetryPolicySpec := policyv1alpha1.RetryPolicySpec{
+		RetryOn:    "5xx",
+		NumRetries: &numRetries,
+	}
+
+	downstreamIdentity := identity.K8sServiceAccount{Name: "sa-1", Namespace: "test"}.ToServiceIdentity()
+	upstreamSvc := service.MeshService{Name: "s1", Namespace: "test"}
+	weightedCluster := service.WeightedCluster{ClusterName: "test/s1/local", Weight: 100}
+
+	httpRouteGroup := &smiSpecs.HTTPRouteGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-routes",
+			Namespace: "test",
+		},
+		Spec: smiSpecs.HTTPRouteGroupSpec{
+			Matches: []smiSpecs.HTTPMatch{
+				{
+					Name:      "checkout",
+					PathRegex: "/checkout",
+					Methods:   []string{"POST"},
+				},
+			},
+		},
+	}
+	checkoutMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          "/checkout",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"POST"},
+	}
+
+	canaryCluster := service.WeightedCluster{ClusterName: "test/s1-v2/local", Weight: 100}
+	canaryMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          ".*",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"*"},
+		Headers:       map[string]string{"x-canary": "true"},
+	}
+	canaryCheckoutMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          "/checkout",
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"POST"},
+		Headers:       map[string]string{"x-canary": "true"},
+	}
+
+	routeRetry := &policyv1alpha1.Retry{
+		ObjectMeta: metav1.ObjectMeta{Name: "route-retry", Namespace: "test"},
+		Spec: policyv1alpha1.RetrySpec{
+			Destinations: []policyv1alpha1.RetrySrcDstSpec{
+				{Kind: "Service", Name: "s1", Namespace: "test"},
+			},
+			Matches: []corev1.TypedLocalObjectReference{
+				{Kind: "HTTPRouteGroup", Name: "s1-routes"},
+			},
+			RetryPolicy: routeRetryPolicySpec,
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		retryPolicies  []*policyv1alpha1.Retry
+		routes         []*trafficpolicy.RouteWeightedClusters
+		expectedRoutes []*trafficpolicy.RouteWeightedClusters
+	}{
+		{
+			name:          "retry policy without matches does not add routes",
+			retryPolicies: []*policyv1alpha1.Retry{{Spec: policyv1alpha1.RetrySpec{Destinations: routeRetry.Spec.Destinations, RetryPolicy: serviceRetryPolicySpec}}},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+		},
+		{
+			name:          "retry policy with matches sets the retry policy on the route with the selected match",
+			retryPolicies: []*policyv1alpha1.Retry{routeRetry},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: checkoutMatch, WeightedClusters: mapset.NewSet(weightedCluster)},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: checkoutMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &routeRetryPolicySpec},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+		},
+		{
+			name:          "retry policy with matches adds a route derived from the wildcard route preceding it",
+			retryPolicies: []*policyv1alpha1.Retry{routeRetry},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: checkoutMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &routeRetryPolicySpec},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+		},
+		{
+			name:          "retry policy with matches adds routes derived from the overlapping TrafficSplit match route and wildcard route",
+			retryPolicies: []*policyv1alpha1.Retry{routeRetry},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: canaryMatch, WeightedClusters: mapset.NewSet(canaryCluster), RetryPolicy: &serviceRetryPolicySpec},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: canaryCheckoutMatch, WeightedClusters: mapset.NewSet(canaryCluster), RetryPolicy: &routeRetryPolicySpec},
+				{HTTPRouteMatch: canaryMatch, WeightedClusters: mapset.NewSet(canaryCluster), RetryPolicy: &serviceRetryPolicySpec},
+				{HTTPRouteMatch: checkoutMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &routeRetryPolicySpec},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &serviceRetryPolicySpec},
+			},
+		},
+		{
+			name:          "retry policy with matches sets the retry policy on the TrafficSplit match route it covers",
+			retryPolicies: []*policyv1alpha1.Retry{routeRetry},
+			routes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: canaryCheckoutMatch, WeightedClusters: mapset.NewSet(canaryCluster)},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster)},
+			},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{
+				{HTTPRouteMatch: canaryCheckoutMatch, WeightedClusters: mapset.NewSet(canaryCluster), RetryPolicy: &routeRetryPolicySpec},
+				{HTTPRouteMatch: checkoutMatch, WeightedClusters: mapset.NewSet(weightedCluster), RetryPolicy: &routeRetryPolicySpec},
+				{HTTPRouteMatch: trafficpolicy.WildCardRouteMatch, WeightedClusters: mapset.NewSet(weightedCluster)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockCfg := configurator.NewMockConfigurator(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+
+			mc := &MeshCatalog{
+				configurator:     mockCfg,
+				policyController: mockPolicyController,
+				meshSpec:         mockMeshSpec,
+			}
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{EnableRetryPolicy: true}).Times(1)
+			mockPolicyController.EXPECT().ListRetryPolicies(downstreamIdentity.ToK8sServiceAccount()).Return(tc.retryPolicies).Times(1)
+			mockMeshSpec.EXPECT().GetHTTPRouteGroup("test/s1-routes").Return(httpRouteGroup).AnyTimes()
+
+			actual := mc.applyRouteRetryPolicies(downstreamIdentity, upstreamSvc, tc.routes)
+			assert.Equal(tc.expectedRoutes, actual)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+
+	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// routeRewrite is the type used to represent a rewrite specified in the rewrite annotation on an HTTPRouteGroup
+type routeRewrite struct {
+	PathPrefix            string `json:"pathPrefix,omitempty"`
+	PathRegex             string `json:"pathRegex,omitempty"`
+	PathRegexSubstitution string `json:"pathRegexSubstitution,omitempty"`
+	Host                  string `json:"host,omitempty"`
+}
+
+// routeRedirect is the type used to represent a redirect specified in the redirect annotation on an HTTPRouteGroup
+type routeRedirect struct {
+	Scheme       string `json:"scheme,omitempty"`
+	Host         string `json:"host,omitempty"`
+	Port         uint32 `json:"port,omitempty"`
+	Path         string `json:"path,omitempty"`
+	PathPrefix   string `json:"pathPrefix,omitempty"`
+	ResponseCode uint32 `json:"responseCode,omitempty"`
+}
+
+// validRedirectResponseCodes are the HTTP status codes allowed for redirects
+var validRedirectResponseCodes = map[uint32]bool{
+	http.StatusMovedPermanently:  true,
+	http.StatusFound:             true,
+	http.StatusSeeOther:          true,
+	http.StatusTemporaryRedirect: true,
+	http.StatusPermanentRedirect: true,
+}
+
+// getRouteRewritesFromAnnotation returns a mapping of match names to the rewrites specified in the rewrite annotation
+// on the HTTPRouteGroup. The annotation value is a JSON object mapping match names to rewrites, ex.
+// {"v1-api": {"pathRegex": "^/v1/", "pathRegexSubstitution": "/"}}. Invalid rewrites are logged and ignored.
+func getRouteRewritesFromAnnotation(routeGroup *spec.HTTPRouteGroup) map[string]*trafficpolicy.HTTPRouteRewrite {
+	value, ok := routeGroup.Annotations[constants.RewriteAnnotation]
+	if !ok {
+		return nil
+	}
+
+	var annotatedRewrites map[string]routeRewrite
+	if err := json.Unmarshal([]byte(value), &annotatedRewrites); err != nil {
+		log.Error().Err(err).Msgf("Invalid value for annotation %s on HTTPRouteGroup %s/%s, expected a JSON object mapping match names to rewrites; ignoring",
+			constants.RewriteAnnotation, routeGroup.Namespace, routeGroup.Name)
+		return nil
+	}
+
+	rewrites := make(map[string]*trafficpolicy.HTTPRouteRewrite)
+	for matchName, rewrite := range annotatedRewrites {
+		if rewrite.PathPrefix != "" && rewrite.PathRegex != "" {
+			log.Error().Msgf("Invalid rewrite for match %s in annotation %s on HTTPRouteGroup %s/%s, pathPrefix and pathRegex are mutually exclusive; ignoring",
+				matchName, constants.RewriteAnnotation, routeGroup.Namespace, routeGroup.Name)
+			continue
+		}
+		if _, err := regexp.Compile(rewrite.PathRegex); err != nil {
+			log.Error().Err(err).Msgf("Invalid path regex %q for match %s in annotation %s on HTTPRouteGroup %s/%s; ignoring",
+				rewrite.PathRegex, matchName, constants.RewriteAnnotation, routeGroup.Namespace, routeGroup.Name)
+			continue
+		}
+
+		rewrites[matchName] = &trafficpolicy.HTTPRouteRewrite{
+			PathPrefix:            rewrite.PathPrefix,
+			PathRegex:             rewrite.PathRegex,
+			PathRegexSubstitution: rewrite.PathRegexSubstitution,
+			Host:                  rewrite.Host,
+		}
+	}
+
+	return rewrites
+}
+
+// getRouteRedirectsFromAnnotation returns a mapping of match names to the redirects specified in the redirect annotation
+// on the HTTPRouteGroup. The annotation value is a JSON object mapping match names to redirects, ex.
+// {"old-api": {"pathPrefix": "/v2/", "responseCode": 308}}. Invalid redirects are logged and ignored.
+func getRouteRedirectsFromAnnotation(routeGroup *spec.HTTPRouteGroup) map[string]*trafficpolicy.HTTPRouteRedirect {
+	value, ok := routeGroup.Annotations[constants.RedirectAnnotation]
+	if !ok {
+		return nil
+	}
+
+	var annotatedRedirects map[string]routeRedirect
+	if err := json.Unmarshal([]byte(value), &annotatedRedirects); err != nil {
+		log.Error().Err(err).Msgf("Invalid value for annotation %s on HTTPRouteGroup %s/%s, expected a JSON object mapping match names to redirects; ignoring",
+			constants.RedirectAnnotation, routeGroup.Namespace, routeGroup.Name)
+		return nil
+	}
+
+	redirects := make(map[string]*trafficpolicy.HTTPRouteRedirect)
+	for matchName, redirect := range annotatedRedirects {
+		if redirect.Path != "" && redirect.PathPrefix != "" {
+			log.Error().Msgf("Invalid redirect for match %s in annotation %s on HTTPRouteGroup %s/%s, path and pathPrefix are mutually exclusive; ignoring",
+				matchName, constants.RedirectAnnotation, routeGroup.Namespace, routeGroup.Name)
+			continue
+		}
+		if redirect.ResponseCode != 0 && !validRedirectResponseCodes[redirect.ResponseCode] {
+			log.Error().Msgf("Invalid response code %d for match %s in annotation %s on HTTPRouteGroup %s/%s; ignoring",
+				redirect.ResponseCode, matchName, constants.RedirectAnnotation, routeGroup.Namespace, routeGroup.Name)
+			continue
+		}
+
+		redirects[matchName] = &trafficpolicy.HTTPRouteRedirect{
+			Scheme:       redirect.Scheme,
+			Host:         redirect.Host,
+			Port:         redirect.Port,
+			Path:         redirect.Path,
+			PathPrefix:   redirect.PathPrefix,
+			ResponseCode: redirect.ResponseCode,
+		}
+	}
+
+	return redirects
+}
@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"testing"
+
+	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetRouteRewritesFromAnnotation(t *testing.T) {
+	testCases := []struct {
+		name             string
+		annotations      map[string]string
+		expectedRewrites map[string]*trafficpolicy.HTTPRouteRewrite
+	}{
+		{
+			name:             "annotation not set",
+			annotations:      nil,
+			expectedRewrites: nil,
+		},
+		{
+			name: "malformed annotation is ignored",
+			annotations: map[string]string{
+				constants.RewriteAnnotation: "v1-api=/",
+			},
+			expectedRewrites: nil,
+		},
+		{
+			name: "valid rewrites for multiple matches",
+			annotations: map[string]string{
+				constants.RewriteAnnotation: `{"v1-api": {"pathPrefix": "/", "host": "bookstore-v2"}, "books": {"pathRegex": "^/books/(.*)$", "pathRegexSubstitution": "/\\1"}}`,
+			},
+			expectedRewrites: map[string]*trafficpolicy.HTTPRouteRewrite{
+				"v1-api": {PathPrefix: "/", Host: "bookstore-v2"},
+				"books":  {PathRegex: "^/books/(.*)$", PathRegexSubstitution: `/\1`},
+			},
+		},
+		{
+			name: "invalid rewrites are ignored",
+			annotations: map[string]string{
+				constants.RewriteAnnotation: `{"both": {"pathPrefix": "/", "pathRegex": "^/v1"}, "invalid-regex": {"pathRegex": "(["}, "host": {"host": "bookstore"}}`,
+			},
+			expectedRewrites: map[string]*trafficpolicy.HTTPRouteRewrite{
+				"host": {Host: "bookstore"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			routeGroup := &spec.HTTPRouteGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bookstore-service-routes",
+					Namespace:   "default",
+					Annotations: tc.annotations,
+				},
+			}
+
+			actual := getRouteRewritesFromAnnotation(routeGroup)
+			assert.Equal(tc.expectedRewrites, actual)
+		})
+	}
+}
+
+func TestGetRouteRedirectsFromAnnotation(t *testing.T) {
+	testCases := []struct {
+		name              string
+		annotations       map[string]string
+		expectedRedirects map[string]*trafficpolicy.HTTPRouteRedirect
+	}{
+		{
+			name:              "annotation not set",
+			annotations:       nil,
+			expectedRedirects: nil,
+		},
+		{
+			name: "malformed annotation is ignored",
+			annotations: map[string]string{
+				constants.RedirectAnnotation: "old-api",
+			},
+			expectedRedirects: nil,
+		},
+		{
+			name: "valid redirects for multiple matches",
+			annotations: map[string]string{
+				constants.RedirectAnnotation: `{"old-api": {"pathPrefix": "/v2/", "responseCode": 308}, "http": {"scheme": "https", "port": 443}}`,
+			},
+			expectedRedirects: map[string]*trafficpolicy.HTTPRouteRedirect{
+				"old-api": {PathPrefix: "/v2/", ResponseCode: 308},
+				"http":    {Scheme: "https", Port: 443},
+			},
+		},
+		{
+			name: "invalid redirects are ignored",
+			annotations: map[string]string{
+				constants.RedirectAnnotation: `{"both": {"path": "/v2", "pathPrefix": "/v2/"}, "invalid-code": {"path": "/v2", "responseCode": 200}, "host": {"host": "bookstore-v2"}}`,
+			},
+			expectedRedirects: map[string]*trafficpolicy.HTTPRouteRedirect{
+				"host": {Host: "bookstore-v2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			routeGroup := &spec.HTTPRouteGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bookstore-service-routes",
+					Namespace:   "default",
+					Annotations: tc.annotations,
+				},
+			}
+
+			actual := getRouteRedirectsFromAnnotation(routeGroup)
+			assert.Equal(tc.expectedRedirects, actual)
+		})
+	}
+}
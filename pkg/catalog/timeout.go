@@ -0,0 +1,44 @@
+package catalog
+
+import (
+	"strings"
+	"time"
+
+	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+)
+
+// getRouteTimeoutsFromAnnotation returns a mapping of match names to the timeouts specified in the given
+// annotation on the HTTPRouteGroup. The annotation value is a comma separated list of <match-name>=<duration> pairs.
+// Malformed pairs are logged and ignored.
+func getRouteTimeoutsFromAnnotation(routeGroup *spec.HTTPRouteGroup, annotation string) map[string]time.Duration {
+	value, ok := routeGroup.Annotations[annotation]
+	if !ok {
+		return nil
+	}
+
+	timeouts := make(map[string]time.Duration)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			log.Error().Msgf("Invalid value %q in annotation %s on HTTPRouteGroup %s/%s, expected <match-name>=<duration>; ignoring",
+				pair, annotation, routeGroup.Namespace, routeGroup.Name)
+			continue
+		}
+
+		matchName := strings.TrimSpace(kv[0])
+		duration, err := time.ParseDuration(strings.TrimSpace(kv[1]))
+		if err != nil || duration < 0 {
+			log.Error().Err(err).Msgf("Invalid duration %q for match %s in annotation %s on HTTPRouteGroup %s/%s; ignoring",
+				kv[1], matchName, annotation, routeGroup.Namespace, routeGroup.Name)
+			continue
+		}
+		timeouts[matchName] = duration
+	}
+
+	return timeouts
+}
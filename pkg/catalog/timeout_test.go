@@ -0,0 +1,62 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func TestGetRouteTimeoutsFromAnnotation(t *testing.T) {
+	testCases := []struct {
+		name             string
+		annotations      map[string]string
+		expectedTimeouts map[string]time.Duration
+	}{
+		{
+			name:             "annotation not set",
+			annotations:      nil,
+			expectedTimeouts: nil,
+		},
+		{
+			name: "valid timeouts for multiple matches",
+			annotations: map[string]string{
+				constants.RequestTimeoutAnnotation: "buy-books=30s, stream-events=0s",
+			},
+			expectedTimeouts: map[string]time.Duration{
+				"buy-books":     30 * time.Second,
+				"stream-events": 0,
+			},
+		},
+		{
+			name: "malformed entries are ignored",
+			annotations: map[string]string{
+				constants.RequestTimeoutAnnotation: "buy-books,sell-books=invalid,,stream-events=-1s,ping=1m",
+			},
+			expectedTimeouts: map[string]time.Duration{
+				"ping": time.Minute,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			routeGroup := &spec.HTTPRouteGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bookstore-service-routes",
+					Namespace:   "default",
+					Annotations: tc.annotations,
+				},
+			}
+
+			actual := getRouteTimeoutsFromAnnotation(routeGroup, constants.RequestTimeoutAnnotation)
+			assert.Equal(tc.expectedTimeouts, actual)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"net"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// shadowHostnameSuffix is the suffix Envoy adds to the host of the mirrored requests
+	shadowHostnameSuffix = "-shadow"
+)
+
+// applyTrafficMirrorPolicy mirrors the requests on the given outbound routes to the upstream service as specified by
+// the TrafficMirror policy for the upstream service.
+// The requests are only mirrored if the downstream identity is allowed to access the mirror backend by the access
+// policies, in which case the cluster and endpoints of the backend are programmed on the downstream proxy.
+func (mc *MeshCatalog) applyTrafficMirrorPolicy(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService, routes []*trafficpolicy.RouteWeightedClusters) {
+	trafficMirror := mc.policyController.GetTrafficMirrorPolicy(upstreamSvc)
+	if trafficMirror == nil {
+		return
+	}
+
+	backendSvc := service.MeshService{
+		Name:          trafficMirror.Spec.Backend,
+		Namespace:     trafficMirror.Namespace,
+		ClusterDomain: constants.LocalDomain,
+	}
+	if backendSvc.Name == upstreamSvc.Name {
+		log.Error().Msgf("TrafficMirror policy %s/%s mirrors requests to the root service %s, skipping it", trafficMirror.Namespace, trafficMirror.Name, upstreamSvc)
+		return
+	}
+	if !mc.isAllowedOutboundService(downstreamIdentity, backendSvc) {
+		log.Warn().Msgf("Identity %s is not allowed to access mirror backend %s of TrafficMirror policy %s/%s, requests to %s are not mirrored",
+			downstreamIdentity, backendSvc, trafficMirror.Namespace, trafficMirror.Name, upstreamSvc)
+		return
+	}
+
+	mirror := &trafficpolicy.RequestMirror{
+		ClusterName: getDefaultWeightedClusterForService(backendSvc).ClusterName,
+		Percentage:  trafficMirror.Spec.Percentage,
+	}
+	for _, route := range routes {
+		route.Mirror = mirror
+	}
+}
+
+// isAllowedOutboundService returns a boolean indicating if the given identity is allowed to access the given service
+func (mc *MeshCatalog) isAllowedOutboundService(downstreamIdentity identity.ServiceIdentity, svc service.MeshService) bool {
+	for _, allowedSvc := range mc.ListOutboundServicesForIdentity(downstreamIdentity) {
+		if allowedSvc.Name == svc.Name && allowedSvc.Namespace == svc.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// getMirroredHostnames returns the hostnames of the requests mirrored to the given backend service by
+// TrafficMirror policies, so that the mirrored requests are routed to the backend service on its proxy.
+func (mc *MeshCatalog) getMirroredHostnames(backendSvc service.MeshService) []string {
+	var hostnames []string
+
+	for _, trafficMirror := range mc.policyController.ListTrafficMirrorPoliciesForBackend(backendSvc) {
+		rootSvc := service.MeshService{
+			Name:          k8s.GetServiceFromHostname(trafficMirror.Spec.Host),
+			Namespace:     trafficMirror.Namespace,
+			ClusterDomain: constants.LocalDomain,
+		}
+		rootHostnames, err := mc.GetServiceHostnames(rootSvc, service.LocalNS)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error getting service hostnames for root service %s of TrafficMirror policy %s/%s",
+				rootSvc, trafficMirror.Namespace, trafficMirror.Name)
+			continue
+		}
+		for _, hostname := range rootHostnames {
+			hostnames = append(hostnames, getShadowHostname(hostname))
+		}
+	}
+
+	return hostnames
+}
+
+// getShadowHostname returns the host of the requests mirrored by Envoy for the given hostname,
+// which has the shadow suffix added to the host before the port, if any.
+func getShadowHostname(hostname string) string {
+	if host, port, err := net.SplitHostPort(hostname); err == nil {
+		return net.JoinHostPort(host+shadowHostnameSuffix, port)
+	}
+	return hostname + shadowHostnameSuffix
+}
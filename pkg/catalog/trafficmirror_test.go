@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestApplyTrafficMirrorPolicy(t *testing.T) {
+	downstreamIdentity := identity.K8sServiceAccount{Name: "client", Namespace: "test"}.ToServiceIdentity()
+	upstreamSvc := service.MeshService{Name: "s1", Namespace: "test", ClusterDomain: constants.LocalDomain}
+	backendSvc := service.MeshService{Name: "s1-v2", Namespace: "test", ClusterDomain: constants.LocalDomain}
+
+	trafficMirror := &policyv1alpha1.TrafficMirror{
+		ObjectMeta: metav1.ObjectMeta{Name: "s1-mirror", Namespace: "test"},
+		Spec: policyv1alpha1.TrafficMirrorSpec{
+			Host:       "s1.test.svc.cluster.local",
+			Backend:    "s1-v2",
+			Percentage: 10,
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		trafficMirror  *policyv1alpha1.TrafficMirror
+		meshServices   []service.MeshService
+		expectedMirror *trafficpolicy.RequestMirror
+	}{
+		{
+			name:           "no TrafficMirror policy for the upstream service",
+			trafficMirror:  nil,
+			meshServices:   []service.MeshService{upstreamSvc, backendSvc},
+			expectedMirror: nil,
+		},
+		{
+			name:           "mirror backend is not allowed",
+			trafficMirror:  trafficMirror,
+			meshServices:   []service.MeshService{upstreamSvc},
+			expectedMirror: nil,
+		},
+		{
+			name:          "mirror backend is allowed",
+			trafficMirror: trafficMirror,
+			meshServices:  []service.MeshService{upstreamSvc, backendSvc},
+			expectedMirror: &trafficpolicy.RequestMirror{
+				ClusterName: "test/s1-v2/local",
+				Percentage:  10,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			mockKubeController := k8s.NewMockController(mockCtrl)
+			mockServiceProvider := service.NewMockProvider(mockCtrl)
+
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+				configurator:     mockConfigurator,
+				kubeController:   mockKubeController,
+				serviceProviders: []service.Provider{mockServiceProvider},
+			}
+
+			mockPolicyController.EXPECT().GetTrafficMirrorPolicy(upstreamSvc).Return(tc.trafficMirror).Times(1)
+			mockConfigurator.EXPECT().GetFeatureFlags().Return(configv1alpha1.FeatureFlags{}).AnyTimes()
+			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(true).AnyTimes()
+			mockKubeController.EXPECT().GetNamespace(gomock.Any()).Return(nil).AnyTimes()
+			mockServiceProvider.EXPECT().ListServices().Return(tc.meshServices, nil).AnyTimes()
+
+			routes := []*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSet(getDefaultWeightedClusterForService(upstreamSvc)),
+				},
+			}
+
+			mc.applyTrafficMirrorPolicy(downstreamIdentity, upstreamSvc, routes)
+			assert.Equal(tc.expectedMirror, routes[0].Mirror)
+		})
+	}
+}
+
+func TestGetMirroredHostnames(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockServiceProvider := service.NewMockProvider(mockCtrl)
+
+	mc := &MeshCatalog{
+		policyController: mockPolicyController,
+		kubeController:   mockKubeController,
+		serviceProviders: []service.Provider{mockServiceProvider},
+	}
+
+	rootSvc := service.MeshService{Name: "s1", Namespace: "test", ClusterDomain: constants.LocalDomain}
+	backendSvc := service.MeshService{Name: "s1-v2", Namespace: "test", ClusterDomain: constants.LocalDomain}
+
+	mockPolicyController.EXPECT().ListTrafficMirrorPoliciesForBackend(backendSvc).Return([]*policyv1alpha1.TrafficMirror{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "s1-mirror", Namespace: "test"},
+			Spec: policyv1alpha1.TrafficMirrorSpec{
+				Host:       "s1.test.svc.cluster.local",
+				Backend:    "s1-v2",
+				Percentage: 10,
+			},
+		},
+	}).Times(1)
+	mockKubeController.EXPECT().GetService(rootSvc).Return(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "test"},
+	}).Times(1)
+	mockServiceProvider.EXPECT().GetHostnamesForService(gomock.Any(), service.LocalNS).Return([]string{"s1", "s1:8080", "s1.test.svc.cluster.local"}, nil).Times(1)
+
+	actual := mc.getMirroredHostnames(backendSvc)
+	assert.Equal([]string{"s1-shadow", "s1-shadow:8080", "s1.test.svc.cluster.local-shadow"}, actual)
+}
@@ -2,10 +2,12 @@ package catalog
 
 import (
 	"fmt"
+	"strconv"
 
 	mapset "github.com/deckarep/golang-set"
 	smiAccess "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 
+	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
@@ -19,6 +21,9 @@ const (
 
 	// httpRouteGroupKind is the kind specified for the HTTP route rules in an SMI Traffictarget policy
 	httpRouteGroupKind = "HTTPRouteGroup"
+
+	// grpcRouteGroupKind is the kind specified for the gRPC route rules in an SMI Traffictarget policy
+	grpcRouteGroupKind = "GRPCRouteGroup"
 )
 
 // ListInboundServiceIdentities lists the downstream service identities that are allowed to connect to the given service identity
@@ -33,20 +38,26 @@ func (mc *MeshCatalog) ListOutboundServiceIdentities(downstream identity.Service
 	return mc.getAllowedDirectionalServiceAccounts(downstream, outbound)
 }
 
-// ListInboundTrafficTargetsWithRoutes returns a list traffic target objects composed of its routes for the given destination service account
+// ListInboundTrafficTargetsWithRoutes returns a list traffic target objects composed of its routes for the given destination service account.
+// The traffic targets evaluated in shadow mode are included, and are the only ones returned when the permissive traffic policy
+// mode is enabled for the destination service account.
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 func (mc *MeshCatalog) ListInboundTrafficTargetsWithRoutes(upstream identity.ServiceIdentity) ([]trafficpolicy.TrafficTargetWithRoutes, error) {
 	var trafficTargets []trafficpolicy.TrafficTargetWithRoutes
 
-	if mc.configurator.IsPermissiveTrafficPolicyMode() {
-		return nil, nil
-	}
+	permissiveMode := mc.IsPermissiveTrafficPolicyMode(upstream)
 
 	for _, t := range mc.meshSpec.ListTrafficTargets() { // loop through all traffic targets
 		if !isValidTrafficTarget(t) {
 			continue
 		}
 
+		shadow := isShadowTrafficTarget(t)
+		if permissiveMode && !shadow {
+			// Traffic targets are not enforced in permissive mode, only the ones in shadow mode are evaluated
+			continue
+		}
+
 		destinationSvcIdentity := trafficTargetIdentityToSvcAccount(t.Spec.Destination).ToServiceIdentity()
 		if destinationSvcIdentity != upstream {
 			continue
@@ -58,6 +69,7 @@ func (mc *MeshCatalog) ListInboundTrafficTargetsWithRoutes(upstream identity.Ser
 		trafficTarget := trafficpolicy.TrafficTargetWithRoutes{
 			Name:        fmt.Sprintf("%s/%s", t.Namespace, t.Name),
 			Destination: destinationIdentity,
+			Shadow:      shadow,
 		}
 
 		// Source identifies for this traffic target
@@ -86,7 +98,7 @@ func (mc *MeshCatalog) getAllowedDirectionalServiceAccounts(svcIdentity identity
 	svcAccount := svcIdentity.ToK8sServiceAccount()
 	allowed := mapset.NewSet()
 
-	allTrafficTargets := mc.meshSpec.ListTrafficTargets()
+	allTrafficTargets := mc.listEnforcedTrafficTargets()
 	for _, trafficTarget := range allTrafficTargets {
 		spec := trafficTarget.Spec
 
@@ -195,6 +207,38 @@ func (mc *MeshCatalog) getTCPRouteMatchesFromTrafficTarget(trafficTarget smiAcce
 	return matches, nil
 }
 
+// listEnforcedTrafficTargets returns the SMI TrafficTarget resources that are enforced, i.e. not evaluated in shadow mode
+func (mc *MeshCatalog) listEnforcedTrafficTargets() []*smiAccess.TrafficTarget {
+	var trafficTargets []*smiAccess.TrafficTarget
+	for _, t := range mc.meshSpec.ListTrafficTargets() {
+		if isShadowTrafficTarget(t) {
+			continue
+		}
+		trafficTargets = append(trafficTargets, t)
+	}
+	return trafficTargets
+}
+
+// isShadowTrafficTarget checks if the given SMI TrafficTarget object is evaluated in shadow mode
+func isShadowTrafficTarget(t *smiAccess.TrafficTarget) bool {
+	if t == nil {
+		return false
+	}
+
+	value, ok := t.Annotations[constants.ShadowAnnotation]
+	if !ok {
+		return false
+	}
+
+	shadow, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Error().Err(err).Msgf("Invalid value %q for annotation %s on TrafficTarget %s/%s, the TrafficTarget is enforced",
+			value, constants.ShadowAnnotation, t.Namespace, t.Name)
+		return false
+	}
+	return shadow
+}
+
 // isValidTrafficTarget checks if the given SMI TrafficTarget object is valid
 func isValidTrafficTarget(t *smiAccess.TrafficTarget) bool {
 	return t != nil && t.Spec.Rules != nil && len(t.Spec.Rules) > 0 && hasValidRulesKind(t.Spec.Rules)
@@ -204,7 +248,7 @@ func isValidTrafficTarget(t *smiAccess.TrafficTarget) bool {
 func hasValidRulesKind(rules []smiAccess.TrafficTargetRule) bool {
 	for _, rule := range rules {
 		switch rule.Kind {
-		case httpRouteGroupKind, tcpRouteKind:
+		case httpRouteGroupKind, grpcRouteGroupKind, tcpRouteKind:
 			// valid Kind for rules
 
 		default:
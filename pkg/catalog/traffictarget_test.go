@@ -2,17 +2,25 @@ package catalog
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/ghodss/yaml"
+
 	"github.com/golang/mock/gomock"
 	smiAccess "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
 	tassert "github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
 
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/smi"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
@@ -861,12 +869,15 @@ func TestListInboundTrafficTargetsWithRoutes(t *testing.T) {
 			// Initialize test objects
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
 			mockCfg := configurator.NewMockConfigurator(mockCtrl)
+			mockKubeController := k8s.NewMockController(mockCtrl)
 			meshCatalog := MeshCatalog{
-				meshSpec:     mockMeshSpec,
-				configurator: mockCfg,
+				meshSpec:       mockMeshSpec,
+				configurator:   mockCfg,
+				kubeController: mockKubeController,
 			}
 
 			mockCfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
+			mockKubeController.EXPECT().GetNamespace(gomock.Any()).Return(nil).AnyTimes()
 
 			// Mock TrafficTargets returned by MeshSpec, should return all TrafficTargets relevant for this test
 			mockMeshSpec.EXPECT().ListTrafficTargets().Return(tc.trafficTargets).AnyTimes()
@@ -1039,3 +1050,167 @@ func TestIsValidTrafficTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestListInboundTrafficTargetsWithRoutesInShadowMode(t *testing.T) {
+	newTrafficTarget := func(name string, annotations map[string]string) *smiAccess.TrafficTarget {
+		return &smiAccess.TrafficTarget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "ns-1",
+				Annotations: annotations,
+			},
+			Spec: smiAccess.TrafficTargetSpec{
+				Destination: smiAccess.IdentityBindingSubject{Kind: "ServiceAccount", Name: "sa-1", Namespace: "ns-1"},
+				Sources:     []smiAccess.IdentityBindingSubject{{Kind: "ServiceAccount", Name: "sa-2", Namespace: "ns-2"}},
+				Rules:       []smiAccess.TrafficTargetRule{{Kind: "HTTPRouteGroup", Name: "route-1", Matches: []string{"match-1"}}},
+			},
+		}
+	}
+	trafficTargets := []*smiAccess.TrafficTarget{
+		newTrafficTarget("enforced", nil),
+		newTrafficTarget("shadow", map[string]string{constants.ShadowAnnotation: "true"}),
+	}
+
+	testCases := []struct {
+		name                  string
+		permissiveMode        bool
+		expectedTargetsShadow map[string]bool
+	}{
+		{
+			name:                  "enforced and shadow traffic targets with permissive mode disabled",
+			permissiveMode:        false,
+			expectedTargetsShadow: map[string]bool{"ns-1/enforced": false, "ns-1/shadow": true},
+		},
+		{
+			name:                  "only shadow traffic targets with permissive mode enabled",
+			permissiveMode:        true,
+			expectedTargetsShadow: map[string]bool{"ns-1/shadow": true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockCfg := configurator.NewMockConfigurator(mockCtrl)
+			mockKubeController := k8s.NewMockController(mockCtrl)
+			meshCatalog := MeshCatalog{
+				meshSpec:       mockMeshSpec,
+				configurator:   mockCfg,
+				kubeController: mockKubeController,
+			}
+
+			mockCfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).Times(1)
+			mockKubeController.EXPECT().GetNamespace("ns-1").Return(nil).Times(1)
+			mockMeshSpec.EXPECT().ListTrafficTargets().Return(trafficTargets).Times(1)
+
+			actual, err := meshCatalog.ListInboundTrafficTargetsWithRoutes(identity.K8sServiceAccount{Namespace: "ns-1", Name: "sa-1"}.ToServiceIdentity())
+			assert.Nil(err)
+
+			actualTargetsShadow := make(map[string]bool)
+			for _, trafficTarget := range actual {
+				actualTargetsShadow[trafficTarget.Name] = trafficTarget.Shadow
+			}
+			assert.Equal(tc.expectedTargetsShadow, actualTargetsShadow)
+		})
+	}
+}
+
+func TestIsShadowTrafficTarget(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:        "no shadow annotation",
+			annotations: nil,
+			expected:    false,
+		},
+		{
+			name:        "shadow annotation set to true",
+			annotations: map[string]string{constants.ShadowAnnotation: "true"},
+			expected:    true,
+		},
+		{
+			name:        "shadow annotation set to false",
+			annotations: map[string]string{constants.ShadowAnnotation: "false"},
+			expected:    false,
+		},
+		{
+			name:        "invalid shadow annotation",
+			annotations: map[string]string{constants.ShadowAnnotation: "maybe"},
+			expected:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			trafficTarget := &smiAccess.TrafficTarget{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Annotations: tc.annotations},
+			}
+			assert.Equal(tc.expected, isShadowTrafficTarget(trafficTarget))
+		})
+	}
+}
+
+func TestRouteKindsInCRDs(t *testing.T) {
+	testCases := []struct {
+		name          string
+		crdFile       string
+		schemaPath    []string
+		expectedKinds []string
+	}{
+		{
+			name:          "TrafficTarget rules",
+			crdFile:       "access.yaml",
+			schemaPath:    []string{"spec", "rules", "kind"},
+			expectedKinds: []string{httpRouteGroupKind, grpcRouteGroupKind, tcpRouteKind},
+		},
+		{
+			name:          "TrafficSplit matches",
+			crdFile:       "split.yaml",
+			schemaPath:    []string{"spec", "matches", "kind"},
+			expectedKinds: []string{httpRouteGroupKind, grpcRouteGroupKind},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			content, err := ioutil.ReadFile(filepath.Join("..", "..", "charts", "osm", "crds", tc.crdFile))
+			assert.Nil(err)
+
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			assert.Nil(yaml.Unmarshal(content, crd))
+
+			var schema *apiextensionsv1.JSONSchemaProps
+			for _, version := range crd.Spec.Versions {
+				if version.Storage {
+					schema = version.Schema.OpenAPIV3Schema
+				}
+			}
+			assert.NotNil(schema)
+
+			for _, property := range tc.schemaPath {
+				if schema.Items != nil {
+					schema = schema.Items.Schema
+				}
+				propertySchema := schema.Properties[property]
+				schema = &propertySchema
+			}
+
+			var kinds []string
+			for _, kind := range schema.Enum {
+				kinds = append(kinds, strings.Trim(string(kind.Raw), `"`))
+			}
+			assert.Subset(kinds, tc.expectedKinds)
+		})
+	}
+}
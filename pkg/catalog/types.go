@@ -5,6 +5,7 @@
 package catalog
 
 import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/endpoint"
@@ -52,6 +53,9 @@ type MeshCataloger interface {
 	// ListOutboundServicesForIdentity list the services the given service identity is allowed to initiate outbound connections to
 	ListOutboundServicesForIdentity(identity.ServiceIdentity) []service.MeshService
 
+	// IsPermissiveTrafficPolicyMode returns whether the permissive traffic policy mode is enabled for the given service identity
+	IsPermissiveTrafficPolicyMode(identity.ServiceIdentity) bool
+
 	// ListInboundServiceIdentities lists the downstream service identities that are allowed to connect to the given service identity
 	ListInboundServiceIdentities(identity.ServiceIdentity) ([]identity.ServiceIdentity, error)
 
@@ -83,9 +87,20 @@ type MeshCataloger interface {
 	// actually exposed by the application binary, ie. 'spec.ports[].port' instead of 'spec.ports[].targetPort' for a Kubernetes service.
 	GetPortToProtocolMappingForService(service.MeshService) (map[uint32]string, error)
 
+	// GetHTTPUpgradeTypesForPort returns the HTTP upgrade types enabled on the given port of the service,
+	// where the port is the one used by downstream clients in their requests.
+	GetHTTPUpgradeTypesForPort(service.MeshService, uint32) []string
+
+	// GetHTTPUpgradeTypesForTargetPort returns the HTTP upgrade types enabled on the given port of the service,
+	// where the port is the actual port on which the application exposes the service.
+	GetHTTPUpgradeTypesForTargetPort(service.MeshService, uint32) []string
+
 	// ListInboundTrafficTargetsWithRoutes returns a list traffic target objects composed of its routes for the given destination service identity
 	ListInboundTrafficTargetsWithRoutes(identity.ServiceIdentity) ([]trafficpolicy.TrafficTargetWithRoutes, error)
 
+	// ListInboundDenyRules returns the deny rules for the Deny policies applied to the given destination service identity
+	ListInboundDenyRules(identity.ServiceIdentity) []*trafficpolicy.DenyRule
+
 	// GetWeightedClustersForUpstream lists the weighted cluster backends corresponding to the upstream service.
 	GetWeightedClustersForUpstream(service.MeshService) []service.WeightedCluster
 
@@ -100,6 +115,18 @@ type MeshCataloger interface {
 
 	// GetServiceHostnames returns the hostnames for this service, based on the locality of the source.
 	GetServiceHostnames(service.MeshService, service.Locality) ([]string, error)
+
+	// GetUpstreamTrafficSetting returns the UpstreamTrafficSetting policy spec applied to the given upstream service.
+	GetUpstreamTrafficSetting(service.MeshService) *policyv1alpha1.UpstreamTrafficSettingSpec
+
+	// GetRateLimitPolicy returns the RateLimit policy spec applied to the given service.
+	GetRateLimitPolicy(service.MeshService) *policyv1alpha1.RateLimitSpec
+
+	// GetCORSPolicy returns the CORSPolicy policy spec applied to the given service.
+	GetCORSPolicy(service.MeshService) *policyv1alpha1.CORSPolicySpec
+
+	// GetRequestAuthenticationPolicy returns the RequestAuthentication policy spec applied to the given service.
+	GetRequestAuthenticationPolicy(service.MeshService) *policyv1alpha1.RequestAuthenticationSpec
 }
 
 type trafficDirection string
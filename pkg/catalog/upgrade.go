@@ -0,0 +1,98 @@
+package catalog
+
+import (
+	"encoding/json"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// GetHTTPUpgradeTypesForPort returns the HTTP upgrade types enabled on the given port of the service,
+// where the port is the one used by downstream clients in their requests, ie. 'spec.ports[].port' for a Kubernetes service.
+func (mc *MeshCatalog) GetHTTPUpgradeTypesForPort(svc service.MeshService, port uint32) []string {
+	k8sSvc := mc.kubeController.GetService(svc)
+	if k8sSvc == nil {
+		return nil
+	}
+
+	for _, portSpec := range k8sSvc.Spec.Ports {
+		if uint32(portSpec.Port) == port {
+			return getHTTPUpgradeTypes(k8sSvc, portSpec.Name, portSpec.AppProtocol)
+		}
+	}
+
+	return nil
+}
+
+// GetHTTPUpgradeTypesForTargetPort returns the HTTP upgrade types enabled on the given port of the service,
+// where the port is the actual port on which the application exposes the service derived from the service's endpoints,
+// ie. 'spec.ports[].targetPort' for a Kubernetes service.
+func (mc *MeshCatalog) GetHTTPUpgradeTypesForTargetPort(svc service.MeshService, port uint32) []string {
+	k8sSvc := mc.kubeController.GetService(svc)
+	if k8sSvc == nil {
+		return nil
+	}
+
+	endpoints, err := mc.kubeController.GetEndpoints(svc)
+	if err != nil || endpoints == nil {
+		return nil
+	}
+
+	// Endpoint ports have the same names and application protocols as the service ports they are the target of
+	for _, endpointSet := range endpoints.Subsets {
+		for _, endpointPort := range endpointSet.Ports {
+			if uint32(endpointPort.Port) == port {
+				return getHTTPUpgradeTypes(k8sSvc, endpointPort.Name, endpointPort.AppProtocol)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getHTTPUpgradeTypes returns the HTTP upgrade types enabled on the service port with the given name and application protocol.
+// The WebSocket upgrade is enabled on ports with the 'websocket' application protocol. Upgrade types are also enabled on named
+// ports through the HTTP upgrade types annotation on the service, whose value is a JSON object mapping port names to lists of
+// upgrade types, ex. {"http-chat": ["websocket"]}.
+func getHTTPUpgradeTypes(k8sSvc *corev1.Service, portName string, appProtocol *string) []string {
+	var upgradeTypes []string
+
+	if appProtocol != nil && strings.EqualFold(*appProtocol, constants.ProtocolWebSocket) {
+		upgradeTypes = append(upgradeTypes, constants.ProtocolWebSocket)
+	}
+
+	value, ok := k8sSvc.Annotations[constants.HTTPUpgradeTypesAnnotation]
+	if !ok || portName == "" {
+		return upgradeTypes
+	}
+
+	var annotatedUpgradeTypes map[string][]string
+	if err := json.Unmarshal([]byte(value), &annotatedUpgradeTypes); err != nil {
+		log.Error().Err(err).Msgf("Invalid value for annotation %s on service %s/%s, expected a JSON object mapping port names to upgrade types; ignoring",
+			constants.HTTPUpgradeTypesAnnotation, k8sSvc.Namespace, k8sSvc.Name)
+		return upgradeTypes
+	}
+
+	for _, upgradeType := range annotatedUpgradeTypes[portName] {
+		if upgradeType == "" || containsUpgradeType(upgradeTypes, upgradeType) {
+			continue
+		}
+		upgradeTypes = append(upgradeTypes, upgradeType)
+	}
+
+	return upgradeTypes
+}
+
+// containsUpgradeType returns a boolean indicating if the given upgrade type is in the list of upgrade types.
+// Upgrade types are compared case-insensitively as the Upgrade header value is case-insensitive.
+func containsUpgradeType(upgradeTypes []string, upgradeType string) bool {
+	for _, u := range upgradeTypes {
+		if strings.EqualFold(u, upgradeType) {
+			return true
+		}
+	}
+	return false
+}
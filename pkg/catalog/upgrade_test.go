@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetHTTPUpgradeTypes(t *testing.T) {
+	websocket := constants.ProtocolWebSocket
+	http := constants.ProtocolHTTP
+
+	testCases := []struct {
+		name                 string
+		annotations          map[string]string
+		portName             string
+		appProtocol          *string
+		expectedUpgradeTypes []string
+	}{
+		{
+			name:                 "no upgrade types for an HTTP port without annotation",
+			portName:             "http-api",
+			appProtocol:          &http,
+			expectedUpgradeTypes: nil,
+		},
+		{
+			name:                 "websocket upgrade for a port with the websocket application protocol",
+			portName:             "chat",
+			appProtocol:          &websocket,
+			expectedUpgradeTypes: []string{"websocket"},
+		},
+		{
+			name:                 "upgrade types from the annotation for the named port",
+			annotations:          map[string]string{constants.HTTPUpgradeTypesAnnotation: `{"http-chat": ["websocket", "spdy/3.1"], "http-api": ["h2c"]}`},
+			portName:             "http-chat",
+			expectedUpgradeTypes: []string{"websocket", "spdy/3.1"},
+		},
+		{
+			name:                 "upgrade types from the application protocol and the annotation are not duplicated",
+			annotations:          map[string]string{constants.HTTPUpgradeTypesAnnotation: `{"chat": ["WebSocket", "spdy/3.1"]}`},
+			portName:             "chat",
+			appProtocol:          &websocket,
+			expectedUpgradeTypes: []string{"websocket", "spdy/3.1"},
+		},
+		{
+			name:                 "invalid annotation is ignored",
+			annotations:          map[string]string{constants.HTTPUpgradeTypesAnnotation: `["websocket"]`},
+			portName:             "chat",
+			appProtocol:          &websocket,
+			expectedUpgradeTypes: []string{"websocket"},
+		},
+		{
+			name:                 "annotation does not apply to unnamed ports",
+			annotations:          map[string]string{constants.HTTPUpgradeTypesAnnotation: `{"": ["websocket"]}`},
+			portName:             "",
+			expectedUpgradeTypes: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "s1",
+					Namespace:   "test",
+					Annotations: tc.annotations,
+				},
+			}
+
+			actual := getHTTPUpgradeTypes(svc, tc.portName, tc.appProtocol)
+			assert.Equal(tc.expectedUpgradeTypes, actual)
+		})
+	}
+}
+
+func TestGetHTTPUpgradeTypesForPort(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		kubeController: mockKubeController,
+	}
+
+	meshSvc := service.MeshService{Name: "s1", Namespace: "test"}
+	websocket := constants.ProtocolWebSocket
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "s1",
+			Namespace:   "test",
+			Annotations: map[string]string{constants.HTTPUpgradeTypesAnnotation: `{"http-api": ["h2c"]}`},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "chat", Port: 80, TargetPort: intstr.FromInt(8080), AppProtocol: &websocket},
+				{Name: "http-api", Port: 90, TargetPort: intstr.FromInt(9090)},
+			},
+		},
+	}
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: svc.ObjectMeta,
+		Subsets: []corev1.EndpointSubset{
+			{
+				Ports: []corev1.EndpointPort{
+					{Name: "chat", Port: 8080, AppProtocol: &websocket},
+					{Name: "http-api", Port: 9090},
+				},
+			},
+		},
+	}
+
+	mockKubeController.EXPECT().GetService(meshSvc).Return(svc).AnyTimes()
+	mockKubeController.EXPECT().GetEndpoints(meshSvc).Return(endpoints, nil).AnyTimes()
+
+	assert.Equal([]string{"websocket"}, mc.GetHTTPUpgradeTypesForPort(meshSvc, 80))
+	assert.Equal([]string{"h2c"}, mc.GetHTTPUpgradeTypesForPort(meshSvc, 90))
+	assert.Nil(mc.GetHTTPUpgradeTypesForPort(meshSvc, 8080))
+
+	assert.Equal([]string{"websocket"}, mc.GetHTTPUpgradeTypesForTargetPort(meshSvc, 8080))
+	assert.Equal([]string{"h2c"}, mc.GetHTTPUpgradeTypesForTargetPort(meshSvc, 9090))
+	assert.Nil(mc.GetHTTPUpgradeTypesForTargetPort(meshSvc, 80))
+
+	unknownSvc := service.MeshService{Name: "unknown", Namespace: "test"}
+	mockKubeController.EXPECT().GetService(unknownSvc).Return(nil).Times(2)
+	assert.Nil(mc.GetHTTPUpgradeTypesForPort(unknownSvc, 80))
+	assert.Nil(mc.GetHTTPUpgradeTypesForTargetPort(unknownSvc, 8080))
+}
@@ -0,0 +1,28 @@
+package catalog
+
+import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// GetUpstreamTrafficSetting returns the UpstreamTrafficSettingSpec for the given upstream service.
+// If no UpstreamTrafficSetting policy applies to the service, nil is returned.
+func (mc *MeshCatalog) GetUpstreamTrafficSetting(upstreamSvc service.MeshService) *policyv1alpha1.UpstreamTrafficSettingSpec {
+	upstreamTrafficSetting := mc.policyController.GetUpstreamTrafficSetting(upstreamSvc)
+	if upstreamTrafficSetting == nil {
+		return nil
+	}
+
+	return upstreamTrafficSetting.Spec.DeepCopy()
+}
+
+// getLoadBalancer returns the LoadBalancerSpec of the UpstreamTrafficSetting policy for the given upstream service.
+// If no UpstreamTrafficSetting policy applies to the service or the policy does not configure load balancing, nil is returned.
+func (mc *MeshCatalog) getLoadBalancer(upstreamSvc service.MeshService) *policyv1alpha1.LoadBalancerSpec {
+	upstreamTrafficSetting := mc.GetUpstreamTrafficSetting(upstreamSvc)
+	if upstreamTrafficSetting == nil {
+		return nil
+	}
+
+	return upstreamTrafficSetting.LoadBalancer
+}
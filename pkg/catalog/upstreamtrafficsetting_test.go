@@ -0,0 +1,127 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetUpstreamTrafficSetting(t *testing.T) {
+	var maxConnections uint32 = 10
+	upstreamSvc := service.MeshService{Name: "s1", Namespace: "test"}
+
+	testCases := []struct {
+		name                   string
+		upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting
+		expectedSpec           *policyv1alpha1.UpstreamTrafficSettingSpec
+	}{
+		{
+			name:                   "no UpstreamTrafficSetting for the upstream service",
+			upstreamTrafficSetting: nil,
+			expectedSpec:           nil,
+		},
+		{
+			name: "UpstreamTrafficSetting found for the upstream service",
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+				Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+					Host: "s1.test.svc.cluster.local",
+					ConnectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+						TCP: &policyv1alpha1.TCPConnectionSettings{
+							MaxConnections: &maxConnections,
+						},
+					},
+				},
+			},
+			expectedSpec: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				Host: "s1.test.svc.cluster.local",
+				ConnectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+					TCP: &policyv1alpha1.TCPConnectionSettings{
+						MaxConnections: &maxConnections,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(upstreamSvc).Return(tc.upstreamTrafficSetting).Times(1)
+
+			actual := mc.GetUpstreamTrafficSetting(upstreamSvc)
+			assert.Equal(tc.expectedSpec, actual)
+		})
+	}
+}
+
+func TestGetLoadBalancer(t *testing.T) {
+	upstreamSvc := service.MeshService{Name: "s1", Namespace: "test"}
+	loadBalancer := &policyv1alpha1.LoadBalancerSpec{
+		Type: policyv1alpha1.RingHashLoadBalancer,
+		ConsistentHash: &policyv1alpha1.ConsistentHashSpec{
+			Header: "x-user-id",
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting
+		expectedLoadBalancer   *policyv1alpha1.LoadBalancerSpec
+	}{
+		{
+			name:                   "no UpstreamTrafficSetting for the upstream service",
+			upstreamTrafficSetting: nil,
+			expectedLoadBalancer:   nil,
+		},
+		{
+			name: "UpstreamTrafficSetting without load balancing settings",
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+				Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+					Host: "s1.test.svc.cluster.local",
+				},
+			},
+			expectedLoadBalancer: nil,
+		},
+		{
+			name: "UpstreamTrafficSetting with load balancing settings",
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+				Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+					Host:         "s1.test.svc.cluster.local",
+					LoadBalancer: loadBalancer,
+				},
+			},
+			expectedLoadBalancer: loadBalancer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(upstreamSvc).Return(tc.upstreamTrafficSetting).Times(1)
+
+			actual := mc.getLoadBalancer(upstreamSvc)
+			assert.Equal(tc.expectedLoadBalancer, actual)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package configurator
 
 import (
 	"fmt"
+	"reflect"
 
 	"k8s.io/client-go/tools/cache"
 
@@ -168,6 +169,14 @@ func meshConfigUpdatedMessageHandler(psubMsg *events.PubSubMessage) {
 			(prevSpec.Traffic.InboundExternalAuthorization.FailureModeAllow != newSpec.Traffic.InboundExternalAuthorization.FailureModeAllow)
 	}
 
+	triggerGlobalBroadcast = triggerGlobalBroadcast || (prevSpec.Traffic.InboundGlobalRateLimit.Enable != newSpec.Traffic.InboundGlobalRateLimit.Enable)
+
+	// Do not trigger updates on the inner configuration changes of global rate limiting if disabled,
+	// or otherwise skip checking if the update is to be scheduled anyway
+	if newSpec.Traffic.InboundGlobalRateLimit.Enable && !triggerGlobalBroadcast {
+		triggerGlobalBroadcast = !reflect.DeepEqual(prevSpec.Traffic.InboundGlobalRateLimit, newSpec.Traffic.InboundGlobalRateLimit)
+	}
+
 	if triggerGlobalBroadcast {
 		log.Debug().Msgf("[%s] OSM MeshConfig update triggered global proxy broadcast",
 			psubMsg.AnnouncementType)
@@ -165,6 +165,20 @@ func TestMeshConfigEventTriggers(t *testing.T) {
 			},
 			expectProxyBroadcast: true,
 		},
+		{
+			caseName: "InboundGlobalRateLimit",
+			updateMeshConfigSpec: func(spec *v1alpha1.MeshConfigSpec) {
+				spec.Traffic.InboundGlobalRateLimit.Enable = true
+			},
+			expectProxyBroadcast: true,
+		},
+		{
+			caseName: "InboundGlobalRateLimit domain",
+			updateMeshConfigSpec: func(spec *v1alpha1.MeshConfigSpec) {
+				spec.Traffic.InboundGlobalRateLimit.Domain = "osm"
+			},
+			expectProxyBroadcast: true,
+		},
 		{
 			caseName: "osmLogLevel",
 			updateMeshConfigSpec: func(spec *v1alpha1.MeshConfigSpec) {
@@ -11,11 +11,21 @@ import (
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 )
 
 const (
 	// defaultServiceCertValidityDuration is the default validity duration for service certificates
 	defaultServiceCertValidityDuration = 24 * time.Hour
+
+	// defaultRequestTimeout is the default timeout for HTTP requests, same as Envoy's default
+	defaultRequestTimeout = 15 * time.Second
+
+	// defaultStreamIdleTimeout is the default idle timeout for HTTP streams, same as Envoy's default
+	defaultStreamIdleTimeout = 5 * time.Minute
+
+	// defaultGlobalRateLimitTimeout is the default timeout for responses from the rate limit service, same as Envoy's default
+	defaultGlobalRateLimitTimeout = 20 * time.Millisecond
 )
 
 // The functions in this file implement the configurator.Configurator interface
@@ -200,6 +210,62 @@ func (c *Client) GetInboundExternalAuthConfig() auth.ExtAuthConfig {
 	return extAuthConfig
 }
 
+// GetInboundGlobalRateLimitConfig returns the global rate limiting configuration for incoming traffic, if any
+func (c *Client) GetInboundGlobalRateLimitConfig() ratelimit.GlobalRateLimitConfig {
+	inboundGlobalRateLimitMeshConfig := c.getMeshConfig().Spec.Traffic.InboundGlobalRateLimit
+
+	globalRateLimitConfig := ratelimit.GlobalRateLimitConfig{
+		Enable:          inboundGlobalRateLimitMeshConfig.Enable,
+		Address:         inboundGlobalRateLimitMeshConfig.Address,
+		Port:            inboundGlobalRateLimitMeshConfig.Port,
+		Domain:          inboundGlobalRateLimitMeshConfig.Domain,
+		FailureModeDeny: inboundGlobalRateLimitMeshConfig.FailureModeDeny,
+		Descriptors:     inboundGlobalRateLimitMeshConfig.Descriptors,
+	}
+
+	duration, err := time.ParseDuration(inboundGlobalRateLimitMeshConfig.Timeout)
+	if err != nil {
+		log.Debug().Err(err).Msgf("GlobalRateLimitTimeout: Not a valid duration %s. defaulting to %s.", inboundGlobalRateLimitMeshConfig.Timeout, defaultGlobalRateLimitTimeout)
+		duration = defaultGlobalRateLimitTimeout
+	}
+	globalRateLimitConfig.Timeout = duration
+
+	// Rate limit requests per destination cluster when no descriptors are specified
+	if len(globalRateLimitConfig.Descriptors) == 0 {
+		globalRateLimitConfig.Descriptors = []v1alpha1.GlobalRateLimitDescriptorSpec{
+			{
+				Entries: []v1alpha1.GlobalRateLimitDescriptorEntrySpec{
+					{Type: v1alpha1.DestinationClusterDescriptorEntry},
+				},
+			},
+		}
+	}
+
+	return globalRateLimitConfig
+}
+
+// GetRequestTimeout returns the mesh-wide timeout for HTTP requests
+func (c *Client) GetRequestTimeout() time.Duration {
+	requestTimeout := c.getMeshConfig().Spec.Traffic.RequestTimeout
+	duration, err := time.ParseDuration(requestTimeout)
+	if err != nil {
+		log.Debug().Err(err).Msgf("RequestTimeout: Not a valid duration %s. defaulting to %s.", requestTimeout, defaultRequestTimeout)
+		return defaultRequestTimeout
+	}
+	return duration
+}
+
+// GetStreamIdleTimeout returns the mesh-wide idle timeout for HTTP streams
+func (c *Client) GetStreamIdleTimeout() time.Duration {
+	streamIdleTimeout := c.getMeshConfig().Spec.Traffic.StreamIdleTimeout
+	duration, err := time.ParseDuration(streamIdleTimeout)
+	if err != nil {
+		log.Debug().Err(err).Msgf("StreamIdleTimeout: Not a valid duration %s. defaulting to %s.", streamIdleTimeout, defaultStreamIdleTimeout)
+		return defaultStreamIdleTimeout
+	}
+	return duration
+}
+
 // GetClusterDomain returns the cluster domain name (experimental - multicluster)
 func (c *Client) GetClusterDomain() string {
 	return c.getMeshConfig().Spec.Experimental.MulticlusterSpec.ClusterDomain
@@ -16,6 +16,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/announcements"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/k8s/events"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 )
 
 func TestGetMeshConfigCacheKey(t *testing.T) {
@@ -376,6 +377,103 @@ func TestCreateUpdateConfig(t *testing.T) {
 				assert.Equal(interval, time.Duration(0))
 			},
 		},
+		{
+			name:                  "GetRequestTimeout",
+			initialMeshConfigData: &v1alpha1.MeshConfigSpec{},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(defaultRequestTimeout, cfg.GetRequestTimeout())
+			},
+			updatedMeshConfigData: &v1alpha1.MeshConfigSpec{
+				Traffic: v1alpha1.TrafficSpec{
+					RequestTimeout: "1m",
+				},
+			},
+			checkUpdate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(time.Minute, cfg.GetRequestTimeout())
+			},
+		},
+		{
+			name:                  "NegativeGetRequestTimeout",
+			initialMeshConfigData: &v1alpha1.MeshConfigSpec{},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(defaultRequestTimeout, cfg.GetRequestTimeout())
+			},
+			updatedMeshConfigData: &v1alpha1.MeshConfigSpec{
+				Traffic: v1alpha1.TrafficSpec{
+					RequestTimeout: "Non-duration string",
+				},
+			},
+			checkUpdate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(defaultRequestTimeout, cfg.GetRequestTimeout())
+			},
+		},
+		{
+			name:                  "GetStreamIdleTimeout",
+			initialMeshConfigData: &v1alpha1.MeshConfigSpec{},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(defaultStreamIdleTimeout, cfg.GetStreamIdleTimeout())
+			},
+			updatedMeshConfigData: &v1alpha1.MeshConfigSpec{
+				Traffic: v1alpha1.TrafficSpec{
+					StreamIdleTimeout: "0s",
+				},
+			},
+			checkUpdate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(time.Duration(0), cfg.GetStreamIdleTimeout())
+			},
+		},
+		{
+			name:                  "GetInboundGlobalRateLimitConfig",
+			initialMeshConfigData: &v1alpha1.MeshConfigSpec{},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				globalRateLimitConfig := cfg.GetInboundGlobalRateLimitConfig()
+				assert.False(globalRateLimitConfig.Enable)
+				assert.Equal(defaultGlobalRateLimitTimeout, globalRateLimitConfig.Timeout)
+				assert.Equal([]v1alpha1.GlobalRateLimitDescriptorSpec{
+					{
+						Entries: []v1alpha1.GlobalRateLimitDescriptorEntrySpec{
+							{Type: v1alpha1.DestinationClusterDescriptorEntry},
+						},
+					},
+				}, globalRateLimitConfig.Descriptors)
+			},
+			updatedMeshConfigData: &v1alpha1.MeshConfigSpec{
+				Traffic: v1alpha1.TrafficSpec{
+					InboundGlobalRateLimit: v1alpha1.GlobalRateLimitSpec{
+						Enable:          true,
+						Address:         "ratelimit.ratelimit.svc.cluster.local",
+						Port:            8081,
+						Domain:          "osm",
+						Timeout:         "100ms",
+						FailureModeDeny: true,
+						Descriptors: []v1alpha1.GlobalRateLimitDescriptorSpec{
+							{
+								Entries: []v1alpha1.GlobalRateLimitDescriptorEntrySpec{
+									{Type: v1alpha1.RequestHeaderDescriptorEntry, Key: "user", Header: "x-user-id"},
+								},
+							},
+						},
+					},
+				},
+			},
+			checkUpdate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(ratelimit.GlobalRateLimitConfig{
+					Enable:          true,
+					Address:         "ratelimit.ratelimit.svc.cluster.local",
+					Port:            8081,
+					Domain:          "osm",
+					Timeout:         100 * time.Millisecond,
+					FailureModeDeny: true,
+					Descriptors: []v1alpha1.GlobalRateLimitDescriptorSpec{
+						{
+							Entries: []v1alpha1.GlobalRateLimitDescriptorEntrySpec{
+								{Type: v1alpha1.RequestHeaderDescriptorEntry, Key: "user", Header: "x-user-id"},
+							},
+						},
+					},
+				}, cfg.GetInboundGlobalRateLimitConfig())
+			},
+		},
 		{
 			name:                  "GetMaxDataplaneConnections",
 			initialMeshConfigData: &v1alpha1.MeshConfigSpec{},
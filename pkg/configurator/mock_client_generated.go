@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
 	auth "github.com/openservicemesh/osm/pkg/auth"
+	ratelimit "github.com/openservicemesh/osm/pkg/ratelimit"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -121,6 +122,20 @@ func (mr *MockConfiguratorMockRecorder) GetInboundExternalAuthConfig() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInboundExternalAuthConfig", reflect.TypeOf((*MockConfigurator)(nil).GetInboundExternalAuthConfig))
 }
 
+// GetInboundGlobalRateLimitConfig mocks base method
+func (m *MockConfigurator) GetInboundGlobalRateLimitConfig() ratelimit.GlobalRateLimitConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInboundGlobalRateLimitConfig")
+	ret0, _ := ret[0].(ratelimit.GlobalRateLimitConfig)
+	return ret0
+}
+
+// GetInboundGlobalRateLimitConfig indicates an expected call of GetInboundGlobalRateLimitConfig
+func (mr *MockConfiguratorMockRecorder) GetInboundGlobalRateLimitConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInboundGlobalRateLimitConfig", reflect.TypeOf((*MockConfigurator)(nil).GetInboundGlobalRateLimitConfig))
+}
+
 // GetInboundPortExclusionList mocks base method
 func (m *MockConfigurator) GetInboundPortExclusionList() []int {
 	m.ctrl.T.Helper()
@@ -248,6 +263,20 @@ func (mr *MockConfiguratorMockRecorder) GetProxyResources() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProxyResources", reflect.TypeOf((*MockConfigurator)(nil).GetProxyResources))
 }
 
+// GetRequestTimeout mocks base method
+func (m *MockConfigurator) GetRequestTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetRequestTimeout indicates an expected call of GetRequestTimeout
+func (mr *MockConfiguratorMockRecorder) GetRequestTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestTimeout", reflect.TypeOf((*MockConfigurator)(nil).GetRequestTimeout))
+}
+
 // GetServiceCertValidityPeriod mocks base method
 func (m *MockConfigurator) GetServiceCertValidityPeriod() time.Duration {
 	m.ctrl.T.Helper()
@@ -262,6 +291,20 @@ func (mr *MockConfiguratorMockRecorder) GetServiceCertValidityPeriod() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceCertValidityPeriod", reflect.TypeOf((*MockConfigurator)(nil).GetServiceCertValidityPeriod))
 }
 
+// GetStreamIdleTimeout mocks base method
+func (m *MockConfigurator) GetStreamIdleTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamIdleTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetStreamIdleTimeout indicates an expected call of GetStreamIdleTimeout
+func (mr *MockConfiguratorMockRecorder) GetStreamIdleTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamIdleTimeout", reflect.TypeOf((*MockConfigurator)(nil).GetStreamIdleTimeout))
+}
+
 // GetTracingEndpoint mocks base method
 func (m *MockConfigurator) GetTracingEndpoint() string {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/logger"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 )
 
 var (
@@ -97,6 +98,17 @@ type Configurator interface {
 	// GetInboundExternalAuthConfig returns the External Authentication configuration for incoming traffic, if any
 	GetInboundExternalAuthConfig() auth.ExtAuthConfig
 
+	// GetInboundGlobalRateLimitConfig returns the global rate limiting configuration for incoming traffic, if any
+	GetInboundGlobalRateLimitConfig() ratelimit.GlobalRateLimitConfig
+
+	// GetRequestTimeout returns the mesh-wide timeout for HTTP requests.
+	// If error or non-parsable value, returns the default request timeout
+	GetRequestTimeout() time.Duration
+
+	// GetStreamIdleTimeout returns the mesh-wide idle timeout for HTTP streams.
+	// If error or non-parsable value, returns the default stream idle timeout
+	GetStreamIdleTimeout() time.Duration
+
 	// GetClusterDomain returns the cluster domain name (experimental - multicluster)
 	GetClusterDomain() string
 
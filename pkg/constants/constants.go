@@ -43,6 +43,9 @@ const (
 	// EnvoyTracingCluster is the default name to refer to the tracing cluster.
 	EnvoyTracingCluster = "envoy-tracing-cluster"
 
+	// EnvoyGlobalRateLimitCluster is the cluster name of the global rate limit service cluster
+	EnvoyGlobalRateLimitCluster = "envoy-global-rate-limit-cluster"
+
 	// DefaultTracingEndpoint is the default endpoint route.
 	DefaultTracingEndpoint = "/api/v2/spans"
 
@@ -162,6 +165,38 @@ const (
 
 	// MetricsAnnotation is the annotation used for enabling/disabling metrics
 	MetricsAnnotation = "openservicemesh.io/metrics"
+
+	// RequestTimeoutAnnotation is the annotation on an HTTPRouteGroup used to override the request timeout
+	// for its matches, specified as a comma separated list of <match-name>=<duration> pairs
+	RequestTimeoutAnnotation = "openservicemesh.io/request-timeout"
+
+	// IdleTimeoutAnnotation is the annotation on an HTTPRouteGroup used to set the stream idle timeout
+	// for its matches, specified as a comma separated list of <match-name>=<duration> pairs
+	IdleTimeoutAnnotation = "openservicemesh.io/idle-timeout"
+
+	// RewriteAnnotation is the annotation on an HTTPRouteGroup used to rewrite the path and host of the requests
+	// matching its matches, specified as a JSON object mapping match names to rewrites
+	RewriteAnnotation = "openservicemesh.io/rewrite"
+
+	// RedirectAnnotation is the annotation on an HTTPRouteGroup used to redirect the requests matching its matches,
+	// specified as a JSON object mapping match names to redirects
+	RedirectAnnotation = "openservicemesh.io/redirect"
+
+	// QueryParamsAnnotation is the annotation on an HTTPRouteGroup used to match the query parameters of the requests
+	// for its matches, specified as a JSON object mapping match names to lists of query parameter matches
+	QueryParamsAnnotation = "openservicemesh.io/query-params"
+
+	// HTTPUpgradeTypesAnnotation is the annotation on a Service used to enable HTTP upgrades on its ports,
+	// specified as a JSON object mapping port names to lists of upgrade types
+	HTTPUpgradeTypesAnnotation = "openservicemesh.io/http-upgrade-types"
+
+	// ShadowAnnotation is the annotation on an SMI TrafficTarget used to evaluate it in shadow mode, in which the
+	// requests it would deny are reported without being denied, specified as a boolean
+	ShadowAnnotation = "openservicemesh.io/shadow"
+
+	// PermissiveTrafficPolicyModeAnnotation is the annotation on a Namespace used to enable or disable the permissive
+	// traffic policy mode for the workloads in the namespace, overriding the mesh-wide setting, specified as a boolean
+	PermissiveTrafficPolicyModeAnnotation = "openservicemesh.io/permissive-traffic-policy-mode"
 )
 
 // Labels used by the control plane
@@ -209,6 +244,9 @@ const (
 	// gRPC protocol
 	ProtocolGRPC = "grpc"
 
+	// ProtocolWebSocket implies HTTP based protocols with WebSocket upgrades enabled
+	ProtocolWebSocket = "websocket"
+
 	// ProtocolTCPServerFirst implies TCP based server first protocols
 	// Ex. MySQL, SMTP, PostgreSQL etc. where the server initiates the first
 	// byte in a TCP connection.
@@ -3,6 +3,7 @@ package crdconversion
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -10,6 +11,9 @@ import (
 	apiv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm/pkg/certificate"
@@ -26,13 +30,24 @@ const (
 )
 
 var crdConversionWebhookConfiguration = map[string]string{
-	"traffictargets.access.smi-spec.io":              "/trafficaccessconversion",
-	"httproutegroups.specs.smi-spec.io":              "/httproutegroupconversion",
-	"meshconfigs.config.openservicemesh.io":          "/meshconfigconversion",
-	"multiclusterservices.config.openservicemesh.io": "/multiclusterserviceconversion",
-	"egresses.policy.openservicemesh.io":             "/egresspolicyconversion",
-	"trafficsplits.split.smi-spec.io":                "/trafficsplitconversion",
-	"tcproutes.specs.smi-spec.io":                    "/tcproutesconversion",
+	"traffictargets.access.smi-spec.io":                 "/trafficaccessconversion",
+	"httproutegroups.specs.smi-spec.io":                 "/httproutegroupconversion",
+	"meshconfigs.config.openservicemesh.io":             "/meshconfigconversion",
+	"multiclusterservices.config.openservicemesh.io":    "/multiclusterserviceconversion",
+	"egresses.policy.openservicemesh.io":                "/egresspolicyconversion",
+	"retries.policy.openservicemesh.io":                 "/retrypolicyconversion",
+	"upstreamtrafficsettings.policy.openservicemesh.io": "/upstreamtrafficsettingconversion",
+	"ratelimits.policy.openservicemesh.io":              "/ratelimitpolicyconversion",
+	"faultinjections.policy.openservicemesh.io":         "/faultinjectionpolicyconversion",
+	"headermodifiers.policy.openservicemesh.io":         "/headermodifierpolicyconversion",
+	"trafficmirrors.policy.openservicemesh.io":          "/trafficmirrorpolicyconversion",
+	"grpcroutegroups.policy.openservicemesh.io":         "/grpcroutegroupconversion",
+	"corspolicies.policy.openservicemesh.io":            "/corspolicyconversion",
+	"requestauthentications.policy.openservicemesh.io":  "/requestauthenticationconversion",
+	"authorizationpolicies.policy.openservicemesh.io":   "/authorizationpolicyconversion",
+	"denies.policy.openservicemesh.io":                  "/denypolicyconversion",
+	"trafficsplits.split.smi-spec.io":                   trafficSplitConversionPath,
+	"tcproutes.specs.smi-spec.io":                       "/tcproutesconversion",
 }
 
 var conversionReviewVersions = []string{"v1beta1", "v1"}
@@ -78,8 +93,9 @@ func (crdWh *crdConversionWebhook) run(stop <-chan struct{}) {
 	mux := http.NewServeMux()
 
 	mux.HandleFunc(webhookHealthPath, healthHandler)
+	mux.HandleFunc(trafficSplitConversionPath, serveConversion(convertTrafficSplit))
 
-	// TODO (snchh): add handler and logic for conversion stratergy of each CRD in OSM
+	// TODO (snchh): add handler and logic for conversion stratergy of the other CRDs in OSM
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", crdWh.config.ListenPort),
@@ -124,6 +140,72 @@ func healthHandler(w http.ResponseWriter, _ *http.Request) {
 	}
 }
 
+// serveConversion returns an HTTP handler serving the ConversionReview requests with the given conversion function,
+// which converts an object to the desired API version
+func serveConversion(convert func(obj *unstructured.Unstructured, toVersion string) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		defer req.Body.Close() //nolint: errcheck,gosec
+
+		review := &apiv1.ConversionReview{}
+		if err := json.NewDecoder(req.Body).Decode(review); err != nil || review.Request == nil {
+			log.Error().Err(err).Msgf("Error reading conversion request body; Responded to conversion request with HTTP %v", http.StatusBadRequest)
+			http.Error(w, "Invalid ConversionReview request", http.StatusBadRequest)
+			return
+		}
+
+		review.Response = convertObjects(review.Request, convert)
+		review.Request = nil
+
+		data, err := json.Marshal(review)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error marshalling conversion response body; Responded to conversion request with HTTP %v", http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(data); err != nil {
+			log.Error().Err(err).Msg("Error writing conversion response")
+		}
+	}
+}
+
+// convertObjects returns the ConversionResponse for the given ConversionRequest, with its objects converted by the given conversion function
+func convertObjects(request *apiv1.ConversionRequest, convert func(obj *unstructured.Unstructured, toVersion string) error) *apiv1.ConversionResponse {
+	response := &apiv1.ConversionResponse{
+		UID:    request.UID,
+		Result: metav1.Status{Status: metav1.StatusSuccess},
+	}
+
+	for _, object := range request.Objects {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(object.Raw); err != nil {
+			return conversionFailure(request.UID, errors.Wrap(err, "Error unmarshalling object to convert"))
+		}
+		if err := convert(obj, request.DesiredAPIVersion); err != nil {
+			return conversionFailure(request.UID, err)
+		}
+		converted, err := obj.MarshalJSON()
+		if err != nil {
+			return conversionFailure(request.UID, errors.Wrap(err, "Error marshalling converted object"))
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+
+	return response
+}
+
+// conversionFailure returns a failed ConversionResponse for the given error
+func conversionFailure(uid types.UID, err error) *apiv1.ConversionResponse {
+	log.Error().Err(err).Msgf("Error converting objects for conversion request %s", uid)
+	return &apiv1.ConversionResponse{
+		UID: uid,
+		Result: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Message: err.Error(),
+		},
+	}
+}
+
 func patchCrdsWithConversionWehook(cert certificate.Certificater, crdClient apiclient.ApiextensionsV1Interface, osmNamespace string) error {
 	for crdName, crdConversionPath := range crdConversionWebhookConfiguration {
 		if err := updateCrdConversionWebhookConfiguration(cert, crdClient, osmNamespace, crdName, crdConversionPath); err != nil {
@@ -0,0 +1,76 @@
+package crdconversion
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// trafficSplitConversionPath is the HTTP path at which the TrafficSplit conversion requests are served
+	trafficSplitConversionPath = "/trafficsplitconversion"
+
+	// trafficSplitV1alpha2 is the API version of the v1alpha2 TrafficSplit resources
+	trafficSplitV1alpha2 = "split.smi-spec.io/v1alpha2"
+
+	// trafficSplitV1alpha4 is the API version of the v1alpha4 TrafficSplit resources
+	trafficSplitV1alpha4 = "split.smi-spec.io/v1alpha4"
+
+	// trafficSplitMatchesAnnotation is the annotation preserving the matches of a v1alpha4 TrafficSplit converted to v1alpha2,
+	// which does not support matches, so that they are restored when the TrafficSplit is converted back to v1alpha4
+	trafficSplitMatchesAnnotation = "openservicemesh.io/trafficsplit-matches"
+)
+
+// convertTrafficSplit converts the given TrafficSplit resource to the given API version.
+// The v1alpha2 and v1alpha4 TrafficSplit specs only differ by the matches, which are specific to v1alpha4.
+func convertTrafficSplit(obj *unstructured.Unstructured, toVersion string) error {
+	fromVersion := obj.GetAPIVersion()
+	if fromVersion == toVersion {
+		return nil
+	}
+
+	switch {
+	case fromVersion == trafficSplitV1alpha4 && toVersion == trafficSplitV1alpha2:
+		matches, found, err := unstructured.NestedSlice(obj.Object, "spec", "matches")
+		if err != nil {
+			return errors.Wrapf(err, "Error reading the matches of TrafficSplit %s/%s", obj.GetNamespace(), obj.GetName())
+		}
+		if found {
+			marshalledMatches, err := json.Marshal(matches)
+			if err != nil {
+				return errors.Wrapf(err, "Error marshalling the matches of TrafficSplit %s/%s", obj.GetNamespace(), obj.GetName())
+			}
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = make(map[string]string)
+			}
+			annotations[trafficSplitMatchesAnnotation] = string(marshalledMatches)
+			obj.SetAnnotations(annotations)
+			unstructured.RemoveNestedField(obj.Object, "spec", "matches")
+		}
+
+	case fromVersion == trafficSplitV1alpha2 && toVersion == trafficSplitV1alpha4:
+		annotations := obj.GetAnnotations()
+		if marshalledMatches, ok := annotations[trafficSplitMatchesAnnotation]; ok {
+			var matches []interface{}
+			if err := json.Unmarshal([]byte(marshalledMatches), &matches); err != nil {
+				return errors.Wrapf(err, "Error unmarshalling the %s annotation of TrafficSplit %s/%s", trafficSplitMatchesAnnotation, obj.GetNamespace(), obj.GetName())
+			}
+			if err := unstructured.SetNestedSlice(obj.Object, matches, "spec", "matches"); err != nil {
+				return errors.Wrapf(err, "Error setting the matches of TrafficSplit %s/%s", obj.GetNamespace(), obj.GetName())
+			}
+			delete(annotations, trafficSplitMatchesAnnotation)
+			if len(annotations) == 0 {
+				annotations = nil
+			}
+			obj.SetAnnotations(annotations)
+		}
+
+	default:
+		return errors.Errorf("Unsupported conversion of TrafficSplit from %s to %s", fromVersion, toVersion)
+	}
+
+	obj.SetAPIVersion(toVersion)
+	return nil
+}
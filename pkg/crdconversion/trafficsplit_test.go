@@ -0,0 +1,120 @@
+package crdconversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newTrafficSplit(apiVersion string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "TrafficSplit",
+			"metadata": map[string]interface{}{
+				"name":      "bookstore-split",
+				"namespace": "bookstore",
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestConvertTrafficSplit(t *testing.T) {
+	assert := tassert.New(t)
+
+	backends := []interface{}{
+		map[string]interface{}{"service": "bookstore-v1", "weight": int64(90)},
+		map[string]interface{}{"service": "bookstore-v2", "weight": int64(10)},
+	}
+	matches := []interface{}{
+		map[string]interface{}{"kind": "HTTPRouteGroup", "name": "canary-header"},
+	}
+
+	// v1alpha4 -> v1alpha2 preserves the matches in an annotation
+	split := newTrafficSplit(trafficSplitV1alpha4, map[string]interface{}{
+		"service":  "bookstore",
+		"backends": backends,
+		"matches":  matches,
+	})
+	assert.Nil(convertTrafficSplit(split, trafficSplitV1alpha2))
+	assert.Equal(trafficSplitV1alpha2, split.GetAPIVersion())
+	_, found, _ := unstructured.NestedSlice(split.Object, "spec", "matches")
+	assert.False(found)
+	assert.Contains(split.GetAnnotations(), trafficSplitMatchesAnnotation)
+	actualBackends, _, _ := unstructured.NestedSlice(split.Object, "spec", "backends")
+	assert.Equal(backends, actualBackends)
+
+	// v1alpha2 -> v1alpha4 restores the matches
+	assert.Nil(convertTrafficSplit(split, trafficSplitV1alpha4))
+	assert.Equal(trafficSplitV1alpha4, split.GetAPIVersion())
+	actualMatches, found, _ := unstructured.NestedSlice(split.Object, "spec", "matches")
+	assert.True(found)
+	assert.Equal(matches, actualMatches)
+	assert.Nil(split.GetAnnotations())
+
+	// v1alpha2 -> v1alpha4 without matches
+	split = newTrafficSplit(trafficSplitV1alpha2, map[string]interface{}{
+		"service":  "bookstore",
+		"backends": backends,
+	})
+	assert.Nil(convertTrafficSplit(split, trafficSplitV1alpha4))
+	assert.Equal(trafficSplitV1alpha4, split.GetAPIVersion())
+	_, found, _ = unstructured.NestedSlice(split.Object, "spec", "matches")
+	assert.False(found)
+
+	// Unsupported version
+	assert.NotNil(convertTrafficSplit(split, "split.smi-spec.io/v1alpha3"))
+}
+
+func TestServeTrafficSplitConversion(t *testing.T) {
+	assert := tassert.New(t)
+
+	split := newTrafficSplit(trafficSplitV1alpha2, map[string]interface{}{
+		"service": "bookstore",
+		"backends": []interface{}{
+			map[string]interface{}{"service": "bookstore-v1", "weight": int64(100)},
+		},
+	})
+	raw, err := split.MarshalJSON()
+	assert.Nil(err)
+
+	body, err := json.Marshal(&apiv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request: &apiv1.ConversionRequest{
+			UID:               "uid-1",
+			DesiredAPIVersion: trafficSplitV1alpha4,
+			Objects:           []runtime.RawExtension{{Raw: raw}},
+		},
+	})
+	assert.Nil(err)
+
+	w := httptest.NewRecorder()
+	serveConversion(convertTrafficSplit)(w, httptest.NewRequest(http.MethodPost, trafficSplitConversionPath, bytes.NewReader(body)))
+	assert.Equal(http.StatusOK, w.Code)
+
+	review := &apiv1.ConversionReview{}
+	assert.Nil(json.Unmarshal(w.Body.Bytes(), review))
+	assert.Nil(review.Request)
+	assert.Equal("uid-1", string(review.Response.UID))
+	assert.Equal(metav1.StatusSuccess, review.Response.Result.Status)
+	assert.Len(review.Response.ConvertedObjects, 1)
+
+	converted := &unstructured.Unstructured{}
+	assert.Nil(converted.UnmarshalJSON(review.Response.ConvertedObjects[0].Raw))
+	assert.Equal(trafficSplitV1alpha4, converted.GetAPIVersion())
+	assert.Equal("bookstore-split", converted.GetName())
+
+	// Invalid request
+	w = httptest.NewRecorder()
+	serveConversion(convertTrafficSplit)(w, httptest.NewRequest(http.MethodPost, trafficSplitConversionPath, bytes.NewReader([]byte("{}"))))
+	assert.Equal(http.StatusBadRequest, w.Code)
+}
@@ -7,8 +7,9 @@ import (
 
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
-	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/identity"
 )
 
@@ -19,6 +20,10 @@ type policies struct {
 	TrafficTargets  []*access.TrafficTarget      `json:"traffic_targets"`
 }
 
+type upstreamTrafficSettings struct {
+	UpstreamTrafficSettings []*policyv1alpha1.UpstreamTrafficSetting `json:"upstream_traffic_settings"`
+}
+
 func (ds DebugConfig) getOSMConfigHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		confJSON, err := ds.configurator.GetMeshConfigJSON()
@@ -43,3 +48,17 @@ func (ds DebugConfig) getSMIPoliciesHandler() http.Handler {
 		_, _ = fmt.Fprint(w, string(jsonPolicies))
 	})
 }
+
+func (ds DebugConfig) getUpstreamTrafficSettingsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var u upstreamTrafficSettings
+		u.UpstreamTrafficSettings = ds.meshCatalogDebugger.ListUpstreamTrafficSettings()
+
+		jsonSettings, err := json.Marshal(u)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error marshalling UpstreamTrafficSetting policies %+v", u)
+		}
+
+		_, _ = fmt.Fprint(w, string(jsonSettings))
+	})
+}
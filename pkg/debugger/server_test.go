@@ -43,6 +43,8 @@ func TestGetHandlers(t *testing.T) {
 		"/debug/policies",
 		"/debug/config",
 		"/debug/namespaces",
+		"/debug/upstream-traffic-settings",
+		"/debug/shadow-denials",
 		// Pprof handlers
 		"/debug/pprof/",
 		"/debug/pprof/cmdline",
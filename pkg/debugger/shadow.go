@@ -0,0 +1,112 @@
+package debugger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/envoy"
+)
+
+const (
+	// shadowDeniedStatsURL is the Envoy admin URL listing the stats of the requests and connections denied by the
+	// RBAC shadow rules, ex. network-rbac.shadow_denied and http.<stat-prefix>.rbac.shadow_denied
+	shadowDeniedStatsURL = "stats?filter=shadow_denied$"
+)
+
+// shadowDenials is the report of the requests and connections that would be denied by the policies in shadow mode
+type shadowDenials struct {
+	Total   uint64               `json:"total"`
+	Proxies []proxyShadowDenials `json:"proxies"`
+}
+
+// proxyShadowDenials is the report of the requests and connections that would be denied by the policies in shadow mode
+// on a proxy, per stat
+type proxyShadowDenials struct {
+	CommonName certificate.CommonName `json:"common_name"`
+	Pod        string                 `json:"pod"`
+	Total      uint64                 `json:"total"`
+	Stats      map[string]uint64      `json:"stats"`
+}
+
+func (ds DebugConfig) getShadowDenialsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var proxiesDenials []proxyShadowDenials
+		for cn := range ds.proxyRegistry.ListConnectedProxies() {
+			pod, err := envoy.GetPodFromCertificate(cn, ds.kubeController)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error getting Pod from certificate with CN=%s", cn)
+				continue
+			}
+
+			stats := parseShadowDeniedStats(ds.getEnvoyConfig(pod, shadowDeniedStatsURL))
+			proxiesDenials = append(proxiesDenials, proxyShadowDenials{
+				CommonName: cn,
+				Pod:        fmt.Sprintf("%s/%s", pod.Namespace, pod.Name),
+				Stats:      stats,
+			})
+		}
+
+		report := aggregateShadowDenials(proxiesDenials)
+		jsonReport, err := json.Marshal(report)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error marshalling shadow denials report %+v", report)
+		}
+
+		_, _ = fmt.Fprint(w, string(jsonReport))
+	})
+}
+
+// parseShadowDeniedStats parses the shadow denied counters from the given Envoy admin stats output,
+// formatted as one '<stat-name>: <value>' pair per line
+func parseShadowDeniedStats(stats string) map[string]uint64 {
+	counters := make(map[string]uint64)
+	for _, line := range strings.Split(stats, "\n") {
+		chunks := strings.SplitN(line, ":", 2)
+		if len(chunks) != 2 || !strings.HasSuffix(chunks[0], "shadow_denied") {
+			continue
+		}
+
+		value, err := strconv.ParseUint(strings.TrimSpace(chunks[1]), 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error parsing the value of stat %s", chunks[0])
+			continue
+		}
+		counters[strings.TrimSpace(chunks[0])] = value
+	}
+	return counters
+}
+
+// aggregateShadowDenials returns the shadow denials report for the given proxies, including only the proxies
+// with shadow denials, ordered by decreasing number of shadow denials
+func aggregateShadowDenials(proxiesDenials []proxyShadowDenials) shadowDenials {
+	report := shadowDenials{
+		Proxies: []proxyShadowDenials{},
+	}
+
+	for _, proxyDenials := range proxiesDenials {
+		proxyDenials.Total = 0
+		for _, value := range proxyDenials.Stats {
+			proxyDenials.Total += value
+		}
+		if proxyDenials.Total == 0 {
+			continue
+		}
+
+		report.Total += proxyDenials.Total
+		report.Proxies = append(report.Proxies, proxyDenials)
+	}
+
+	sort.SliceStable(report.Proxies, func(i, j int) bool {
+		if report.Proxies[i].Total != report.Proxies[j].Total {
+			return report.Proxies[i].Total > report.Proxies[j].Total
+		}
+		return report.Proxies[i].CommonName < report.Proxies[j].CommonName
+	})
+
+	return report
+}
@@ -0,0 +1,60 @@
+package debugger
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestParseShadowDeniedStats(t *testing.T) {
+	assert := tassert.New(t)
+
+	stats := `network-rbac.shadow_denied: 3
+http.mesh-http-conn-manager.rds-inbound.rbac.shadow_denied: 5
+network-rbac.shadow_allowed: 10
+invalid.shadow_denied: not-a-number
+Error: connection refused`
+
+	assert.Equal(map[string]uint64{
+		"network-rbac.shadow_denied":                                 3,
+		"http.mesh-http-conn-manager.rds-inbound.rbac.shadow_denied": 5,
+	}, parseShadowDeniedStats(stats))
+
+	assert.Empty(parseShadowDeniedStats(""))
+}
+
+func TestAggregateShadowDenials(t *testing.T) {
+	assert := tassert.New(t)
+
+	report := aggregateShadowDenials([]proxyShadowDenials{
+		{
+			CommonName: "a.bookbuyer.default",
+			Pod:        "default/bookbuyer",
+			Stats:      map[string]uint64{"network-rbac.shadow_denied": 1},
+		},
+		{
+			CommonName: "b.bookstore.default",
+			Pod:        "default/bookstore",
+			Stats: map[string]uint64{
+				"network-rbac.shadow_denied":                                 2,
+				"http.mesh-http-conn-manager.rds-inbound.rbac.shadow_denied": 3,
+			},
+		},
+		{
+			CommonName: "c.bookwarehouse.default",
+			Pod:        "default/bookwarehouse",
+			Stats:      map[string]uint64{"network-rbac.shadow_denied": 0},
+		},
+	})
+
+	assert.Equal(uint64(6), report.Total)
+
+	// Proxies without shadow denials are omitted, the others are ordered by decreasing number of shadow denials
+	assert.Len(report.Proxies, 2)
+	assert.Equal("default/bookstore", report.Proxies[0].Pod)
+	assert.Equal(uint64(5), report.Proxies[0].Total)
+	assert.Equal("default/bookbuyer", report.Proxies[1].Pod)
+	assert.Equal(uint64(1), report.Proxies[1].Total)
+
+	assert.Equal(shadowDenials{Proxies: []proxyShadowDenials{}}, aggregateShadowDenials(nil))
+}
@@ -6,10 +6,11 @@ import (
 
 	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	spec "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
-	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/envoy"
@@ -46,6 +47,9 @@ type MeshCatalogDebugger interface {
 
 	// ListMonitoredNamespaces lists the namespaces that the control plan knows about.
 	ListMonitoredNamespaces() []string
+
+	// ListUpstreamTrafficSettings lists the UpstreamTrafficSetting policies detected by OSM.
+	ListUpstreamTrafficSettings() []*policyv1alpha1.UpstreamTrafficSetting
 }
 
 // XDSDebugger is an interface providing debugging server with methods introspecting XDS.
@@ -32,6 +32,12 @@ type Provider interface {
 type Endpoint struct {
 	net.IP `json:"ip"`
 	Port   `json:"port"`
+
+	// Region is the region of the node the endpoint is scheduled on, if known
+	Region string `json:"region,omitempty"`
+
+	// Zone is the zone of the node the endpoint is scheduled on, if known
+	Zone string `json:"zone,omitempty"`
 }
 
 func (ep Endpoint) String() string {
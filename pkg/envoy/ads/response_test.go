@@ -30,6 +30,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/envoy/registry"
 	"github.com/openservicemesh/osm/pkg/envoy/secrets"
 	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/tests"
 )
@@ -137,6 +138,9 @@ var _ = Describe("Test ADS response functions", func() {
 
 		mockConfigurator.EXPECT().IsEgressEnabled().Return(false).AnyTimes()
 		mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+		mockConfigurator.EXPECT().GetInboundGlobalRateLimitConfig().Return(ratelimit.GlobalRateLimitConfig{}).AnyTimes()
+		mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
+		mockConfigurator.EXPECT().GetRequestTimeout().Return(15 * time.Second).AnyTimes()
 		mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
 		mockConfigurator.EXPECT().GetServiceCertValidityPeriod().Return(certDuration).AnyTimes()
 		mockConfigurator.EXPECT().IsDebugServerEnabled().Return(true).AnyTimes()
@@ -221,6 +225,9 @@ var _ = Describe("Test ADS response functions", func() {
 
 		mockConfigurator.EXPECT().IsEgressEnabled().Return(false).AnyTimes()
 		mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+		mockConfigurator.EXPECT().GetInboundGlobalRateLimitConfig().Return(ratelimit.GlobalRateLimitConfig{}).AnyTimes()
+		mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
+		mockConfigurator.EXPECT().GetRequestTimeout().Return(15 * time.Second).AnyTimes()
 		mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
 		mockConfigurator.EXPECT().GetServiceCertValidityPeriod().Return(certDuration).AnyTimes()
 		mockConfigurator.EXPECT().IsDebugServerEnabled().Return(true).AnyTimes()
@@ -15,6 +15,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/envoy"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/k8s/events"
 	"github.com/openservicemesh/osm/pkg/metricsstore"
 	"github.com/openservicemesh/osm/pkg/utils"
@@ -345,6 +346,10 @@ func (s *Server) recordPodMetadata(p *envoy.Proxy) error {
 		WorkloadName: workloadName,
 	}
 
+	if pod.Spec.NodeName != "" {
+		p.PodMetadata.Region, p.PodMetadata.Zone = k8s.GetTopologyFromNode(s.kubecontroller.GetNode(pod.Spec.NodeName))
+	}
+
 	// Verify Service account matches (cert to pod Service Account)
 	cn := p.GetCertificateCommonName()
 	certSA, err := envoy.GetServiceIdentityFromProxyCertificate(cn)
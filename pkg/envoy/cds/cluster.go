@@ -1,6 +1,7 @@
 package cds
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
@@ -25,14 +27,33 @@ import (
 const (
 	// clusterConnectTimeout is the timeout duration used by Envoy to timeout connections to the cluster
 	clusterConnectTimeout = 1 * time.Second
+
+	// defaultHealthCheckInterval is the default time interval between active health checks
+	defaultHealthCheckInterval = 10 * time.Second
+
+	// defaultHealthCheckTimeout is the default time to wait for an active health check response
+	defaultHealthCheckTimeout = 1 * time.Second
+
+	// defaultHealthCheckHealthyThreshold is the default number of successful health checks before a host is marked healthy
+	defaultHealthCheckHealthyThreshold = 2
+
+	// defaultHealthCheckUnhealthyThreshold is the default number of failed health checks before a host is marked unhealthy
+	defaultHealthCheckUnhealthyThreshold = 3
+
+	// defaultHealthCheckPath is the default HTTP path requested during HTTP health checks
+	defaultHealthCheckPath = "/"
+
+	// outlierDetectionEnforcingPercentage is the percentage of hosts detected as outliers that are ejected
+	outlierDetectionEnforcingPercentage = 100
 )
 
 // replacer used to configure an Envoy cluster's altStatName
 var replacer = strings.NewReplacer(".", "_", ":", "_")
 
 type clusterOptions struct {
-	permissive bool
-	withTLS    bool
+	permissive             bool
+	withTLS                bool
+	upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSettingSpec
 }
 
 // clusterOption is type of function that edits the defaults of the options struct.
@@ -48,6 +69,13 @@ func permissive(o *clusterOptions) {
 	o.permissive = true
 }
 
+// withUpstreamTrafficSetting is an option to apply the given UpstreamTrafficSetting to the cluster.
+func withUpstreamTrafficSetting(upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSettingSpec) clusterOption {
+	return func(o *clusterOptions) {
+		o.upstreamTrafficSetting = upstreamTrafficSetting
+	}
+}
+
 // getUpstreamServiceCluster returns an Envoy Cluster corresponding to the given upstream service
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 // The defaults are non-permissive, and *no tls*.
@@ -93,6 +121,23 @@ func getUpstreamServiceCluster(downstreamIdentity identity.ServiceIdentity, upst
 		remoteCluster.ClusterDiscoveryType = &xds_cluster.Cluster_Type{Type: xds_cluster.Cluster_EDS}
 		remoteCluster.EdsClusterConfig = &xds_cluster.Cluster_EdsClusterConfig{EdsConfig: envoy.GetADSConfigSource()}
 		remoteCluster.LbPolicy = xds_cluster.Cluster_ROUND_ROBIN
+
+		// Active health checks and load balancing policies require the hosts of the cluster to be known upfront,
+		// so they are only applicable to EDS based clusters.
+		if o.upstreamTrafficSetting != nil {
+			remoteCluster.LbPolicy = getLbPolicy(o.upstreamTrafficSetting.LoadBalancer)
+			if leastRequestLbConfig := getLeastRequestLbConfig(o.upstreamTrafficSetting.LoadBalancer); leastRequestLbConfig != nil {
+				remoteCluster.LbConfig = &xds_cluster.Cluster_LeastRequestLbConfig_{LeastRequestLbConfig: leastRequestLbConfig}
+			}
+			if healthCheck := getHealthCheck(o.upstreamTrafficSetting.HealthCheck, upstreamSvc); healthCheck != nil {
+				remoteCluster.HealthChecks = []*xds_core.HealthCheck{healthCheck}
+			}
+		}
+	}
+
+	if o.upstreamTrafficSetting != nil {
+		remoteCluster.CircuitBreakers = getCircuitBreakers(o.upstreamTrafficSetting.ConnectionSettings)
+		remoteCluster.OutlierDetection = getOutlierDetection(o.upstreamTrafficSetting.OutlierDetection)
 	}
 
 	return remoteCluster, nil
@@ -152,9 +197,203 @@ func getLocalServiceCluster(catalog catalog.MeshCataloger, proxyServiceName serv
 		xdsCluster.LoadAssignment.Endpoints = append(xdsCluster.LoadAssignment.Endpoints, localityEndpoint)
 	}
 
+	if upstreamTrafficSetting := catalog.GetUpstreamTrafficSetting(proxyServiceName); upstreamTrafficSetting != nil {
+		xdsCluster.CircuitBreakers = getCircuitBreakers(upstreamTrafficSetting.ConnectionSettings)
+	}
+
 	return &xdsCluster, nil
 }
 
+// getCircuitBreakers returns the circuit breaking thresholds for a cluster corresponding to the given connection settings.
+// Limits that are not specified in the connection settings retain Envoy's defaults.
+func getCircuitBreakers(connectionSettings *policyv1alpha1.ConnectionSettingsSpec) *xds_cluster.CircuitBreakers {
+	if connectionSettings == nil {
+		return nil
+	}
+
+	threshold := &xds_cluster.CircuitBreakers_Thresholds{}
+
+	if tcp := connectionSettings.TCP; tcp != nil {
+		if tcp.MaxConnections != nil {
+			threshold.MaxConnections = &wrappers.UInt32Value{Value: *tcp.MaxConnections}
+		}
+	}
+
+	if http := connectionSettings.HTTP; http != nil {
+		if http.MaxPendingRequests != nil {
+			threshold.MaxPendingRequests = &wrappers.UInt32Value{Value: *http.MaxPendingRequests}
+		}
+		if http.MaxRequests != nil {
+			threshold.MaxRequests = &wrappers.UInt32Value{Value: *http.MaxRequests}
+		}
+		if http.MaxRetries != nil {
+			threshold.MaxRetries = &wrappers.UInt32Value{Value: *http.MaxRetries}
+		}
+	}
+
+	return &xds_cluster.CircuitBreakers{
+		Thresholds: []*xds_cluster.CircuitBreakers_Thresholds{threshold},
+	}
+}
+
+// getOutlierDetection returns the outlier detection config for a cluster corresponding to the given outlier detection spec.
+// Envoy enforces consecutive 5xx and success rate based ejections by default, so the detection types that are not
+// specified in the spec are explicitly disabled.
+func getOutlierDetection(outlierDetection *policyv1alpha1.OutlierDetectionSpec) *xds_cluster.OutlierDetection {
+	if outlierDetection == nil {
+		return nil
+	}
+
+	config := &xds_cluster.OutlierDetection{
+		EnforcingConsecutive_5Xx: &wrappers.UInt32Value{Value: 0},
+		EnforcingSuccessRate:     &wrappers.UInt32Value{Value: 0},
+	}
+
+	if outlierDetection.Consecutive5xxErrors != nil {
+		config.Consecutive_5Xx = &wrappers.UInt32Value{Value: *outlierDetection.Consecutive5xxErrors}
+		config.EnforcingConsecutive_5Xx = &wrappers.UInt32Value{Value: outlierDetectionEnforcingPercentage}
+	}
+
+	if outlierDetection.ConsecutiveGatewayErrors != nil {
+		config.ConsecutiveGatewayFailure = &wrappers.UInt32Value{Value: *outlierDetection.ConsecutiveGatewayErrors}
+		config.EnforcingConsecutiveGatewayFailure = &wrappers.UInt32Value{Value: outlierDetectionEnforcingPercentage}
+	}
+
+	if successRate := outlierDetection.SuccessRate; successRate != nil {
+		config.EnforcingSuccessRate = &wrappers.UInt32Value{Value: outlierDetectionEnforcingPercentage}
+		if successRate.MinimumHosts != nil {
+			config.SuccessRateMinimumHosts = &wrappers.UInt32Value{Value: *successRate.MinimumHosts}
+		}
+		if successRate.RequestVolume != nil {
+			config.SuccessRateRequestVolume = &wrappers.UInt32Value{Value: *successRate.RequestVolume}
+		}
+		if successRate.StdevFactor != nil {
+			config.SuccessRateStdevFactor = &wrappers.UInt32Value{Value: *successRate.StdevFactor}
+		}
+	}
+
+	if outlierDetection.Interval != nil {
+		config.Interval = ptypes.DurationProto(outlierDetection.Interval.Duration)
+	}
+	if outlierDetection.BaseEjectionTime != nil {
+		config.BaseEjectionTime = ptypes.DurationProto(outlierDetection.BaseEjectionTime.Duration)
+	}
+	if outlierDetection.MaxEjectionPercent != nil {
+		config.MaxEjectionPercent = &wrappers.UInt32Value{Value: *outlierDetection.MaxEjectionPercent}
+	}
+
+	return config
+}
+
+// getHealthCheck returns the active health check config for the cluster of the given upstream service corresponding to the
+// given health check spec. The HTTP and gRPC health checks are sent with the FQDN of the upstream service as their host,
+// as the cluster name used by default is not a host the upstream service is known by.
+// If the health check type is not supported, an error is logged and nil is returned.
+func getHealthCheck(healthCheck *policyv1alpha1.HealthCheckSpec, upstreamSvc service.MeshService) *xds_core.HealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+
+	config := &xds_core.HealthCheck{
+		Interval:           ptypes.DurationProto(defaultHealthCheckInterval),
+		Timeout:            ptypes.DurationProto(defaultHealthCheckTimeout),
+		HealthyThreshold:   &wrappers.UInt32Value{Value: defaultHealthCheckHealthyThreshold},
+		UnhealthyThreshold: &wrappers.UInt32Value{Value: defaultHealthCheckUnhealthyThreshold},
+	}
+
+	host := fmt.Sprintf("%s.%s.svc.cluster.local", upstreamSvc.Name, upstreamSvc.Namespace)
+
+	switch healthCheck.Type {
+	case policyv1alpha1.HTTPHealthCheck:
+		path := healthCheck.Path
+		if path == "" {
+			path = defaultHealthCheckPath
+		}
+		config.HealthChecker = &xds_core.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: &xds_core.HealthCheck_HttpHealthCheck{
+				Host: host,
+				Path: path,
+			},
+		}
+
+	case policyv1alpha1.GRPCHealthCheck:
+		config.HealthChecker = &xds_core.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &xds_core.HealthCheck_GrpcHealthCheck{
+				ServiceName: healthCheck.GRPCServiceName,
+				Authority:   host,
+			},
+		}
+
+	case policyv1alpha1.TCPHealthCheck:
+		config.HealthChecker = &xds_core.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &xds_core.HealthCheck_TcpHealthCheck{},
+		}
+
+	default:
+		log.Error().Msgf("Unsupported health check type %s, skipping active health checks", healthCheck.Type)
+		return nil
+	}
+
+	if healthCheck.Interval != nil {
+		config.Interval = ptypes.DurationProto(healthCheck.Interval.Duration)
+	}
+	if healthCheck.Timeout != nil {
+		config.Timeout = ptypes.DurationProto(healthCheck.Timeout.Duration)
+	}
+	if healthCheck.HealthyThreshold != nil {
+		config.HealthyThreshold = &wrappers.UInt32Value{Value: *healthCheck.HealthyThreshold}
+	}
+	if healthCheck.UnhealthyThreshold != nil {
+		config.UnhealthyThreshold = &wrappers.UInt32Value{Value: *healthCheck.UnhealthyThreshold}
+	}
+
+	return config
+}
+
+// getLbPolicy returns the load balancing policy for a cluster corresponding to the given load balancer spec.
+// If the load balancer spec is not specified or its type is not supported, the round robin policy is returned.
+func getLbPolicy(loadBalancer *policyv1alpha1.LoadBalancerSpec) xds_cluster.Cluster_LbPolicy {
+	if loadBalancer == nil {
+		return xds_cluster.Cluster_ROUND_ROBIN
+	}
+
+	switch loadBalancer.Type {
+	case policyv1alpha1.RoundRobinLoadBalancer:
+		return xds_cluster.Cluster_ROUND_ROBIN
+
+	case policyv1alpha1.LeastRequestLoadBalancer:
+		return xds_cluster.Cluster_LEAST_REQUEST
+
+	case policyv1alpha1.RandomLoadBalancer:
+		return xds_cluster.Cluster_RANDOM
+
+	case policyv1alpha1.RingHashLoadBalancer:
+		return xds_cluster.Cluster_RING_HASH
+
+	case policyv1alpha1.MaglevLoadBalancer:
+		return xds_cluster.Cluster_MAGLEV
+
+	default:
+		log.Error().Msgf("Unsupported load balancer type %s, using round robin load balancing", loadBalancer.Type)
+		return xds_cluster.Cluster_ROUND_ROBIN
+	}
+}
+
+// getLeastRequestLbConfig returns the least request load balancing config for a cluster corresponding to the given
+// load balancer spec, or nil if the LeastRequest load balancer is not used or its choice count is not specified.
+func getLeastRequestLbConfig(loadBalancer *policyv1alpha1.LoadBalancerSpec) *xds_cluster.Cluster_LeastRequestLbConfig {
+	if loadBalancer == nil || loadBalancer.Type != policyv1alpha1.LeastRequestLoadBalancer {
+		return nil
+	}
+	if loadBalancer.LeastRequest == nil || loadBalancer.LeastRequest.ChoiceCount == nil {
+		return nil
+	}
+
+	return &xds_cluster.Cluster_LeastRequestLbConfig{
+		ChoiceCount: &wrappers.UInt32Value{Value: *loadBalancer.LeastRequest.ChoiceCount},
+	}
+}
+
 // getPrometheusCluster returns an Envoy Cluster responsible for scraping metrics by Prometheus
 func getPrometheusCluster() *xds_cluster.Cluster {
 	return &xds_cluster.Cluster{
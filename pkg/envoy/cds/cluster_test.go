@@ -12,7 +12,9 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
@@ -28,12 +30,16 @@ func TestGetUpstreamServiceCluster(t *testing.T) {
 
 	downstreamSvcAccount := tests.BookbuyerServiceIdentity
 	upstreamSvc := tests.BookstoreV1Service
+	var maxRequests uint32 = 50
 
 	testCases := []struct {
-		name                string
-		permissiveMode      bool
-		expectedClusterType xds_cluster.Cluster_DiscoveryType
-		expectedLbPolicy    xds_cluster.Cluster_LbPolicy
+		name                    string
+		permissiveMode          bool
+		upstreamTrafficSetting  *policyv1alpha1.UpstreamTrafficSettingSpec
+		expectedClusterType     xds_cluster.Cluster_DiscoveryType
+		expectedLbPolicy        xds_cluster.Cluster_LbPolicy
+		expectedCircuitBreakers *xds_cluster.CircuitBreakers
+		expectedHealthChecks    int
 	}{
 		{
 			name:                "Returns an EDS based cluster when permissive mode is disabled",
@@ -47,13 +53,71 @@ func TestGetUpstreamServiceCluster(t *testing.T) {
 			expectedClusterType: xds_cluster.Cluster_ORIGINAL_DST,
 			expectedLbPolicy:    xds_cluster.Cluster_CLUSTER_PROVIDED,
 		},
+		{
+			name:           "Returns an EDS based cluster with circuit breakers when an UpstreamTrafficSetting is specified",
+			permissiveMode: false,
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				ConnectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+					HTTP: &policyv1alpha1.HTTPConnectionSettings{
+						MaxRequests: &maxRequests,
+					},
+				},
+			},
+			expectedClusterType: xds_cluster.Cluster_EDS,
+			expectedLbPolicy:    xds_cluster.Cluster_ROUND_ROBIN,
+			expectedCircuitBreakers: &xds_cluster.CircuitBreakers{
+				Thresholds: []*xds_cluster.CircuitBreakers_Thresholds{
+					{
+						MaxRequests: &wrappers.UInt32Value{Value: maxRequests},
+					},
+				},
+			},
+		},
+		{
+			name:           "Returns an EDS based cluster with active health checks when an UpstreamTrafficSetting is specified",
+			permissiveMode: false,
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				HealthCheck: &policyv1alpha1.HealthCheckSpec{Type: policyv1alpha1.TCPHealthCheck},
+			},
+			expectedClusterType:  xds_cluster.Cluster_EDS,
+			expectedLbPolicy:     xds_cluster.Cluster_ROUND_ROBIN,
+			expectedHealthChecks: 1,
+		},
+		{
+			name:           "Returns an Original Destination based cluster without active health checks when permissive mode is enabled",
+			permissiveMode: true,
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				HealthCheck: &policyv1alpha1.HealthCheckSpec{Type: policyv1alpha1.TCPHealthCheck},
+			},
+			expectedClusterType:  xds_cluster.Cluster_ORIGINAL_DST,
+			expectedLbPolicy:     xds_cluster.Cluster_CLUSTER_PROVIDED,
+			expectedHealthChecks: 0,
+		},
+		{
+			name:           "Returns an EDS based cluster with ring hash load balancing when an UpstreamTrafficSetting is specified",
+			permissiveMode: false,
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				LoadBalancer: &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RingHashLoadBalancer},
+			},
+			expectedClusterType: xds_cluster.Cluster_EDS,
+			expectedLbPolicy:    xds_cluster.Cluster_RING_HASH,
+		},
+		{
+			name:           "Returns an Original Destination based cluster without ring hash load balancing when permissive mode is enabled",
+			permissiveMode: true,
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				LoadBalancer: &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RingHashLoadBalancer},
+			},
+			expectedClusterType: xds_cluster.Cluster_ORIGINAL_DST,
+			expectedLbPolicy:    xds_cluster.Cluster_CLUSTER_PROVIDED,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := tassert.New(t)
 
-			opts := []clusterOption{withTLS}
+			opts := []clusterOption{withTLS, withUpstreamTrafficSetting(tc.upstreamTrafficSetting)}
 			if tc.permissiveMode {
 				opts = append(opts, permissive)
 			}
@@ -61,6 +125,8 @@ func TestGetUpstreamServiceCluster(t *testing.T) {
 			assert.Nil(err)
 			assert.Equal(tc.expectedClusterType, remoteCluster.GetType())
 			assert.Equal(tc.expectedLbPolicy, remoteCluster.LbPolicy)
+			assert.Equal(tc.expectedCircuitBreakers, remoteCluster.CircuitBreakers)
+			assert.Len(remoteCluster.HealthChecks, tc.expectedHealthChecks)
 		})
 	}
 }
@@ -76,6 +142,8 @@ func TestGetLocalServiceCluster(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockCatalog := catalog.NewMockMeshCataloger(mockCtrl)
 
+	var maxConnections uint32 = 100
+
 	testCases := []struct {
 		name                             string
 		proxyService                     service.MeshService
@@ -83,6 +151,8 @@ func TestGetLocalServiceCluster(t *testing.T) {
 		expectedLocalityLbEndpoints      []*xds_endpoint.LocalityLbEndpoints
 		expectedLbPolicy                 xds_cluster.Cluster_LbPolicy
 		expectedProtocolSelection        xds_cluster.Cluster_ClusterProtocolSelection
+		upstreamTrafficSetting           *policyv1alpha1.UpstreamTrafficSettingSpec
+		expectedCircuitBreakers          *xds_cluster.CircuitBreakers
 		expectedPortToProtocolMappingErr bool
 		expectedErr                      bool
 	}{
@@ -110,6 +180,44 @@ func TestGetLocalServiceCluster(t *testing.T) {
 			expectedPortToProtocolMappingErr: false,
 			expectedErr:                      false,
 		},
+		{
+			name:                  "when an UpstreamTrafficSetting applies to the service",
+			proxyService:          proxyService,
+			portToProtocolMapping: map[uint32]string{uint32(8080): "something"},
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				ConnectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+					TCP: &policyv1alpha1.TCPConnectionSettings{
+						MaxConnections: &maxConnections,
+					},
+				},
+			},
+			expectedLocalityLbEndpoints: []*xds_endpoint.LocalityLbEndpoints{
+				{
+					Locality: &xds_core.Locality{
+						Zone: "zone",
+					},
+					LbEndpoints: []*xds_endpoint.LbEndpoint{{
+						HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+							Endpoint: &xds_endpoint.Endpoint{
+								Address: envoy.GetAddress(constants.WildcardIPAddr, uint32(8080)),
+							},
+						},
+						LoadBalancingWeight: &wrappers.UInt32Value{
+							Value: constants.ClusterWeightAcceptAll, // Local cluster accepts all traffic
+						},
+					}},
+				},
+			},
+			expectedCircuitBreakers: &xds_cluster.CircuitBreakers{
+				Thresholds: []*xds_cluster.CircuitBreakers_Thresholds{
+					{
+						MaxConnections: &wrappers.UInt32Value{Value: maxConnections},
+					},
+				},
+			},
+			expectedPortToProtocolMappingErr: false,
+			expectedErr:                      false,
+		},
 		{
 			name:                             "when err fetching ports",
 			proxyService:                     proxyService,
@@ -128,6 +236,7 @@ func TestGetLocalServiceCluster(t *testing.T) {
 				mockCatalog.EXPECT().GetTargetPortToProtocolMappingForService(tc.proxyService).Return(tc.portToProtocolMapping, errors.New("error")).Times(1)
 			} else {
 				mockCatalog.EXPECT().GetTargetPortToProtocolMappingForService(tc.proxyService).Return(tc.portToProtocolMapping, nil).Times(1)
+				mockCatalog.EXPECT().GetUpstreamTrafficSetting(tc.proxyService).Return(tc.upstreamTrafficSetting).Times(1)
 			}
 
 			cluster, err := getLocalServiceCluster(mockCatalog, tc.proxyService, clusterName)
@@ -146,11 +255,346 @@ func TestGetLocalServiceCluster(t *testing.T) {
 				assert.Equal(xds_cluster.Cluster_V4_ONLY, cluster.DnsLookupFamily)
 				assert.Equal(len(tc.expectedLocalityLbEndpoints), len(cluster.LoadAssignment.Endpoints))
 				assert.ElementsMatch(tc.expectedLocalityLbEndpoints, cluster.LoadAssignment.Endpoints)
+				assert.Equal(tc.expectedCircuitBreakers, cluster.CircuitBreakers)
 			}
 		})
 	}
 }
 
+func TestGetCircuitBreakers(t *testing.T) {
+	var maxConnections uint32 = 100
+	var maxPendingRequests uint32 = 10
+	var maxRequests uint32 = 50
+	var maxRetries uint32 = 3
+
+	testCases := []struct {
+		name                    string
+		connectionSettings      *policyv1alpha1.ConnectionSettingsSpec
+		expectedCircuitBreakers *xds_cluster.CircuitBreakers
+	}{
+		{
+			name:                    "no connection settings",
+			connectionSettings:      nil,
+			expectedCircuitBreakers: nil,
+		},
+		{
+			name:               "empty connection settings",
+			connectionSettings: &policyv1alpha1.ConnectionSettingsSpec{},
+			expectedCircuitBreakers: &xds_cluster.CircuitBreakers{
+				Thresholds: []*xds_cluster.CircuitBreakers_Thresholds{{}},
+			},
+		},
+		{
+			name: "TCP and HTTP connection settings",
+			connectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+				TCP: &policyv1alpha1.TCPConnectionSettings{
+					MaxConnections: &maxConnections,
+				},
+				HTTP: &policyv1alpha1.HTTPConnectionSettings{
+					MaxPendingRequests: &maxPendingRequests,
+					MaxRequests:        &maxRequests,
+					MaxRetries:         &maxRetries,
+				},
+			},
+			expectedCircuitBreakers: &xds_cluster.CircuitBreakers{
+				Thresholds: []*xds_cluster.CircuitBreakers_Thresholds{
+					{
+						MaxConnections:     &wrappers.UInt32Value{Value: maxConnections},
+						MaxPendingRequests: &wrappers.UInt32Value{Value: maxPendingRequests},
+						MaxRequests:        &wrappers.UInt32Value{Value: maxRequests},
+						MaxRetries:         &wrappers.UInt32Value{Value: maxRetries},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getCircuitBreakers(tc.connectionSettings)
+			assert.Equal(tc.expectedCircuitBreakers, actual)
+		})
+	}
+}
+
+func TestGetOutlierDetection(t *testing.T) {
+	var consecutive5xxErrors uint32 = 5
+	var consecutiveGatewayErrors uint32 = 3
+	var minimumHosts uint32 = 2
+	var stdevFactor uint32 = 1900
+	var maxEjectionPercent uint32 = 50
+
+	testCases := []struct {
+		name                     string
+		outlierDetection         *policyv1alpha1.OutlierDetectionSpec
+		expectedOutlierDetection *xds_cluster.OutlierDetection
+	}{
+		{
+			name:                     "no outlier detection",
+			outlierDetection:         nil,
+			expectedOutlierDetection: nil,
+		},
+		{
+			name:             "empty outlier detection disables the default detection types",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{},
+			expectedOutlierDetection: &xds_cluster.OutlierDetection{
+				EnforcingConsecutive_5Xx: &wrappers.UInt32Value{Value: 0},
+				EnforcingSuccessRate:     &wrappers.UInt32Value{Value: 0},
+			},
+		},
+		{
+			name: "consecutive errors based outlier detection",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{
+				Consecutive5xxErrors:     &consecutive5xxErrors,
+				ConsecutiveGatewayErrors: &consecutiveGatewayErrors,
+				Interval:                 &metav1.Duration{Duration: 10 * time.Second},
+				BaseEjectionTime:         &metav1.Duration{Duration: 30 * time.Second},
+				MaxEjectionPercent:       &maxEjectionPercent,
+			},
+			expectedOutlierDetection: &xds_cluster.OutlierDetection{
+				Consecutive_5Xx:                    &wrappers.UInt32Value{Value: consecutive5xxErrors},
+				EnforcingConsecutive_5Xx:           &wrappers.UInt32Value{Value: 100},
+				ConsecutiveGatewayFailure:          &wrappers.UInt32Value{Value: consecutiveGatewayErrors},
+				EnforcingConsecutiveGatewayFailure: &wrappers.UInt32Value{Value: 100},
+				EnforcingSuccessRate:               &wrappers.UInt32Value{Value: 0},
+				Interval:                           ptypes.DurationProto(10 * time.Second),
+				BaseEjectionTime:                   ptypes.DurationProto(30 * time.Second),
+				MaxEjectionPercent:                 &wrappers.UInt32Value{Value: maxEjectionPercent},
+			},
+		},
+		{
+			name: "success rate based outlier detection",
+			outlierDetection: &policyv1alpha1.OutlierDetectionSpec{
+				SuccessRate: &policyv1alpha1.SuccessRateOutlierDetectionSpec{
+					MinimumHosts: &minimumHosts,
+					StdevFactor:  &stdevFactor,
+				},
+			},
+			expectedOutlierDetection: &xds_cluster.OutlierDetection{
+				EnforcingConsecutive_5Xx: &wrappers.UInt32Value{Value: 0},
+				EnforcingSuccessRate:     &wrappers.UInt32Value{Value: 100},
+				SuccessRateMinimumHosts:  &wrappers.UInt32Value{Value: minimumHosts},
+				SuccessRateStdevFactor:   &wrappers.UInt32Value{Value: stdevFactor},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getOutlierDetection(tc.outlierDetection)
+			assert.Equal(tc.expectedOutlierDetection, actual)
+		})
+	}
+}
+
+func TestGetHealthCheck(t *testing.T) {
+	var healthyThreshold uint32 = 1
+	var unhealthyThreshold uint32 = 5
+	upstreamSvc := service.MeshService{Name: "bookstore", Namespace: "bookstore-ns"}
+
+	testCases := []struct {
+		name                string
+		healthCheck         *policyv1alpha1.HealthCheckSpec
+		expectedHealthCheck *xds_core.HealthCheck
+	}{
+		{
+			name:                "no health check",
+			healthCheck:         nil,
+			expectedHealthCheck: nil,
+		},
+		{
+			name:                "unsupported health check type",
+			healthCheck:         &policyv1alpha1.HealthCheckSpec{Type: "UDP"},
+			expectedHealthCheck: nil,
+		},
+		{
+			name:        "HTTP health check with defaults",
+			healthCheck: &policyv1alpha1.HealthCheckSpec{Type: policyv1alpha1.HTTPHealthCheck},
+			expectedHealthCheck: &xds_core.HealthCheck{
+				Interval:           ptypes.DurationProto(defaultHealthCheckInterval),
+				Timeout:            ptypes.DurationProto(defaultHealthCheckTimeout),
+				HealthyThreshold:   &wrappers.UInt32Value{Value: defaultHealthCheckHealthyThreshold},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: defaultHealthCheckUnhealthyThreshold},
+				HealthChecker: &xds_core.HealthCheck_HttpHealthCheck_{
+					HttpHealthCheck: &xds_core.HealthCheck_HttpHealthCheck{
+						Host: "bookstore.bookstore-ns.svc.cluster.local",
+						Path: "/",
+					},
+				},
+			},
+		},
+		{
+			name: "HTTP health check",
+			healthCheck: &policyv1alpha1.HealthCheckSpec{
+				Type:               policyv1alpha1.HTTPHealthCheck,
+				Path:               "/healthz",
+				Interval:           &metav1.Duration{Duration: 5 * time.Second},
+				Timeout:            &metav1.Duration{Duration: 2 * time.Second},
+				HealthyThreshold:   &healthyThreshold,
+				UnhealthyThreshold: &unhealthyThreshold,
+			},
+			expectedHealthCheck: &xds_core.HealthCheck{
+				Interval:           ptypes.DurationProto(5 * time.Second),
+				Timeout:            ptypes.DurationProto(2 * time.Second),
+				HealthyThreshold:   &wrappers.UInt32Value{Value: healthyThreshold},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: unhealthyThreshold},
+				HealthChecker: &xds_core.HealthCheck_HttpHealthCheck_{
+					HttpHealthCheck: &xds_core.HealthCheck_HttpHealthCheck{
+						Host: "bookstore.bookstore-ns.svc.cluster.local",
+						Path: "/healthz",
+					},
+				},
+			},
+		},
+		{
+			name: "gRPC health check",
+			healthCheck: &policyv1alpha1.HealthCheckSpec{
+				Type:            policyv1alpha1.GRPCHealthCheck,
+				GRPCServiceName: "grpc.health.v1.Health",
+			},
+			expectedHealthCheck: &xds_core.HealthCheck{
+				Interval:           ptypes.DurationProto(defaultHealthCheckInterval),
+				Timeout:            ptypes.DurationProto(defaultHealthCheckTimeout),
+				HealthyThreshold:   &wrappers.UInt32Value{Value: defaultHealthCheckHealthyThreshold},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: defaultHealthCheckUnhealthyThreshold},
+				HealthChecker: &xds_core.HealthCheck_GrpcHealthCheck_{
+					GrpcHealthCheck: &xds_core.HealthCheck_GrpcHealthCheck{
+						ServiceName: "grpc.health.v1.Health",
+						Authority:   "bookstore.bookstore-ns.svc.cluster.local",
+					},
+				},
+			},
+		},
+		{
+			name:        "TCP health check",
+			healthCheck: &policyv1alpha1.HealthCheckSpec{Type: policyv1alpha1.TCPHealthCheck},
+			expectedHealthCheck: &xds_core.HealthCheck{
+				Interval:           ptypes.DurationProto(defaultHealthCheckInterval),
+				Timeout:            ptypes.DurationProto(defaultHealthCheckTimeout),
+				HealthyThreshold:   &wrappers.UInt32Value{Value: defaultHealthCheckHealthyThreshold},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: defaultHealthCheckUnhealthyThreshold},
+				HealthChecker: &xds_core.HealthCheck_TcpHealthCheck_{
+					TcpHealthCheck: &xds_core.HealthCheck_TcpHealthCheck{},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getHealthCheck(tc.healthCheck, upstreamSvc)
+			assert.Equal(tc.expectedHealthCheck, actual)
+		})
+	}
+}
+
+func TestGetLbPolicy(t *testing.T) {
+	testCases := []struct {
+		name             string
+		loadBalancer     *policyv1alpha1.LoadBalancerSpec
+		expectedLbPolicy xds_cluster.Cluster_LbPolicy
+	}{
+		{
+			name:             "no load balancer",
+			loadBalancer:     nil,
+			expectedLbPolicy: xds_cluster.Cluster_ROUND_ROBIN,
+		},
+		{
+			name:             "round robin load balancer",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RoundRobinLoadBalancer},
+			expectedLbPolicy: xds_cluster.Cluster_ROUND_ROBIN,
+		},
+		{
+			name:             "ring hash load balancer",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RingHashLoadBalancer},
+			expectedLbPolicy: xds_cluster.Cluster_RING_HASH,
+		},
+		{
+			name:             "maglev load balancer",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.MaglevLoadBalancer},
+			expectedLbPolicy: xds_cluster.Cluster_MAGLEV,
+		},
+		{
+			name:             "least request load balancer",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.LeastRequestLoadBalancer},
+			expectedLbPolicy: xds_cluster.Cluster_LEAST_REQUEST,
+		},
+		{
+			name:             "random load balancer",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RandomLoadBalancer},
+			expectedLbPolicy: xds_cluster.Cluster_RANDOM,
+		},
+		{
+			name:             "unsupported load balancer type",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: "Sticky"},
+			expectedLbPolicy: xds_cluster.Cluster_ROUND_ROBIN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getLbPolicy(tc.loadBalancer)
+			assert.Equal(tc.expectedLbPolicy, actual)
+		})
+	}
+}
+
+func TestGetLeastRequestLbConfig(t *testing.T) {
+	var choiceCount uint32 = 4
+
+	testCases := []struct {
+		name             string
+		loadBalancer     *policyv1alpha1.LoadBalancerSpec
+		expectedLbConfig *xds_cluster.Cluster_LeastRequestLbConfig
+	}{
+		{
+			name:             "no load balancer",
+			loadBalancer:     nil,
+			expectedLbConfig: nil,
+		},
+		{
+			name:             "least request load balancer without choice count",
+			loadBalancer:     &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.LeastRequestLoadBalancer},
+			expectedLbConfig: nil,
+		},
+		{
+			name: "least request load balancer with choice count",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:         policyv1alpha1.LeastRequestLoadBalancer,
+				LeastRequest: &policyv1alpha1.LeastRequestSpec{ChoiceCount: &choiceCount},
+			},
+			expectedLbConfig: &xds_cluster.Cluster_LeastRequestLbConfig{
+				ChoiceCount: &wrappers.UInt32Value{Value: choiceCount},
+			},
+		},
+		{
+			name: "choice count with a load balancer other than least request",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:         policyv1alpha1.RandomLoadBalancer,
+				LeastRequest: &policyv1alpha1.LeastRequestSpec{ChoiceCount: &choiceCount},
+			},
+			expectedLbConfig: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getLeastRequestLbConfig(tc.loadBalancer)
+			assert.Equal(tc.expectedLbConfig, actual)
+		})
+	}
+}
+
 func TestGetPrometheusCluster(t *testing.T) {
 	assert := tassert.New(t)
 
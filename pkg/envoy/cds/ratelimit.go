@@ -0,0 +1,44 @@
+package cds
+
+import (
+	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+)
+
+// getGlobalRateLimitCluster returns the cluster used to reach the gRPC rate limit service used for global rate limiting
+func getGlobalRateLimitCluster(globalRateLimitConfig ratelimit.GlobalRateLimitConfig) (*xds_cluster.Cluster, error) {
+	HTTP2ProtocolOptions, err := envoy.GetHTTP2ProtocolOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &xds_cluster.Cluster{
+		Name:           constants.EnvoyGlobalRateLimitCluster,
+		AltStatName:    constants.EnvoyGlobalRateLimitCluster,
+		ConnectTimeout: ptypes.DurationProto(clusterConnectTimeout),
+		ClusterDiscoveryType: &xds_cluster.Cluster_Type{
+			Type: xds_cluster.Cluster_LOGICAL_DNS,
+		},
+		LbPolicy:                      xds_cluster.Cluster_ROUND_ROBIN,
+		TypedExtensionProtocolOptions: HTTP2ProtocolOptions,
+		LoadAssignment: &xds_endpoint.ClusterLoadAssignment{
+			ClusterName: constants.EnvoyGlobalRateLimitCluster,
+			Endpoints: []*xds_endpoint.LocalityLbEndpoints{
+				{
+					LbEndpoints: []*xds_endpoint.LbEndpoint{{
+						HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+							Endpoint: &xds_endpoint.Endpoint{
+								Address: envoy.GetAddress(globalRateLimitConfig.Address, uint32(globalRateLimitConfig.Port)),
+							},
+						},
+					}},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,31 @@
+package cds
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+)
+
+func TestGetGlobalRateLimitCluster(t *testing.T) {
+	assert := tassert.New(t)
+
+	actual, err := getGlobalRateLimitCluster(ratelimit.GlobalRateLimitConfig{
+		Enable:  true,
+		Address: "ratelimit.ratelimit.svc.cluster.local",
+		Port:    8081,
+	})
+	assert.Nil(err)
+	assert.Equal(constants.EnvoyGlobalRateLimitCluster, actual.Name)
+	assert.Equal(constants.EnvoyGlobalRateLimitCluster, actual.AltStatName)
+	assert.NotNil(actual.TypedExtensionProtocolOptions)
+
+	endpoints := actual.GetLoadAssignment().GetEndpoints()
+	assert.Len(endpoints, 1)
+	assert.Len(endpoints[0].LbEndpoints, 1)
+	socketAddress := endpoints[0].LbEndpoints[0].GetEndpoint().GetAddress().GetSocketAddress()
+	assert.Equal("ratelimit.ratelimit.svc.cluster.local", socketAddress.GetAddress())
+	assert.Equal(uint32(8081), socketAddress.GetPortValue())
+}
@@ -28,7 +28,7 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 	if proxy.Kind() == envoy.KindGateway {
 		// Build remote clusters based on allowed outbound services
 		for _, dstService := range meshCatalog.ListOutboundServicesForIdentity(proxyIdentity) {
-			cluster, err := getUpstreamServiceCluster(proxyIdentity, dstService, cfg)
+			cluster, err := getUpstreamServiceCluster(proxyIdentity, dstService, cfg, withUpstreamTrafficSetting(meshCatalog.GetUpstreamTrafficSetting(dstService)))
 			if err != nil {
 				log.Error().Err(err).Str(errcode.Kind, errcode.ErrObtainingUpstreamServiceCluster.String()).
 					Msgf("Failed to construct service cluster for service %s for proxy with XDS Certificate SerialNumber=%s on Pod with UID=%s",
@@ -42,9 +42,10 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 	}
 
 	// Build remote clusters based on allowed outbound services
+	permissiveMode := meshCatalog.IsPermissiveTrafficPolicyMode(proxyIdentity)
 	for _, dstService := range meshCatalog.ListOutboundServicesForIdentity(proxyIdentity) {
-		opts := []clusterOption{withTLS}
-		if cfg.IsPermissiveTrafficPolicyMode() {
+		opts := []clusterOption{withTLS, withUpstreamTrafficSetting(meshCatalog.GetUpstreamTrafficSetting(dstService))}
+		if permissiveMode {
 			opts = append(opts, permissive)
 		}
 		cluster, err := getUpstreamServiceCluster(proxyIdentity, dstService, cfg, opts...)
@@ -110,6 +111,16 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 		clusters = append(clusters, getTracingCluster(cfg))
 	}
 
+	// Add an outbound global rate limit service cluster (from localhost to the rate limit service)
+	if globalRateLimitConfig := cfg.GetInboundGlobalRateLimitConfig(); globalRateLimitConfig.Enable {
+		globalRateLimitCluster, err := getGlobalRateLimitCluster(globalRateLimitConfig)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to get global rate limit cluster for proxy %s", proxy.String())
+			return nil, err
+		}
+		clusters = append(clusters, globalRateLimitCluster)
+	}
+
 	return removeDups(clusters), nil
 }
 
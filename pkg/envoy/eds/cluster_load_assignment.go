@@ -1,6 +1,8 @@
 package eds
 
 import (
+	"sort"
+
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 
@@ -15,6 +17,13 @@ const (
 	zone = "zone"
 )
 
+// Priorities of the endpoints relative to the locality of the proxy, from the most to the least preferred
+const (
+	sameZonePriority uint32 = iota
+	sameRegionPriority
+	otherLocalityPriority
+)
+
 // newClusterLoadAssignment returns the cluster load assignments for the given service and its endpoints
 func newClusterLoadAssignment(serviceName service.MeshService, serviceEndpoints []endpoint.Endpoint) *xds_endpoint.ClusterLoadAssignment {
 	cla := &xds_endpoint.ClusterLoadAssignment{
@@ -52,3 +61,87 @@ func newClusterLoadAssignment(serviceName service.MeshService, serviceEndpoints
 	log.Debug().Msgf("[EDS] Constructed ClusterLoadAssignment: %+v", cla)
 	return cla
 }
+
+// newLocalityAwareClusterLoadAssignment returns the cluster load assignments for the given service and its endpoints,
+// grouping the endpoints by locality. Endpoints in the same zone as the proxy are preferred, followed by endpoints in
+// the same region, followed by all other endpoints. Envoy fails over to a lower priority only when the endpoints of
+// the higher priorities are unhealthy.
+func newLocalityAwareClusterLoadAssignment(serviceName service.MeshService, serviceEndpoints []endpoint.Endpoint, proxyRegion, proxyZone string) *xds_endpoint.ClusterLoadAssignment {
+	cla := &xds_endpoint.ClusterLoadAssignment{
+		ClusterName: serviceName.String(),
+	}
+
+	type locality struct {
+		region string
+		zone   string
+	}
+	endpointsByLocality := make(map[locality][]endpoint.Endpoint)
+	var localities []locality
+	for _, meshEndpoint := range serviceEndpoints {
+		l := locality{region: meshEndpoint.Region, zone: meshEndpoint.Zone}
+		if _, ok := endpointsByLocality[l]; !ok {
+			localities = append(localities, l)
+		}
+		endpointsByLocality[l] = append(endpointsByLocality[l], meshEndpoint)
+	}
+
+	getPriority := func(l locality) uint32 {
+		switch {
+		case proxyRegion == "" && proxyZone == "":
+			// The locality of the proxy is unknown, so no locality is preferred
+			return sameZonePriority
+		case l.region == proxyRegion && l.zone == proxyZone:
+			return sameZonePriority
+		case proxyRegion != "" && l.region == proxyRegion:
+			return sameRegionPriority
+		default:
+			return otherLocalityPriority
+		}
+	}
+
+	sort.Slice(localities, func(i, j int) bool {
+		pi, pj := getPriority(localities[i]), getPriority(localities[j])
+		if pi != pj {
+			return pi < pj
+		}
+		if localities[i].region != localities[j].region {
+			return localities[i].region < localities[j].region
+		}
+		return localities[i].zone < localities[j].zone
+	})
+
+	// Envoy requires the priorities to be contiguous starting from 0, so priorities without endpoints are skipped
+	var priority uint32
+	for i, l := range localities {
+		if i > 0 && getPriority(l) != getPriority(localities[i-1]) {
+			priority++
+		}
+
+		endpoints := endpointsByLocality[l]
+		weight := uint32(100 / len(endpoints))
+		localityLbEndpoints := &xds_endpoint.LocalityLbEndpoints{
+			Locality: &xds_core.Locality{
+				Region: l.region,
+				Zone:   l.zone,
+			},
+			Priority: priority,
+		}
+		for _, meshEndpoint := range endpoints {
+			log.Trace().Msgf("[EDS][ClusterLoadAssignment] Adding Endpoint: Cluster=%s, Endpoint=%+v, Weight=%d, Priority=%d", serviceName, meshEndpoint, weight, priority)
+			localityLbEndpoints.LbEndpoints = append(localityLbEndpoints.LbEndpoints, &xds_endpoint.LbEndpoint{
+				HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+					Endpoint: &xds_endpoint.Endpoint{
+						Address: envoy.GetAddress(meshEndpoint.IP.String(), uint32(meshEndpoint.Port)),
+					},
+				},
+				LoadBalancingWeight: &wrappers.UInt32Value{
+					Value: weight,
+				},
+			})
+		}
+		cla.Endpoints = append(cla.Endpoints, localityLbEndpoints)
+	}
+
+	log.Debug().Msgf("[EDS] Constructed locality aware ClusterLoadAssignment: %+v", cla)
+	return cla
+}
@@ -4,10 +4,14 @@ import (
 	"net"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	tassert "github.com/stretchr/testify/assert"
 
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/envoy"
 	"github.com/openservicemesh/osm/pkg/service"
 )
 
@@ -50,3 +54,121 @@ func TestNewClusterLoadAssignment(t *testing.T) {
 	assert.Len(cla3.Endpoints, 1)
 	assert.Len(cla3.Endpoints[0].LbEndpoints, 0)
 }
+
+func TestNewLocalityAwareClusterLoadAssignment(t *testing.T) {
+	svc := service.MeshService{Namespace: "osm", Name: "bookstore", ClusterDomain: constants.LocalDomain}
+
+	sameZone := endpoint.Endpoint{IP: net.ParseIP("10.0.0.1"), Port: 80, Region: "us-east-1", Zone: "us-east-1a"}
+	sameZone2 := endpoint.Endpoint{IP: net.ParseIP("10.0.0.2"), Port: 80, Region: "us-east-1", Zone: "us-east-1a"}
+	sameRegion := endpoint.Endpoint{IP: net.ParseIP("10.0.0.3"), Port: 80, Region: "us-east-1", Zone: "us-east-1b"}
+	otherRegion := endpoint.Endpoint{IP: net.ParseIP("10.0.0.4"), Port: 80, Region: "us-west-2", Zone: "us-west-2a"}
+	unknown := endpoint.Endpoint{IP: net.ParseIP("10.0.0.5"), Port: 80}
+
+	type expectedLocality struct {
+		region       string
+		zone         string
+		priority     uint32
+		numEndpoints int
+		weight       uint32
+	}
+
+	testCases := []struct {
+		name               string
+		endpoints          []endpoint.Endpoint
+		proxyRegion        string
+		proxyZone          string
+		expectedLocalities []expectedLocality
+	}{
+		{
+			name:               "no endpoints",
+			endpoints:          nil,
+			proxyRegion:        "us-east-1",
+			proxyZone:          "us-east-1a",
+			expectedLocalities: nil,
+		},
+		{
+			name:        "endpoints are prioritized by zone, then region",
+			endpoints:   []endpoint.Endpoint{unknown, otherRegion, sameRegion, sameZone, sameZone2},
+			proxyRegion: "us-east-1",
+			proxyZone:   "us-east-1a",
+			expectedLocalities: []expectedLocality{
+				{region: "us-east-1", zone: "us-east-1a", priority: 0, numEndpoints: 2, weight: 50},
+				{region: "us-east-1", zone: "us-east-1b", priority: 1, numEndpoints: 1, weight: 100},
+				{region: "", zone: "", priority: 2, numEndpoints: 1, weight: 100},
+				{region: "us-west-2", zone: "us-west-2a", priority: 2, numEndpoints: 1, weight: 100},
+			},
+		},
+		{
+			name:        "priorities are contiguous when there are no endpoints in the same zone",
+			endpoints:   []endpoint.Endpoint{otherRegion, sameRegion},
+			proxyRegion: "us-east-1",
+			proxyZone:   "us-east-1a",
+			expectedLocalities: []expectedLocality{
+				{region: "us-east-1", zone: "us-east-1b", priority: 0, numEndpoints: 1, weight: 100},
+				{region: "us-west-2", zone: "us-west-2a", priority: 1, numEndpoints: 1, weight: 100},
+			},
+		},
+		{
+			name:        "no locality is preferred when the locality of the proxy is unknown",
+			endpoints:   []endpoint.Endpoint{sameZone, otherRegion},
+			proxyRegion: "",
+			proxyZone:   "",
+			expectedLocalities: []expectedLocality{
+				{region: "us-east-1", zone: "us-east-1a", priority: 0, numEndpoints: 1, weight: 100},
+				{region: "us-west-2", zone: "us-west-2a", priority: 0, numEndpoints: 1, weight: 100},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			cla := newLocalityAwareClusterLoadAssignment(svc, tc.endpoints, tc.proxyRegion, tc.proxyZone)
+			assert.Equal("osm/bookstore/local", cla.ClusterName)
+			assert.Len(cla.Endpoints, len(tc.expectedLocalities))
+
+			for i, expected := range tc.expectedLocalities {
+				actual := cla.Endpoints[i]
+				assert.Equal(expected.region, actual.Locality.Region)
+				assert.Equal(expected.zone, actual.Locality.Zone)
+				assert.Equal(expected.priority, actual.Priority)
+				assert.Len(actual.LbEndpoints, expected.numEndpoints)
+				for _, lbEndpoint := range actual.LbEndpoints {
+					assert.Equal(expected.weight, lbEndpoint.GetLoadBalancingWeight().Value)
+				}
+			}
+		})
+	}
+}
+
+func TestGetClusterLoadAssignment(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+
+	svc := service.MeshService{Namespace: "osm", Name: "bookstore", ClusterDomain: constants.LocalDomain}
+	endpoints := []endpoint.Endpoint{
+		{IP: net.ParseIP("10.0.0.1"), Port: 80, Region: "us-east-1", Zone: "us-east-1a"},
+		{IP: net.ParseIP("10.0.0.2"), Port: 80, Region: "us-east-1", Zone: "us-east-1b"},
+	}
+	proxy := &envoy.Proxy{
+		PodMetadata: &envoy.PodMetadata{Region: "us-east-1", Zone: "us-east-1b"},
+	}
+
+	// Locality aware routing disabled, all endpoints are in a single locality
+	mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{EnableLocalityAwareRouting: false}).Times(1)
+	cla := getClusterLoadAssignment(svc, endpoints, proxy, mockConfigurator)
+	assert.Len(cla.Endpoints, 1)
+	assert.Len(cla.Endpoints[0].LbEndpoints, 2)
+
+	// Locality aware routing enabled, endpoints in the zone of the proxy are preferred
+	mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{EnableLocalityAwareRouting: true}).Times(1)
+	cla = getClusterLoadAssignment(svc, endpoints, proxy, mockConfigurator)
+	assert.Len(cla.Endpoints, 2)
+	assert.Equal("us-east-1b", cla.Endpoints[0].Locality.Zone)
+	assert.Equal(uint32(0), cla.Endpoints[0].Priority)
+	assert.Equal("us-east-1a", cla.Endpoints[1].Locality.Zone)
+	assert.Equal(uint32(1), cla.Endpoints[1].Priority)
+}
@@ -3,6 +3,7 @@ package eds
 import (
 	"strings"
 
+	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	xds_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/pkg/errors"
@@ -23,18 +24,18 @@ const (
 )
 
 // NewResponse creates a new Endpoint Discovery Response.
-func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, request *xds_discovery.DiscoveryRequest, _ configurator.Configurator, _ certificate.Manager, _ *registry.ProxyRegistry) ([]types.Resource, error) {
+func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, request *xds_discovery.DiscoveryRequest, cfg configurator.Configurator, _ certificate.Manager, _ *registry.ProxyRegistry) ([]types.Resource, error) {
 	// If request comes through and requests specific endpoints, just attempt to answer those
 	if request != nil && len(request.ResourceNames) > 0 {
-		return fulfillEDSRequest(meshCatalog, proxy, request)
+		return fulfillEDSRequest(meshCatalog, proxy, request, cfg)
 	}
 
 	// Otherwise, generate all endpoint configuration for this proxy
-	return generateEDSConfig(meshCatalog, proxy)
+	return generateEDSConfig(meshCatalog, proxy, cfg)
 }
 
 // fulfillEDSRequest replies only to requested EDS endpoints on Discovery Request
-func fulfillEDSRequest(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, request *xds_discovery.DiscoveryRequest) ([]types.Resource, error) {
+func fulfillEDSRequest(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, request *xds_discovery.DiscoveryRequest, cfg configurator.Configurator) ([]types.Resource, error) {
 	proxyIdentity, err := envoy.GetServiceIdentityFromProxyCertificate(proxy.GetCertificateCommonName())
 	if err != nil {
 		log.Error().Err(err).Msgf("Error looking up identity for proxy %s", proxy.String())
@@ -57,7 +58,7 @@ func fulfillEDSRequest(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, re
 			log.Error().Err(err).Msgf("Failed listing allowed endpoints for service %s, for proxy identity %s", meshSvc, proxyIdentity)
 			continue
 		}
-		loadAssignment := newClusterLoadAssignment(meshSvc, endpoints)
+		loadAssignment := getClusterLoadAssignment(meshSvc, endpoints, proxy, cfg)
 		rdsResources = append(rdsResources, loadAssignment)
 	}
 
@@ -65,7 +66,7 @@ func fulfillEDSRequest(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, re
 }
 
 // generateEDSConfig generates all endpoints expected for a given proxy
-func generateEDSConfig(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy) ([]types.Resource, error) {
+func generateEDSConfig(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, cfg configurator.Configurator) ([]types.Resource, error) {
 	proxyIdentity, err := envoy.GetServiceIdentityFromProxyCertificate(proxy.GetCertificateCommonName())
 	if err != nil {
 		log.Error().Err(err).Msgf("Error looking up identity for proxy %s", proxy.String())
@@ -80,13 +81,27 @@ func generateEDSConfig(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy) ([
 
 	var rdsResources []types.Resource
 	for svc, endpoints := range allowedEndpoints {
-		loadAssignment := newClusterLoadAssignment(svc, endpoints)
+		loadAssignment := getClusterLoadAssignment(svc, endpoints, proxy, cfg)
 		rdsResources = append(rdsResources, loadAssignment)
 	}
 
 	return rdsResources, nil
 }
 
+// getClusterLoadAssignment returns the cluster load assignment for the given service and its endpoints.
+// When locality aware routing is enabled, the endpoints are prioritized based on their locality relative to the proxy.
+func getClusterLoadAssignment(svc service.MeshService, endpoints []endpoint.Endpoint, proxy *envoy.Proxy, cfg configurator.Configurator) *xds_endpoint.ClusterLoadAssignment {
+	if !cfg.GetFeatureFlags().EnableLocalityAwareRouting {
+		return newClusterLoadAssignment(svc, endpoints)
+	}
+
+	var proxyRegion, proxyZone string
+	if proxy.HasPodMetadata() {
+		proxyRegion, proxyZone = proxy.PodMetadata.Region, proxy.PodMetadata.Zone
+	}
+	return newLocalityAwareClusterLoadAssignment(svc, endpoints, proxyRegion, proxyZone)
+}
+
 func clusterToMeshSvc(cluster string) (service.MeshService, error) {
 	chunks := strings.Split(cluster, namespacedNameDelimiter)
 	if len(chunks) != 3 {
@@ -11,6 +11,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	testclient "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
 	configFake "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
 	"github.com/openservicemesh/osm/pkg/service"
 
@@ -69,6 +70,8 @@ func TestEndpointConfiguration(t *testing.T) {
 	request := &xds_discovery.DiscoveryRequest{
 		ResourceNames: []string{"default/bookstore-v1/local"},
 	}
+	mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{}).AnyTimes()
+
 	resources, err := NewResponse(meshCatalog, proxy, request, mockConfigurator, nil, nil)
 	assert.Nil(err)
 	assert.NotNil(resources)
@@ -0,0 +1,25 @@
+package lds
+
+import (
+	xds_http_cors "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+)
+
+// getCORSHTTPFilter returns the HTTP CORS filter. The filter does not enforce CORS by itself,
+// the CORS policies are configured per virtual host in the inbound and ingress route configurations.
+func getCORSHTTPFilter() (*xds_hcm.HttpFilter, error) {
+	marshalledCORS, err := ptypes.MarshalAny(&xds_http_cors.Cors{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP CORS filter")
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: wellknown.CORS,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledCORS,
+		},
+	}, nil
+}
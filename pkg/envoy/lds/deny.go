@@ -0,0 +1,236 @@
+package lds
+
+import (
+	"sort"
+
+	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	xds_network_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/envoy/rbac"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// httpDenyRBACFilterName is the name of the HTTP RBAC filter denying the requests on the routes of Deny policies.
+	// It differs from the name of the HTTP RBAC filter configured per route so that the per route configurations
+	// do not override it.
+	httpDenyRBACFilterName = "osm.filters.http.deny_rbac"
+
+	// methodHeaderKey is the name of the pseudo header for the HTTP method
+	methodHeaderKey = ":method"
+
+	// httpHostHeaderKey is the name of the HTTP host header in HTTPRouteMatch.Headers
+	httpHostHeaderKey = "host"
+
+	// authorityHeaderKey is the name of the pseudo header for the HTTP host
+	authorityHeaderKey = ":authority"
+)
+
+// buildDenyRBACFilter builds a network RBAC filter denying the connections from the sources of the given deny rules
+// that apply to all the traffic. It returns nil if none of the deny rules apply to all the traffic.
+func buildDenyRBACFilter(denyRules []*trafficpolicy.DenyRule) (*xds_listener.Filter, error) {
+	rbacPolicies := make(map[string]*xds_rbac.Policy)
+	for _, denyRule := range denyRules {
+		if len(denyRule.HTTPRouteMatches) != 0 {
+			// Deny rules with routes are enforced by the HTTP deny RBAC filter
+			continue
+		}
+
+		policy, err := buildDenyRBACPolicy(denyRule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error building RBAC policy for Deny policy %s", denyRule.Name)
+		}
+		rbacPolicies[denyRule.Name] = policy
+	}
+
+	if len(rbacPolicies) == 0 {
+		return nil, nil
+	}
+
+	networkRBACPolicy := &xds_network_rbac.RBAC{
+		StatPrefix: "network-deny-", // will be displayed as network-deny-rbac.<path>
+		Rules: &xds_rbac.RBAC{
+			Action:   xds_rbac.RBAC_DENY, // Denies the request if there is a policy that matches the request
+			Policies: rbacPolicies,
+		},
+	}
+
+	marshalledNetworkRBACPolicy, err := ptypes.MarshalAny(networkRBACPolicy)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling network deny RBAC policy")
+	}
+
+	return &xds_listener.Filter{
+		Name:       wellknown.RoleBasedAccessControl,
+		ConfigType: &xds_listener.Filter_TypedConfig{TypedConfig: marshalledNetworkRBACPolicy},
+	}, nil
+}
+
+// getDenyRBACHTTPFilter returns an HTTP RBAC filter denying the requests from the sources of the given deny rules
+// on the routes of these rules. It returns nil if none of the deny rules have routes.
+func getDenyRBACHTTPFilter(denyRules []*trafficpolicy.DenyRule) (*xds_hcm.HttpFilter, error) {
+	rbacPolicies := make(map[string]*xds_rbac.Policy)
+	for _, denyRule := range denyRules {
+		if len(denyRule.HTTPRouteMatches) == 0 {
+			// Deny rules without routes are enforced by the network deny RBAC filter
+			continue
+		}
+
+		policy, err := buildDenyRBACPolicy(denyRule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error building RBAC policy for Deny policy %s", denyRule.Name)
+		}
+
+		// Each route match is its own permission in the RBAC policy
+		policy.Permissions = nil
+		for _, routeMatch := range denyRule.HTTPRouteMatches {
+			policy.Permissions = append(policy.Permissions, getHTTPRouteMatchPermission(routeMatch))
+		}
+		rbacPolicies[denyRule.Name] = policy
+	}
+
+	if len(rbacPolicies) == 0 {
+		return nil, nil
+	}
+
+	httpRBAC := &xds_http_rbac.RBAC{
+		Rules: &xds_rbac.RBAC{
+			Action:   xds_rbac.RBAC_DENY, // Denies the request if there is a policy that matches the request
+			Policies: rbacPolicies,
+		},
+	}
+
+	marshalledHTTPRBAC, err := ptypes.MarshalAny(httpRBAC)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP deny RBAC filter")
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: httpDenyRBACFilterName,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledHTTPRBAC,
+		},
+	}, nil
+}
+
+// buildDenyRBACPolicy builds an RBAC policy whose principals are the sources of the given deny rule,
+// and whose permissions are set to ANY (all permissions)
+func buildDenyRBACPolicy(denyRule *trafficpolicy.DenyRule) (*xds_rbac.Policy, error) {
+	policy := &rbac.Policy{}
+
+	// The principals of the policy are set to ANY (all downstreams) when the deny rule has no sources
+	var principalRules []rbac.Rule
+	for _, source := range denyRule.Sources {
+		downstreamPrincipal := identity.GetKubernetesServiceIdentity(source, identity.ClusterLocalTrustDomain)
+		principalRules = append(principalRules, rbac.Rule{Attribute: rbac.DownstreamAuthPrincipal, Value: downstreamPrincipal.String()})
+	}
+	for _, namespace := range denyRule.SourceNamespaces {
+		// The principals of the service accounts in a namespace are formatted as <service-account>.<namespace>.<trust-domain>
+		principalRules = append(principalRules, rbac.Rule{
+			Attribute: rbac.DownstreamAuthPrincipalSuffix,
+			Value:     "." + namespace + "." + identity.ClusterLocalTrustDomain,
+		})
+	}
+	if len(principalRules) != 0 {
+		policy.Principals = []rbac.RulesList{{OrRules: principalRules}}
+	}
+
+	return policy.Generate()
+}
+
+// getHTTPRouteMatchPermission returns the RBAC permission matching the requests matched by the given HTTP route match.
+// The query parameters of the HTTP route match are not matched by the permission.
+func getHTTPRouteMatchPermission(routeMatch trafficpolicy.HTTPRouteMatch) *xds_rbac.Permission {
+	var permissions []*xds_rbac.Permission
+
+	if routeMatch.Path != "" {
+		pathMatcher := &xds_matcher.StringMatcher{}
+		switch routeMatch.PathMatchType {
+		case trafficpolicy.PathMatchRegex:
+			pathMatcher.MatchPattern = &xds_matcher.StringMatcher_SafeRegex{SafeRegex: getRegexMatcher(routeMatch.Path)}
+		case trafficpolicy.PathMatchExact:
+			pathMatcher.MatchPattern = &xds_matcher.StringMatcher_Exact{Exact: routeMatch.Path}
+		case trafficpolicy.PathMatchPrefix:
+			pathMatcher.MatchPattern = &xds_matcher.StringMatcher_Prefix{Prefix: routeMatch.Path}
+		}
+		permissions = append(permissions, &xds_rbac.Permission{
+			Rule: &xds_rbac.Permission_UrlPath{
+				UrlPath: &xds_matcher.PathMatcher{
+					Rule: &xds_matcher.PathMatcher_Path{Path: pathMatcher},
+				},
+			},
+		})
+	}
+
+	// Matching methods have an OR relationship, a wildcard method matches all the methods
+	var methodPermissions []*xds_rbac.Permission
+	for _, method := range routeMatch.Methods {
+		if method == constants.WildcardHTTPMethod {
+			methodPermissions = nil
+			break
+		}
+		methodPermissions = append(methodPermissions, getHeaderPermission(methodHeaderKey, method))
+	}
+	if len(methodPermissions) != 0 {
+		permissions = append(permissions, &xds_rbac.Permission{
+			Rule: &xds_rbac.Permission_OrRules{
+				OrRules: &xds_rbac.Permission_Set{Rules: methodPermissions},
+			},
+		})
+	}
+
+	// Sort the headers so that the permission is the same across builds
+	var headerKeys []string
+	for headerKey := range routeMatch.Headers {
+		headerKeys = append(headerKeys, headerKey)
+	}
+	sort.Strings(headerKeys)
+	for _, headerKey := range headerKeys {
+		headerName := headerKey
+		if headerKey == httpHostHeaderKey {
+			headerName = authorityHeaderKey
+		}
+		permissions = append(permissions, getHeaderPermission(headerName, routeMatch.Headers[headerKey]))
+	}
+
+	if len(permissions) == 0 {
+		return &xds_rbac.Permission{
+			Rule: &xds_rbac.Permission_Any{Any: true},
+		}
+	}
+
+	return &xds_rbac.Permission{
+		Rule: &xds_rbac.Permission_AndRules{
+			AndRules: &xds_rbac.Permission_Set{Rules: permissions},
+		},
+	}
+}
+
+// getHeaderPermission returns an RBAC permission matching the requests whose given header matches the given regex
+func getHeaderPermission(name string, regex string) *xds_rbac.Permission {
+	return &xds_rbac.Permission{
+		Rule: &xds_rbac.Permission_Header{
+			Header: &xds_route.HeaderMatcher{
+				Name:                 name,
+				HeaderMatchSpecifier: &xds_route.HeaderMatcher_SafeRegexMatch{SafeRegexMatch: getRegexMatcher(regex)},
+			},
+		},
+	}
+}
+
+func getRegexMatcher(regex string) *xds_matcher.RegexMatcher {
+	return &xds_matcher.RegexMatcher{
+		EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+		Regex:      regex,
+	}
+}
@@ -0,0 +1,149 @@
+package lds
+
+import (
+	"testing"
+
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	xds_network_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestBuildDenyRBACFilter(t *testing.T) {
+	testCases := []struct {
+		name             string
+		denyRules        []*trafficpolicy.DenyRule
+		expectedPolicies []string
+	}{
+		{
+			name:             "no deny rules",
+			denyRules:        nil,
+			expectedPolicies: nil,
+		},
+		{
+			name: "only deny rules with routes",
+			denyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:             "default/deny-sell",
+					HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{{Path: "/sell", PathMatchType: trafficpolicy.PathMatchExact}},
+				},
+			},
+			expectedPolicies: nil,
+		},
+		{
+			name: "deny rules with and without routes",
+			denyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:    "default/deny-sa",
+					Sources: []identity.K8sServiceAccount{{Name: "sa-2", Namespace: "ns-2"}},
+				},
+				{
+					Name:             "default/deny-sell",
+					HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{{Path: "/sell", PathMatchType: trafficpolicy.PathMatchExact}},
+				},
+			},
+			expectedPolicies: []string{"default/deny-sa"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			filter, err := buildDenyRBACFilter(tc.denyRules)
+			assert.Nil(err)
+
+			if tc.expectedPolicies == nil {
+				assert.Nil(filter)
+				return
+			}
+
+			assert.Equal(wellknown.RoleBasedAccessControl, filter.Name)
+			networkRBAC := &xds_network_rbac.RBAC{}
+			assert.Nil(ptypes.UnmarshalAny(filter.GetTypedConfig(), networkRBAC))
+			assert.Equal(xds_rbac.RBAC_DENY, networkRBAC.Rules.Action)
+			assert.Len(networkRBAC.Rules.Policies, len(tc.expectedPolicies))
+			for _, policyName := range tc.expectedPolicies {
+				assert.Contains(networkRBAC.Rules.Policies, policyName)
+			}
+		})
+	}
+}
+
+func TestGetDenyRBACHTTPFilter(t *testing.T) {
+	assert := tassert.New(t)
+
+	denyRules := []*trafficpolicy.DenyRule{
+		{
+			Name: "default/deny-all",
+		},
+		{
+			Name:             "default/deny-sell",
+			Sources:          []identity.K8sServiceAccount{{Name: "sa-2", Namespace: "ns-2"}},
+			SourceNamespaces: []string{"ns-3"},
+			HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{
+				{
+					Path:          "/sell",
+					PathMatchType: trafficpolicy.PathMatchPrefix,
+					Methods:       []string{"POST", "PUT"},
+					Headers:       map[string]string{"host": "bookstore.*"},
+				},
+				{
+					Path:          "/books/.*",
+					PathMatchType: trafficpolicy.PathMatchRegex,
+					Methods:       []string{"*"},
+				},
+			},
+		},
+	}
+
+	filter, err := getDenyRBACHTTPFilter(denyRules)
+	assert.Nil(err)
+	assert.Equal(httpDenyRBACFilterName, filter.Name)
+
+	httpRBAC := &xds_http_rbac.RBAC{}
+	assert.Nil(ptypes.UnmarshalAny(filter.GetTypedConfig(), httpRBAC))
+	assert.Equal(xds_rbac.RBAC_DENY, httpRBAC.Rules.Action)
+
+	// Only the deny rule with routes is enforced by the HTTP filter
+	assert.Len(httpRBAC.Rules.Policies, 1)
+	policy := httpRBAC.Rules.Policies["default/deny-sell"]
+	assert.NotNil(policy)
+
+	// The principals match the service account and the service accounts in the namespace
+	principals := policy.Principals[0].GetOrIds().Ids
+	assert.Len(principals, 2)
+	assert.Equal("sa-2.ns-2.cluster.local", principals[0].GetAuthenticated().PrincipalName.GetExact())
+	assert.Equal(".ns-3.cluster.local", principals[1].GetAuthenticated().PrincipalName.GetSuffix())
+
+	// A permission per route match
+	assert.Len(policy.Permissions, 2)
+
+	sellRules := policy.Permissions[0].GetAndRules().Rules
+	assert.Len(sellRules, 3)
+	assert.Equal("/sell", sellRules[0].GetUrlPath().GetPath().GetPrefix())
+	methods := sellRules[1].GetOrRules().Rules
+	assert.Len(methods, 2)
+	assert.Equal(methodHeaderKey, methods[0].GetHeader().Name)
+	assert.Equal("POST", methods[0].GetHeader().GetSafeRegexMatch().Regex)
+	assert.Equal(authorityHeaderKey, sellRules[2].GetHeader().Name)
+	assert.Equal("bookstore.*", sellRules[2].GetHeader().GetSafeRegexMatch().Regex)
+
+	// The wildcard method matches all the methods
+	booksRules := policy.Permissions[1].GetAndRules().Rules
+	assert.Len(booksRules, 1)
+	assert.Equal("/books/.*", booksRules[0].GetUrlPath().GetPath().GetSafeRegex().Regex)
+}
+
+func TestGetDenyRBACHTTPFilterWithoutRoutes(t *testing.T) {
+	assert := tassert.New(t)
+
+	filter, err := getDenyRBACHTTPFilter([]*trafficpolicy.DenyRule{{Name: "default/deny-all"}})
+	assert.Nil(err)
+	assert.Nil(filter)
+}
@@ -58,7 +58,7 @@ func (lb *listenerBuilder) getEgressFilterChainsForMatches(matches []*trafficpol
 }
 
 func (lb *listenerBuilder) getEgressHTTPFilterChain(destinationPort int) (*xds_listener.FilterChain, error) {
-	filter, err := lb.getOutboundHTTPFilter(route.GetEgressRouteConfigNameForPort(destinationPort))
+	filter, err := lb.getOutboundHTTPFilter(route.GetEgressRouteConfigNameForPort(destinationPort), nil)
 	if err != nil {
 		log.Error().Err(err).Msgf("Error building HTTP filter chain for destination port [%d]", destinationPort)
 		return nil, err
@@ -2,6 +2,7 @@ package lds
 
 import (
 	"testing"
+	"time"
 
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -55,6 +56,7 @@ func TestGetEgressHTTPFilterChain(t *testing.T) {
 				cfg: mockConfigurator,
 			}
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+			mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("some-endpoint").AnyTimes()
 			mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{
 				EnableEgressPolicy: true,
@@ -227,6 +229,7 @@ func TestGetEgressFilterChainsForMatches(t *testing.T) {
 				cfg: mockConfigurator,
 			}
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+			mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("some-endpoint").AnyTimes()
 			mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{
 				EnableEgressPolicy: true,
@@ -0,0 +1,25 @@
+package lds
+
+import (
+	xds_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+)
+
+// getFaultInjectionHTTPFilter returns the HTTP fault filter. The filter does not inject faults by itself,
+// the faults are configured per route in the outbound route configuration.
+func getFaultInjectionHTTPFilter() (*xds_hcm.HttpFilter, error) {
+	marshalledHTTPFault, err := ptypes.MarshalAny(&xds_http_fault.HTTPFault{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP fault filter")
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: wellknown.Fault,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledHTTPFault,
+		},
+	}, nil
+}
@@ -3,6 +3,7 @@ package lds
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	"github.com/golang/mock/gomock"
@@ -29,6 +30,7 @@ func TestNewMultiClusterGatewayListener(t *testing.T) {
 
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+	mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
 	mockConfigurator.EXPECT().GetClusterDomain().Return("cluster-x").AnyTimes()
 	id := identity.K8sServiceAccount{Name: "gateway", Namespace: "osm-system"}.ToServiceIdentity()
@@ -52,6 +54,7 @@ func TestNewMultiClusterGatewayListener(t *testing.T) {
 
 	mockCatalog.EXPECT().GetWeightedClustersForUpstream(tests.BookbuyerService).Return(nil).AnyTimes()
 	mockCatalog.EXPECT().GetWeightedClustersForUpstream(tests.BookwarehouseService).Return(nil).AnyTimes()
+	mockCatalog.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
 
 	lb := &listenerBuilder{
 		meshCatalog:     mockCatalog,
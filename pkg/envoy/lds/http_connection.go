@@ -2,16 +2,21 @@ package lds
 
 import (
 	"fmt"
+	"time"
 
 	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 // connectionDirection defines, for filter terms, the direction of a connection from
@@ -36,12 +41,24 @@ type httpConnManagerOptions struct {
 	rdsRoutConfigName string
 
 	// Additional filters
-	wasmStatsHeaders map[string]string
-	extAuthConfig    *auth.ExtAuthConfig
+	wasmStatsHeaders      map[string]string
+	extAuthConfig         *auth.ExtAuthConfig
+	localRateLimit        bool
+	globalRateLimitConfig *ratelimit.GlobalRateLimitConfig
+	faultInjection        bool
+	cors                  bool
+	requestAuthentication *policyv1alpha1.RequestAuthenticationSpec
+	denyRules             []*trafficpolicy.DenyRule
+
+	// HTTP upgrade options
+	upgradeTypes []string
 
 	// Tracing options
 	enableTracing      bool
 	tracingAPIEndpoint string
+
+	// Timeout options
+	streamIdleTimeout time.Duration
 }
 
 func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, error) {
@@ -63,7 +80,40 @@ func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, e
 				RouteConfigName: options.rdsRoutConfigName,
 			},
 		},
-		AccessLog: envoy.GetAccessLog(),
+		AccessLog:         envoy.GetAccessLog(),
+		StreamIdleTimeout: ptypes.DurationProto(options.streamIdleTimeout),
+	}
+
+	// For inbound connections, add the deny RBAC filter if Deny policies apply to routes.
+	// The deny RBAC filter precedes the HTTP RBAC filter so that the denied requests are rejected even if they are allowed.
+	if options.direction == inbound {
+		denyRBACFilter, err := getDenyRBACHTTPFilter(options.denyRules)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error getting deny RBAC filter for HTTP connection manager")
+		}
+		if denyRBACFilter != nil {
+			connManager.HttpFilters = append([]*xds_hcm.HttpFilter{denyRBACFilter}, connManager.HttpFilters...)
+		}
+	}
+
+	// For inbound connections, add the JWT authentication filters if requested.
+	// The JWT authentication filters precede the HTTP RBAC filter so that requests are authorized once authenticated.
+	if options.direction == inbound && options.requestAuthentication != nil {
+		jwtAuthnFilters, err := getJWTAuthnHTTPFilters(options.requestAuthentication)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error getting JWT authentication filters for HTTP connection manager")
+		}
+		connManager.HttpFilters = append(jwtAuthnFilters, connManager.HttpFilters...)
+	}
+
+	// For inbound connections, add the CORS filter if requested.
+	// The CORS filter precedes the authorization filters so that CORS preflight requests are answered without being authorized.
+	if options.direction == inbound && options.cors {
+		corsFilter, err := getCORSHTTPFilter()
+		if err != nil {
+			return nil, errors.Wrap(err, "Error getting CORS filter for HTTP connection manager")
+		}
+		connManager.HttpFilters = append([]*xds_hcm.HttpFilter{corsFilter}, connManager.HttpFilters...)
 	}
 
 	// For inbound connections, add the Authz filter
@@ -71,6 +121,41 @@ func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, e
 		connManager.HttpFilters = append(connManager.HttpFilters, getExtAuthzHTTPFilter(options.extAuthConfig))
 	}
 
+	// For inbound connections, add the local rate limit filter if requested
+	if options.direction == inbound && options.localRateLimit {
+		localRateLimitFilter, err := getHTTPLocalRateLimitFilter()
+		if err != nil {
+			return nil, errors.Wrap(err, "Error getting local rate limit filter for HTTP connection manager")
+		}
+		connManager.HttpFilters = append(connManager.HttpFilters, localRateLimitFilter)
+	}
+
+	// For inbound connections, add the global rate limit filter
+	if options.direction == inbound && options.globalRateLimitConfig != nil {
+		globalRateLimitFilter, err := getGlobalRateLimitHTTPFilter(options.globalRateLimitConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error getting global rate limit filter for HTTP connection manager")
+		}
+		connManager.HttpFilters = append(connManager.HttpFilters, globalRateLimitFilter)
+	}
+
+	// For outbound connections, add the fault filter if requested
+	if options.direction == outbound && options.faultInjection {
+		faultInjectionFilter, err := getFaultInjectionHTTPFilter()
+		if err != nil {
+			return nil, errors.Wrap(err, "Error getting fault filter for HTTP connection manager")
+		}
+		connManager.HttpFilters = append(connManager.HttpFilters, faultInjectionFilter)
+	}
+
+	// Enable the HTTP upgrades if requested. Upgrade requests go through the HTTP filters of the connection manager
+	// like other requests, so the HTTP authorization of the upgrade requests is preserved.
+	for _, upgradeType := range options.upgradeTypes {
+		connManager.UpgradeConfigs = append(connManager.UpgradeConfigs, &xds_hcm.HttpConnectionManager_UpgradeConfig{
+			UpgradeType: upgradeType,
+		})
+	}
+
 	// Enable tracing if requested
 	if options.enableTracing {
 		tracing, err := getHTTPTracingConfig(options.tracingAPIEndpoint)
@@ -8,7 +8,11 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/stretchr/testify/assert"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/auth"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 func TestHTTPConnbuild(t *testing.T) {
@@ -111,6 +115,192 @@ func TestHTTPConnbuild(t *testing.T) {
 				a.True(notContains(connManager.HttpFilters, wellknown.HTTPExternalAuthorization))
 			},
 		},
+		{
+			name: "local rate limit when set is enabled for inbound",
+			option: httpConnManagerOptions{
+				direction:      inbound,
+				localRateLimit: true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(contains(connManager.HttpFilters, envoy.HTTPLocalRateLimitFilterName))
+			},
+		},
+		{
+			name: "local rate limit when set is disabled for outbound",
+			option: httpConnManagerOptions{
+				direction:      outbound,
+				localRateLimit: true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPLocalRateLimitFilterName))
+			},
+		},
+		{
+			name: "global rate limit when set is enabled for inbound",
+			option: httpConnManagerOptions{
+				direction: inbound,
+				globalRateLimitConfig: &ratelimit.GlobalRateLimitConfig{
+					Enable:  true,
+					Address: "ratelimit.ratelimit.svc.cluster.local",
+					Port:    8081,
+					Domain:  "osm",
+					Timeout: 20 * time.Millisecond,
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(contains(connManager.HttpFilters, wellknown.HTTPRateLimit))
+			},
+		},
+		{
+			name: "global rate limit when set is disabled for outbound",
+			option: httpConnManagerOptions{
+				direction: outbound,
+				globalRateLimitConfig: &ratelimit.GlobalRateLimitConfig{
+					Enable: true,
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, wellknown.HTTPRateLimit))
+			},
+		},
+		{
+			name: "fault injection when set is enabled for outbound",
+			option: httpConnManagerOptions{
+				direction:      outbound,
+				faultInjection: true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(contains(connManager.HttpFilters, wellknown.Fault))
+			},
+		},
+		{
+			name: "fault injection when set is disabled for inbound",
+			option: httpConnManagerOptions{
+				direction:      inbound,
+				faultInjection: true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, wellknown.Fault))
+			},
+		},
+		{
+			name: "CORS when set is enabled for inbound and precedes the other filters",
+			option: httpConnManagerOptions{
+				direction: inbound,
+				cors:      true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Equal(wellknown.CORS, connManager.HttpFilters[0].Name)
+			},
+		},
+		{
+			name: "CORS when set is disabled for outbound",
+			option: httpConnManagerOptions{
+				direction: outbound,
+				cors:      true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, wellknown.CORS))
+			},
+		},
+		{
+			name: "JWT authentication when set is enabled for inbound and precedes HTTP RBAC",
+			option: httpConnManagerOptions{
+				direction: inbound,
+				cors:      true,
+				requestAuthentication: &policyv1alpha1.RequestAuthenticationSpec{
+					JWTRules: []policyv1alpha1.JWTRule{
+						{
+							Issuer:               "https://issuer.example.com",
+							JWKS:                 `{"keys": []}`,
+							OutputClaimToHeaders: []policyv1alpha1.ClaimToHeader{{Header: "x-jwt-sub", Claim: "sub"}},
+						},
+					},
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Equal(wellknown.CORS, connManager.HttpFilters[0].Name)
+				a.Equal(envoy.HTTPJWTAuthnFilterName, connManager.HttpFilters[1].Name)
+				a.Equal(wellknown.Lua, connManager.HttpFilters[2].Name)
+				a.Equal(wellknown.HTTPRoleBasedAccessControl, connManager.HttpFilters[3].Name)
+			},
+		},
+		{
+			name: "JWT authentication when set is disabled for outbound",
+			option: httpConnManagerOptions{
+				direction: outbound,
+				requestAuthentication: &policyv1alpha1.RequestAuthenticationSpec{
+					JWTRules: []policyv1alpha1.JWTRule{{Issuer: "https://issuer.example.com", JWKS: `{"keys": []}`}},
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPJWTAuthnFilterName))
+			},
+		},
+		{
+			name: "deny RBAC when set is enabled for inbound and precedes HTTP RBAC",
+			option: httpConnManagerOptions{
+				direction: inbound,
+				requestAuthentication: &policyv1alpha1.RequestAuthenticationSpec{
+					JWTRules: []policyv1alpha1.JWTRule{{Issuer: "https://issuer.example.com", JWKS: `{"keys": []}`}},
+				},
+				denyRules: []*trafficpolicy.DenyRule{
+					{
+						Name:             "default/deny-sell",
+						HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{{Path: "/sell", PathMatchType: trafficpolicy.PathMatchExact}},
+					},
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Equal(envoy.HTTPJWTAuthnFilterName, connManager.HttpFilters[0].Name)
+				a.Equal(httpDenyRBACFilterName, connManager.HttpFilters[1].Name)
+				a.Equal(wellknown.HTTPRoleBasedAccessControl, connManager.HttpFilters[2].Name)
+			},
+		},
+		{
+			name: "deny RBAC when set is disabled for outbound",
+			option: httpConnManagerOptions{
+				direction: outbound,
+				denyRules: []*trafficpolicy.DenyRule{
+					{
+						Name:             "default/deny-sell",
+						HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{{Path: "/sell", PathMatchType: trafficpolicy.PathMatchExact}},
+					},
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, httpDenyRBACFilterName))
+			},
+		},
+		{
+			name: "upgrade configs when upgrade types are set",
+			option: httpConnManagerOptions{
+				upgradeTypes: []string{"websocket", "spdy/3.1"},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Len(connManager.UpgradeConfigs, 2)
+				a.Equal("websocket", connManager.UpgradeConfigs[0].UpgradeType)
+				a.Equal("spdy/3.1", connManager.UpgradeConfigs[1].UpgradeType)
+				// Upgrade requests must go through the filters of the connection manager
+				a.Nil(connManager.UpgradeConfigs[0].Filters)
+			},
+		},
+		{
+			name:   "no upgrade configs when upgrade types are not set",
+			option: httpConnManagerOptions{},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Empty(connManager.UpgradeConfigs)
+			},
+		},
+		{
+			name: "stream idle timeout when set",
+			option: httpConnManagerOptions{
+				streamIdleTimeout: 10 * time.Minute,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Equal(10*time.Minute, connManager.StreamIdleTimeout.AsDuration())
+			},
+		},
 	}
 
 	for _, tc := range testCases {
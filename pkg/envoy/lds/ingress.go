@@ -41,18 +41,42 @@ func (lb *listenerBuilder) newIngressHTTPFilterChain(cfg configurator.Configurat
 		return nil
 	}
 
+	var filters []*xds_listener.Filter
+
+	// Apply a deny RBAC filter when Deny policies apply to all the traffic of the proxy, so that the ingress traffic
+	// from the denied sources is rejected as well
+	denyRules := lb.meshCatalog.ListInboundDenyRules(lb.serviceIdentity)
+	denyRBACFilter, err := buildDenyRBACFilter(denyRules)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying deny RBAC filter for proxy with identity %s and service %s", lb.serviceIdentity, svc)
+		return nil
+	}
+	if denyRBACFilter != nil {
+		filters = append(filters, denyRBACFilter)
+	}
+
 	// Build the HTTP Connection Manager filter from its options
 	ingressConnManager, err := httpConnManagerOptions{
 		direction:         inbound,
 		rdsRoutConfigName: route.IngressRouteConfigName,
 
 		// Additional filters
-		wasmStatsHeaders: nil, // no WASM Stats for ingress traffic
-		extAuthConfig:    lb.getExtAuthConfig(),
+		wasmStatsHeaders:      nil, // no WASM Stats for ingress traffic
+		extAuthConfig:         lb.getExtAuthConfig(),
+		globalRateLimitConfig: lb.getGlobalRateLimitConfig(),
+		localRateLimit:        isHTTPLocalRateLimitEnabled(lb.meshCatalog.GetRateLimitPolicy(svc)),
+		cors:                  lb.meshCatalog.GetCORSPolicy(svc) != nil,
+		denyRules:             denyRules,
+
+		// HTTP upgrade options
+		upgradeTypes: lb.meshCatalog.GetHTTPUpgradeTypesForTargetPort(svc, svcPort),
 
 		// Tracing options
 		enableTracing:      lb.cfg.IsTracingEnabled(),
 		tracingAPIEndpoint: lb.cfg.GetTracingEndpoint(),
+
+		// Timeout options
+		streamIdleTimeout: lb.cfg.GetStreamIdleTimeout(),
 	}.build()
 	if err != nil {
 		log.Error().Err(err).Msgf("Error building inbound HTTP connection manager for proxy with identity %s and service %s", lb.serviceIdentity, svc)
@@ -75,14 +99,12 @@ func (lb *listenerBuilder) newIngressHTTPFilterChain(cfg configurator.Configurat
 			TransportProtocol: getIngressTransportProtocol(cfg.UseHTTPSIngress()),
 		},
 		TransportSocket: getIngressTransportSocket(cfg.UseHTTPSIngress(), marshalledDownstreamTLSContext),
-		Filters: []*xds_listener.Filter{
-			{
-				Name: wellknown.HTTPConnectionManager,
-				ConfigType: &xds_listener.Filter_TypedConfig{
-					TypedConfig: marshalledIngressConnManager,
-				},
+		Filters: append(filters, &xds_listener.Filter{
+			Name: wellknown.HTTPConnectionManager,
+			ConfigType: &xds_listener.Filter_TypedConfig{
+				TypedConfig: marshalledIngressConnManager,
 			},
-		},
+		}),
 	}
 }
 
@@ -99,7 +121,7 @@ func (lb *listenerBuilder) getIngressFilterChains(svc service.MeshService) []*xd
 	// Create protocol specific ingress filter chains per port to handle different ports serving different protocols
 	for port, appProtocol := range protocolToPortMap {
 		switch appProtocol {
-		case constants.ProtocolHTTP:
+		case constants.ProtocolHTTP, constants.ProtocolWebSocket:
 			// Ingress filter chain for HTTP port
 			if lb.cfg.UseHTTPSIngress() {
 				// Filter chain with SNI matching enabled for HTTPS clients that set the SNI
@@ -3,19 +3,27 @@ package lds
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	tassert "github.com/stretchr/testify/assert"
 
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 	"github.com/openservicemesh/osm/pkg/tests"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 func TestGetIngressFilterChains(t *testing.T) {
@@ -29,6 +37,11 @@ func TestGetIngressFilterChains(t *testing.T) {
 		httpsIngress         bool // true for https, false for http
 		svcPortToProtocolMap map[uint32]string
 		portToProtocolErr    error // error to return if port:protocol mapping returns an error
+		rateLimit            *policyv1alpha1.RateLimitSpec
+		denyRules            []*trafficpolicy.DenyRule
+
+		expectedLocalRateLimitFilter bool
+		expectedDenyRBACHTTPFilter   bool
 
 		expectedFilterChainCount               int
 		expectedFilterNamesPerFilterChain      []string
@@ -85,6 +98,56 @@ func TestGetIngressFilterChains(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Test case 3
+			name:                 "HTTP ingress filter chain for service with local rate limit",
+			httpsIngress:         false,
+			svcPortToProtocolMap: map[uint32]string{80: "http"},
+			portToProtocolErr:    nil,
+			rateLimit: &policyv1alpha1.RateLimitSpec{
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					HTTP: &policyv1alpha1.HTTPLocalRateLimitSpec{Requests: 10, Unit: "minute"},
+				},
+			},
+
+			expectedLocalRateLimitFilter:      true,
+			expectedFilterChainCount:          1,
+			expectedFilterNamesPerFilterChain: []string{wellknown.HTTPConnectionManager},
+			expectedFilterChainMatchPerFilterChain: []*xds_listener.FilterChainMatch{
+				{
+					DestinationPort:   &wrapperspb.UInt32Value{Value: 80},
+					TransportProtocol: "",
+				},
+			},
+		},
+		{
+			// Test case 4
+			name:                 "HTTP ingress filter chain for service with Deny policies",
+			httpsIngress:         false,
+			svcPortToProtocolMap: map[uint32]string{80: "http"},
+			portToProtocolErr:    nil,
+			denyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:    "ns/deny-all",
+					Sources: []identity.K8sServiceAccount{tests.BookbuyerServiceAccount},
+				},
+				{
+					Name:             "ns/deny-route",
+					Sources:          []identity.K8sServiceAccount{tests.BookbuyerServiceAccount},
+					HTTPRouteMatches: []trafficpolicy.HTTPRouteMatch{tests.BookstoreBuyHTTPRoute},
+				},
+			},
+
+			expectedDenyRBACHTTPFilter:        true,
+			expectedFilterChainCount:          1,
+			expectedFilterNamesPerFilterChain: []string{wellknown.RoleBasedAccessControl, wellknown.HTTPConnectionManager},
+			expectedFilterChainMatchPerFilterChain: []*xds_listener.FilterChainMatch{
+				{
+					DestinationPort:   &wrapperspb.UInt32Value{Value: 80},
+					TransportProtocol: "",
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -102,10 +165,20 @@ func TestGetIngressFilterChains(t *testing.T) {
 
 			// Mock catalog call to get port:protocol mapping for service
 			mockCatalog.EXPECT().GetTargetPortToProtocolMappingForService(proxyService).Return(tc.svcPortToProtocolMap, tc.portToProtocolErr).Times(1)
+			// Mock catalog call to determine if the CORS filter is required
+			mockCatalog.EXPECT().GetCORSPolicy(proxyService).Return(nil).AnyTimes()
+			// Mock catalog call to determine if the local rate limit filter is required
+			mockCatalog.EXPECT().GetRateLimitPolicy(proxyService).Return(tc.rateLimit).AnyTimes()
+			// Mock catalog call to get the Deny policies applied to the proxy
+			mockCatalog.EXPECT().ListInboundDenyRules(lb.serviceIdentity).Return(tc.denyRules).AnyTimes()
+			// Mock catalog call to get the HTTP upgrade types enabled on the ports
+			mockCatalog.EXPECT().GetHTTPUpgradeTypesForTargetPort(proxyService, gomock.Any()).Return(nil).AnyTimes()
 			// Mock configurator calls to determine HTTP vs HTTPS ingress
 			mockConfigurator.EXPECT().UseHTTPSIngress().Return(tc.httpsIngress).AnyTimes()
 			// Mock calls used to build the HTTP connection manager
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+			mockConfigurator.EXPECT().GetInboundGlobalRateLimitConfig().Return(ratelimit.GlobalRateLimitConfig{}).AnyTimes()
+			mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("some-endpoint").AnyTimes()
 			// Expect no External Auth config
 			mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
@@ -125,6 +198,23 @@ func TestGetIngressFilterChains(t *testing.T) {
 				for i, filter := range filterChain.Filters {
 					assert.Equal(tc.expectedFilterNamesPerFilterChain[i], filter.Name)
 				}
+
+				connManager := &xds_hcm.HttpConnectionManager{}
+				assert.Nil(ptypes.UnmarshalAny(filterChain.Filters[len(filterChain.Filters)-1].GetTypedConfig(), connManager))
+				var httpFilterNames []string
+				for _, httpFilter := range connManager.HttpFilters {
+					httpFilterNames = append(httpFilterNames, httpFilter.Name)
+				}
+				if tc.expectedLocalRateLimitFilter {
+					assert.Contains(httpFilterNames, envoy.HTTPLocalRateLimitFilterName)
+				} else {
+					assert.NotContains(httpFilterNames, envoy.HTTPLocalRateLimitFilterName)
+				}
+				if tc.expectedDenyRBACHTTPFilter {
+					assert.Contains(httpFilterNames, httpDenyRBACFilterName)
+				} else {
+					assert.NotContains(httpFilterNames, httpDenyRBACFilterName)
+				}
 				actualFilterChainMatchPerFilterChain = append(actualFilterChainMatchPerFilterChain, filterChain.FilterChainMatch)
 			}
 
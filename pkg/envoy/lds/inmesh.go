@@ -9,12 +9,14 @@ import (
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	xds_tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/envoy"
 	"github.com/openservicemesh/osm/pkg/envoy/rds/route"
@@ -44,7 +46,7 @@ func (lb *listenerBuilder) getInboundMeshFilterChains(proxyService service.MeshS
 	// Create protocol specific inbound filter chains per port to handle different ports serving different protocols
 	for port, appProtocol := range protocolToPortMap {
 		switch strings.ToLower(appProtocol) {
-		case constants.ProtocolHTTP, constants.ProtocolGRPC:
+		case constants.ProtocolHTTP, constants.ProtocolGRPC, constants.ProtocolWebSocket:
 			// Filter chain for HTTP port
 			filterChainForPort, err := lb.getInboundMeshHTTPFilterChain(proxyService, port)
 			if err != nil {
@@ -69,18 +71,30 @@ func (lb *listenerBuilder) getInboundMeshFilterChains(proxyService service.MeshS
 	return filterChains
 }
 
-func (lb *listenerBuilder) getInboundHTTPFilters(proxyService service.MeshService) ([]*xds_listener.Filter, error) {
+func (lb *listenerBuilder) getInboundHTTPFilters(proxyService service.MeshService, servicePort uint32) ([]*xds_listener.Filter, error) {
 	var filters []*xds_listener.Filter
 
-	// Apply an RBAC filter when permissive mode is disabled. The RBAC filter must be the first filter in the list of filters.
-	if !lb.cfg.IsPermissiveTrafficPolicyMode() {
-		// Apply RBAC policies on the inbound filters based on configured policies
-		rbacFilter, err := lb.buildRBACFilter()
-		if err != nil {
-			log.Error().Err(err).Msgf("Error applying RBAC filter for proxy service %s", proxyService)
-			return nil, err
-		}
-		// RBAC filter should be the very first filter in the filter chain
+	// Apply a deny RBAC filter when Deny policies apply to all the traffic of the proxy, regardless of the permissive mode.
+	// The deny RBAC filter must precede the RBAC filter so that the denied connections are rejected even if they are allowed.
+	denyRules := lb.meshCatalog.ListInboundDenyRules(lb.serviceIdentity)
+	denyRBACFilter, err := buildDenyRBACFilter(denyRules)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying deny RBAC filter for proxy service %s", proxyService)
+		return nil, err
+	}
+	if denyRBACFilter != nil {
+		filters = append(filters, denyRBACFilter)
+	}
+
+	// Apply an RBAC filter when permissive mode is disabled, or in permissive mode when TrafficTarget policies are evaluated
+	// in shadow mode. The RBAC filter must be the first filter in the list of filters following the deny RBAC filter.
+	rbacFilter, err := lb.buildRBACFilter(lb.meshCatalog.IsPermissiveTrafficPolicyMode(lb.serviceIdentity))
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying RBAC filter for proxy service %s", proxyService)
+		return nil, err
+	}
+	if rbacFilter != nil {
+		// RBAC filter should be the very first filter in the filter chain following the deny RBAC filter
 		filters = append(filters, rbacFilter)
 	}
 
@@ -90,12 +104,23 @@ func (lb *listenerBuilder) getInboundHTTPFilters(proxyService service.MeshServic
 		rdsRoutConfigName: route.InboundRouteConfigName,
 
 		// Additional filters
-		wasmStatsHeaders: lb.getWASMStatsHeaders(),
-		extAuthConfig:    lb.getExtAuthConfig(),
+		wasmStatsHeaders:      lb.getWASMStatsHeaders(),
+		extAuthConfig:         lb.getExtAuthConfig(),
+		localRateLimit:        isHTTPLocalRateLimitEnabled(lb.meshCatalog.GetRateLimitPolicy(proxyService)),
+		globalRateLimitConfig: lb.getGlobalRateLimitConfig(),
+		cors:                  lb.meshCatalog.GetCORSPolicy(proxyService) != nil,
+		requestAuthentication: lb.meshCatalog.GetRequestAuthenticationPolicy(proxyService),
+		denyRules:             denyRules,
+
+		// HTTP upgrade options
+		upgradeTypes: lb.meshCatalog.GetHTTPUpgradeTypesForTargetPort(proxyService, servicePort),
 
 		// Tracing options
 		enableTracing:      lb.cfg.IsTracingEnabled(),
 		tracingAPIEndpoint: lb.cfg.GetTracingEndpoint(),
+
+		// Timeout options
+		streamIdleTimeout: lb.cfg.GetStreamIdleTimeout(),
 	}.build()
 	if err != nil {
 		return nil, errors.Wrapf(err, "Error building inbound HTTP connection manager for proxy with identity %s and service %s", lb.serviceIdentity, proxyService)
@@ -118,7 +143,7 @@ func (lb *listenerBuilder) getInboundHTTPFilters(proxyService service.MeshServic
 
 func (lb *listenerBuilder) getInboundMeshHTTPFilterChain(proxyService service.MeshService, servicePort uint32) (*xds_listener.FilterChain, error) {
 	// Construct HTTP filters
-	filters, err := lb.getInboundHTTPFilters(proxyService)
+	filters, err := lb.getInboundHTTPFilters(proxyService, servicePort)
 	if err != nil {
 		log.Error().Err(err).Msgf("Error constructing inbound HTTP filters for proxy service %s", proxyService)
 		return nil, err
@@ -237,16 +262,38 @@ func (lb *listenerBuilder) getInboundMeshTCPFilterChain(proxyService service.Mes
 func (lb *listenerBuilder) getInboundTCPFilters(proxyService service.MeshService) ([]*xds_listener.Filter, error) {
 	var filters []*xds_listener.Filter
 
-	// Apply an RBAC filter when permissive mode is disabled. The RBAC filter must be the first filter in the list of filters.
-	if !lb.cfg.IsPermissiveTrafficPolicyMode() {
-		// Apply RBAC policies on the inbound filters based on configured policies
-		rbacFilter, err := lb.buildRBACFilter()
+	// Apply a deny RBAC filter when Deny policies apply to all the traffic of the proxy, regardless of the permissive mode.
+	// The deny RBAC filter must precede the RBAC filter so that the denied connections are rejected even if they are allowed.
+	denyRules := lb.meshCatalog.ListInboundDenyRules(lb.serviceIdentity)
+	denyRBACFilter, err := buildDenyRBACFilter(denyRules)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying deny RBAC filter for proxy service %s", proxyService)
+		return nil, err
+	}
+	if denyRBACFilter != nil {
+		filters = append(filters, denyRBACFilter)
+	}
+
+	// Apply an RBAC filter when permissive mode is disabled, or in permissive mode when TrafficTarget policies are evaluated
+	// in shadow mode. The RBAC filter must be the first filter in the list of filters following the deny RBAC filter.
+	rbacFilter, err := lb.buildRBACFilter(lb.meshCatalog.IsPermissiveTrafficPolicyMode(lb.serviceIdentity))
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying RBAC filter for proxy service %s", proxyService)
+		return nil, err
+	}
+	if rbacFilter != nil {
+		// RBAC filter should be the very first filter in the filter chain following the deny RBAC filter
+		filters = append(filters, rbacFilter)
+	}
+
+	// Apply the local rate limit filter when a local rate limit is configured for TCP connections to the service
+	if rateLimit := lb.meshCatalog.GetRateLimitPolicy(proxyService); rateLimit != nil && rateLimit.Local != nil && rateLimit.Local.TCP != nil {
+		localRateLimitFilter, err := getTCPLocalRateLimitFilter(rateLimit.Local.TCP, proxyService)
 		if err != nil {
-			log.Error().Err(err).Msgf("Error applying RBAC filter for proxy service %s", proxyService)
+			log.Error().Err(err).Msgf("Error applying local rate limit filter for proxy service %s", proxyService)
 			return nil, err
 		}
-		// RBAC filter should be the very first filter in the filter chain
-		filters = append(filters, rbacFilter)
+		filters = append(filters, localRateLimitFilter)
 	}
 
 	// Apply the TCP Proxy Filter
@@ -270,8 +317,9 @@ func (lb *listenerBuilder) getInboundTCPFilters(proxyService service.MeshService
 	return filters, nil
 }
 
-// getOutboundHTTPFilter returns an HTTP connection manager network filter used to filter outbound HTTP traffic for the given route configuration
-func (lb *listenerBuilder) getOutboundHTTPFilter(routeConfigName string) (*xds_listener.Filter, error) {
+// getOutboundHTTPFilter returns an HTTP connection manager network filter used to filter outbound HTTP traffic for the given route configuration,
+// with the given HTTP upgrade types enabled
+func (lb *listenerBuilder) getOutboundHTTPFilter(routeConfigName string, upgradeTypes []string) (*xds_listener.Filter, error) {
 	var marshalledFilter *any.Any
 	var err error
 
@@ -282,11 +330,18 @@ func (lb *listenerBuilder) getOutboundHTTPFilter(routeConfigName string) (*xds_l
 
 		// Additional filters
 		wasmStatsHeaders: lb.statsHeaders,
-		extAuthConfig:    nil, // Ext auth is not configured for outbound connections
+		extAuthConfig:    nil,  // Ext auth is not configured for outbound connections
+		faultInjection:   true, // Faults are injected per route based on FaultInjection policies
+
+		// HTTP upgrade options
+		upgradeTypes: upgradeTypes,
 
 		// Tracing options
 		enableTracing:      lb.cfg.IsTracingEnabled(),
 		tracingAPIEndpoint: lb.cfg.GetTracingEndpoint(),
+
+		// Timeout options
+		streamIdleTimeout: lb.cfg.GetStreamIdleTimeout(),
 	}.build()
 	if err != nil {
 		return nil, errors.Wrapf(err, "Error building outbound HTTP connection manager for proxy identity %s", lb.serviceIdentity)
@@ -355,7 +410,7 @@ func (lb *listenerBuilder) getOutboundFilterChainMatchForService(dstSvc service.
 
 func (lb *listenerBuilder) getOutboundHTTPFilterChainForService(upstream service.MeshService, port uint32) (*xds_listener.FilterChain, error) {
 	// Get HTTP filter for service
-	filter, err := lb.getOutboundHTTPFilter(route.OutboundRouteConfigName)
+	filter, err := lb.getOutboundHTTPFilter(route.OutboundRouteConfigName, lb.meshCatalog.GetHTTPUpgradeTypesForPort(upstream, port))
 	if err != nil {
 		log.Error().Err(err).Msgf("Error getting HTTP filter for upstream service %s", upstream)
 		return nil, err
@@ -425,6 +480,10 @@ func (lb *listenerBuilder) getOutboundTCPFilter(upstream service.MeshService) (*
 		}
 	}
 
+	if upstreamTrafficSetting := lb.meshCatalog.GetUpstreamTrafficSetting(upstream); upstreamTrafficSetting != nil {
+		tcpProxy.HashPolicy = getTCPHashPolicies(upstreamTrafficSetting.LoadBalancer)
+	}
+
 	marshalledTCPProxy, err := ptypes.MarshalAny(tcpProxy)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.ErrMarshallingXDSResource.String()).
@@ -438,6 +497,26 @@ func (lb *listenerBuilder) getOutboundTCPFilter(upstream service.MeshService) (*
 	}, nil
 }
 
+// getTCPHashPolicies returns the TCP proxy hash policies corresponding to the keys hashed by the given load balancer.
+// The source IP address of the client is the only key applicable to TCP connections, and hash policies are only
+// returned for consistent hash load balancers.
+func getTCPHashPolicies(loadBalancer *policyv1alpha1.LoadBalancerSpec) []*xds_type.HashPolicy {
+	if loadBalancer == nil || loadBalancer.ConsistentHash == nil || !loadBalancer.ConsistentHash.SourceIP {
+		return nil
+	}
+	if loadBalancer.Type != policyv1alpha1.RingHashLoadBalancer && loadBalancer.Type != policyv1alpha1.MaglevLoadBalancer {
+		return nil
+	}
+
+	return []*xds_type.HashPolicy{
+		{
+			PolicySpecifier: &xds_type.HashPolicy_SourceIp_{
+				SourceIp: &xds_type.HashPolicy_SourceIp{},
+			},
+		},
+	}
+}
+
 // getOutboundFilterChainPerUpstream returns a list of filter chains corresponding to upstream services
 func (lb *listenerBuilder) getOutboundFilterChainPerUpstream() []*xds_listener.FilterChain {
 	var filterChains []*xds_listener.FilterChain
@@ -461,7 +540,7 @@ func (lb *listenerBuilder) getOutboundFilterChainPerUpstream() []*xds_listener.F
 		// Create protocol specific inbound filter chains per port to handle different ports serving different protocols
 		for port, appProtocol := range protocolToPortMap {
 			switch strings.ToLower(appProtocol) {
-			case constants.ProtocolHTTP, constants.ProtocolGRPC:
+			case constants.ProtocolHTTP, constants.ProtocolGRPC, constants.ProtocolWebSocket:
 				// Construct HTTP filter chain
 				if httpFilterChain, err := lb.getOutboundHTTPFilterChainForService(upstreamSvc, port); err != nil {
 					log.Error().Err(err).Msgf("Error constructing outbound HTTP filter chain for upstream service %s on proxy with identity %s", upstreamSvc, lb.serviceIdentity)
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	xds_tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes"
@@ -15,13 +17,16 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/envoy"
 	"github.com/openservicemesh/osm/pkg/envoy/rds/route"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/tests"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
@@ -36,6 +41,7 @@ func TestGetOutboundHTTPFilterChainForService(t *testing.T) {
 
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+	mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
@@ -98,6 +104,7 @@ func TestGetOutboundHTTPFilterChainForService(t *testing.T) {
 			assert := tassert.New(t)
 
 			mockCatalog.EXPECT().GetResolvableServiceEndpoints(tests.BookstoreApexService).Return(tc.expectedEndpoints, nil)
+			mockCatalog.EXPECT().GetHTTPUpgradeTypesForPort(tests.BookstoreApexService, tc.servicePort).Return(nil).Times(1)
 			httpFilterChain, err := lb.getOutboundHTTPFilterChainForService(tests.BookstoreApexService, tc.servicePort)
 
 			assert.Equal(err != nil, tc.expectError)
@@ -178,6 +185,7 @@ func TestGetOutboundTCPFilterChainForService(t *testing.T) {
 
 			mockCatalog.EXPECT().GetResolvableServiceEndpoints(tests.BookstoreApexService).Return(tc.expectedEndpoints, nil)
 			mockCatalog.EXPECT().GetWeightedClustersForUpstream(tests.BookstoreApexService).Times(1)
+			mockCatalog.EXPECT().GetUpstreamTrafficSetting(tests.BookstoreApexService).Times(1)
 
 			tcpFilterChain, err := lb.getOutboundTCPFilterChainForService(tests.BookstoreApexService, tc.servicePort)
 
@@ -206,6 +214,8 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+	mockConfigurator.EXPECT().GetInboundGlobalRateLimitConfig().Return(ratelimit.GlobalRateLimitConfig{}).AnyTimes()
+	mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
@@ -228,6 +238,7 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 		name           string
 		permissiveMode bool
 		port           uint32
+		denyRules      []*trafficpolicy.DenyRule
 
 		expectedFilterChainMatch *xds_listener.FilterChainMatch
 		expectedFilterNames      []string
@@ -260,6 +271,26 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 			expectedFilterNames: []string{wellknown.HTTPConnectionManager},
 			expectError:         false,
 		},
+
+		{
+			name:           "inbound HTTP filter chain with permissive mode enabled and a Deny policy",
+			permissiveMode: true,
+			port:           100,
+			denyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:             "default/deny-all",
+					SourceNamespaces: []string{"ns-2"},
+				},
+			},
+			expectedFilterChainMatch: &xds_listener.FilterChainMatch{
+				DestinationPort:      &wrapperspb.UInt32Value{Value: 100},
+				ServerNames:          []string{proxyService.ServerName(), "bookbuyer.default.svc.cluster.cluster-x"},
+				TransportProtocol:    "tls",
+				ApplicationProtocols: []string{"osm"},
+			},
+			expectedFilterNames: []string{wellknown.RoleBasedAccessControl, wellknown.HTTPConnectionManager},
+			expectError:         false,
+		},
 	}
 
 	trafficTargets := []trafficpolicy.TrafficTargetWithRoutes{
@@ -278,10 +309,17 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
 			assert := tassert.New(t)
 
-			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).Times(1)
+			mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(lb.serviceIdentity).Return(tc.permissiveMode).Times(1)
+			mockCatalog.EXPECT().ListInboundDenyRules(lb.serviceIdentity).Return(tc.denyRules).Times(1)
+			mockCatalog.EXPECT().GetRateLimitPolicy(proxyService).Return(nil).Times(1)
+			mockCatalog.EXPECT().GetCORSPolicy(proxyService).Return(nil).Times(1)
+			mockCatalog.EXPECT().GetRequestAuthenticationPolicy(proxyService).Return(nil).Times(1)
+			mockCatalog.EXPECT().GetHTTPUpgradeTypesForTargetPort(proxyService, tc.port).Return(nil).Times(1)
+			// mock catalog calls used to build the RBAC filter, no traffic targets are returned in permissive mode
 			if !tc.permissiveMode {
-				// mock catalog calls used to build the RBAC filter
 				mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(lb.serviceIdentity).Return(trafficTargets, nil).Times(1)
+			} else {
+				mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(lb.serviceIdentity).Return(nil, nil).Times(1)
 			}
 
 			filterChain, err := lb.getInboundMeshHTTPFilterChain(proxyService, tc.port)
@@ -305,6 +343,7 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+	mockConfigurator.EXPECT().GetStreamIdleTimeout().Return(5 * time.Minute).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
@@ -327,6 +366,8 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 		name           string
 		permissiveMode bool
 		port           uint32
+		rateLimit      *policyv1alpha1.RateLimitSpec
+		denyRules      []*trafficpolicy.DenyRule
 
 		expectedFilterChainMatch *xds_listener.FilterChainMatch
 		expectedFilterNames      []string
@@ -359,6 +400,48 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 			expectedFilterNames: []string{wellknown.TCPProxy},
 			expectError:         false,
 		},
+
+		{
+			name:           "inbound TCP filter chain with local rate limit",
+			permissiveMode: false,
+			port:           100,
+			rateLimit: &policyv1alpha1.RateLimitSpec{
+				Host: "bookbuyer.default.svc.cluster.local",
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					TCP: &policyv1alpha1.TCPLocalRateLimitSpec{
+						Connections: 10,
+						Unit:        "second",
+					},
+				},
+			},
+			expectedFilterChainMatch: &xds_listener.FilterChainMatch{
+				DestinationPort:      &wrapperspb.UInt32Value{Value: 100},
+				ServerNames:          []string{proxyService.ServerName(), "bookbuyer.default.svc.cluster.cluster-x"},
+				TransportProtocol:    "tls",
+				ApplicationProtocols: []string{"osm"},
+			},
+			expectedFilterNames: []string{wellknown.RoleBasedAccessControl, envoy.TCPLocalRateLimitFilterName, wellknown.TCPProxy},
+			expectError:         false,
+		},
+		{
+			name:           "inbound TCP filter chain with permissive mode disabled and a Deny policy",
+			permissiveMode: false,
+			port:           110,
+			denyRules: []*trafficpolicy.DenyRule{
+				{
+					Name:    "default/deny-sa-2",
+					Sources: []identity.K8sServiceAccount{{Name: "sa-2", Namespace: "ns-2"}},
+				},
+			},
+			expectedFilterChainMatch: &xds_listener.FilterChainMatch{
+				DestinationPort:      &wrapperspb.UInt32Value{Value: 110},
+				ServerNames:          []string{proxyService.ServerName(), "bookbuyer.default.svc.cluster.cluster-x"},
+				TransportProtocol:    "tls",
+				ApplicationProtocols: []string{"osm"},
+			},
+			expectedFilterNames: []string{wellknown.RoleBasedAccessControl, wellknown.RoleBasedAccessControl, wellknown.TCPProxy},
+			expectError:         false,
+		},
 	}
 
 	trafficTargets := []trafficpolicy.TrafficTargetWithRoutes{
@@ -377,10 +460,14 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
 			assert := tassert.New(t)
 
-			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).Times(1)
+			mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(lb.serviceIdentity).Return(tc.permissiveMode).Times(1)
+			mockCatalog.EXPECT().ListInboundDenyRules(lb.serviceIdentity).Return(tc.denyRules).Times(1)
+			mockCatalog.EXPECT().GetRateLimitPolicy(proxyService).Return(tc.rateLimit).Times(1)
+			// mock catalog calls used to build the RBAC filter, no traffic targets are returned in permissive mode
 			if !tc.permissiveMode {
-				// mock catalog calls used to build the RBAC filter
 				mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(lb.serviceIdentity).Return(trafficTargets, nil).Times(1)
+			} else {
+				mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(lb.serviceIdentity).Return(nil, nil).Times(1)
 			}
 
 			filterChain, err := lb.getInboundMeshTCPFilterChain(proxyService, tc.port)
@@ -545,6 +632,7 @@ func TestGetOutboundTCPFilter(t *testing.T) {
 		name                   string
 		upstream               service.MeshService
 		clusterWeights         []service.WeightedCluster
+		upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSettingSpec
 		expectedTCPProxyConfig *xds_tcp_proxy.TcpProxy
 		expectError            bool
 	}
@@ -564,6 +652,33 @@ func TestGetOutboundTCPFilter(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "TCP filter for upstream with source IP consistent hash load balancing",
+			upstream: service.MeshService{
+				Name:          "foo",
+				Namespace:     "bar",
+				ClusterDomain: constants.LocalDomain,
+			},
+			clusterWeights: nil,
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSettingSpec{
+				LoadBalancer: &policyv1alpha1.LoadBalancerSpec{
+					Type:           policyv1alpha1.MaglevLoadBalancer,
+					ConsistentHash: &policyv1alpha1.ConsistentHashSpec{SourceIP: true},
+				},
+			},
+			expectedTCPProxyConfig: &xds_tcp_proxy.TcpProxy{
+				StatPrefix:       "outbound-mesh-tcp-proxy.bar/foo/local",
+				ClusterSpecifier: &xds_tcp_proxy.TcpProxy_Cluster{Cluster: "bar/foo/local"},
+				HashPolicy: []*xds_type.HashPolicy{
+					{
+						PolicySpecifier: &xds_type.HashPolicy_SourceIp_{
+							SourceIp: &xds_type.HashPolicy_SourceIp{},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
 		{
 			name: "TCP filter for upstream with matching traffic split policy",
 			upstream: service.MeshService{
@@ -608,6 +723,7 @@ func TestGetOutboundTCPFilter(t *testing.T) {
 			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
 
 			mockCatalog.EXPECT().GetWeightedClustersForUpstream(tc.upstream).Return(tc.clusterWeights).Times(1)
+			mockCatalog.EXPECT().GetUpstreamTrafficSetting(tc.upstream).Return(tc.upstreamTrafficSetting).Times(1)
 
 			lb := newListenerBuilder(mockCatalog, tests.BookbuyerServiceIdentity, mockConfigurator, nil)
 			filter, err := lb.getOutboundTCPFilter(tc.upstream)
@@ -623,6 +739,8 @@ func TestGetOutboundTCPFilter(t *testing.T) {
 			assert.Equal(tc.expectedTCPProxyConfig.ClusterSpecifier, actualConfig.ClusterSpecifier)
 
 			assert.Equal(tc.expectedTCPProxyConfig.StatPrefix, actualConfig.StatPrefix)
+
+			assert.Equal(tc.expectedTCPProxyConfig.HashPolicy, actualConfig.HashPolicy)
 		})
 	}
 }
@@ -638,6 +756,7 @@ func TestGetOutboundHTTPFilter(t *testing.T) {
 	}
 
 	mockConfigurator.EXPECT().IsTracingEnabled()
+	mockConfigurator.EXPECT().GetStreamIdleTimeout()
 	mockConfigurator.EXPECT().GetTracingEndpoint()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
@@ -646,7 +765,7 @@ func TestGetOutboundHTTPFilter(t *testing.T) {
 		EnableWASMStats: false,
 	}).AnyTimes()
 
-	filter, err := lb.getOutboundHTTPFilter(route.OutboundRouteConfigName)
+	filter, err := lb.getOutboundHTTPFilter(route.OutboundRouteConfigName, nil)
 	assert.NoError(err)
 	assert.Equal(filter.Name, wellknown.HTTPConnectionManager)
 }
@@ -0,0 +1,275 @@
+package lds
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	xds_lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+const (
+	// jwksFetchTimeout is the timeout to fetch a remote JSON Web Key Set
+	jwksFetchTimeout = 5 * time.Second
+
+	// jwtProviderNamePrefix is the prefix of the names of the JWT providers of the HTTP JWT authentication filter
+	jwtProviderNamePrefix = "jwt-provider"
+)
+
+// getJWTAuthnHTTPFilters returns the HTTP filters authenticating the JWTs of the requests as per the given
+// RequestAuthentication policy spec: the HTTP JWT authentication filter, followed by a Lua filter forwarding
+// the claims of the verified JWT as headers if claims to forward are specified.
+func getJWTAuthnHTTPFilters(requestAuthn *policyv1alpha1.RequestAuthenticationSpec) ([]*xds_hcm.HttpFilter, error) {
+	jwtAuthn, err := buildJWTAuthentication(requestAuthn)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalledJWTAuthn, err := ptypes.MarshalAny(jwtAuthn)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP JWT authentication filter")
+	}
+
+	filters := []*xds_hcm.HttpFilter{
+		{
+			Name: envoy.HTTPJWTAuthnFilterName,
+			ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+				TypedConfig: marshalledJWTAuthn,
+			},
+		},
+	}
+
+	claimToHeadersFilter, err := getJWTClaimToHeadersFilter(requestAuthn)
+	if err != nil {
+		return nil, err
+	}
+	if claimToHeadersFilter != nil {
+		filters = append(filters, claimToHeadersFilter)
+	}
+
+	return filters, nil
+}
+
+// buildJWTAuthentication returns the HTTP JWT authentication filter config for the given RequestAuthentication policy spec.
+// Each JWT rule is configured as a JWT provider, and a request is authenticated if its JWT is verified by any of the providers.
+func buildJWTAuthentication(requestAuthn *policyv1alpha1.RequestAuthenticationSpec) (*xds_jwt.JwtAuthentication, error) {
+	if len(requestAuthn.JWTRules) == 0 {
+		return nil, errors.New("No JWT rules specified")
+	}
+
+	jwtAuthn := &xds_jwt.JwtAuthentication{
+		Providers: make(map[string]*xds_jwt.JwtProvider),
+		// CORS preflight requests do not carry credentials
+		BypassCorsPreflight: true,
+	}
+
+	var requirements []*xds_jwt.JwtRequirement
+	for i, rule := range requestAuthn.JWTRules {
+		provider, err := buildJWTProvider(rule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error building JWT provider for issuer %s", rule.Issuer)
+		}
+
+		providerName := fmt.Sprintf("%s-%d", jwtProviderNamePrefix, i)
+		jwtAuthn.Providers[providerName] = provider
+		requirements = append(requirements, &xds_jwt.JwtRequirement{
+			RequiresType: &xds_jwt.JwtRequirement_ProviderName{ProviderName: providerName},
+		})
+	}
+
+	if requestAuthn.AllowMissing {
+		requirements = append(requirements, &xds_jwt.JwtRequirement{
+			RequiresType: &xds_jwt.JwtRequirement_AllowMissing{AllowMissing: &emptypb.Empty{}},
+		})
+	}
+
+	// RequiresAny requires at least 2 requirements
+	requirement := requirements[0]
+	if len(requirements) > 1 {
+		requirement = &xds_jwt.JwtRequirement{
+			RequiresType: &xds_jwt.JwtRequirement_RequiresAny{
+				RequiresAny: &xds_jwt.JwtRequirementOrList{Requirements: requirements},
+			},
+		}
+	}
+
+	jwtAuthn.Rules = []*xds_jwt.RequirementRule{
+		{
+			Match: &xds_route.RouteMatch{
+				PathSpecifier: &xds_route.RouteMatch_Prefix{Prefix: "/"},
+			},
+			RequirementType: &xds_jwt.RequirementRule_Requires{Requires: requirement},
+		},
+	}
+
+	return jwtAuthn, nil
+}
+
+// buildJWTProvider returns the JWT provider for the given JWT rule
+func buildJWTProvider(rule policyv1alpha1.JWTRule) (*xds_jwt.JwtProvider, error) {
+	provider := &xds_jwt.JwtProvider{
+		Issuer:     rule.Issuer,
+		Audiences:  rule.Audiences,
+		Forward:    rule.ForwardOriginalToken,
+		FromParams: rule.FromParams,
+		// The payload of the verified JWT is stored in the dynamic metadata to forward its claims and authorize requests on them
+		PayloadInMetadata: envoy.JWTPayloadMetadataKey,
+	}
+
+	for _, header := range rule.FromHeaders {
+		provider.FromHeaders = append(provider.FromHeaders, &xds_jwt.JwtHeader{
+			Name:        header.Name,
+			ValuePrefix: header.Prefix,
+		})
+	}
+
+	switch {
+	case rule.JWKS != "" && rule.JWKSURI != "":
+		return nil, errors.New("Only one of JWKS or JWKSURI must be specified")
+
+	case rule.JWKS != "":
+		provider.JwksSourceSpecifier = &xds_jwt.JwtProvider_LocalJwks{
+			LocalJwks: &xds_core.DataSource{
+				Specifier: &xds_core.DataSource_InlineString{InlineString: rule.JWKS},
+			},
+		}
+
+	case rule.JWKSURI != "":
+		clusterName, err := getJWKSClusterName(rule.JWKSURI)
+		if err != nil {
+			return nil, err
+		}
+		provider.JwksSourceSpecifier = &xds_jwt.JwtProvider_RemoteJwks{
+			RemoteJwks: &xds_jwt.RemoteJwks{
+				HttpUri: &xds_core.HttpUri{
+					Uri:              rule.JWKSURI,
+					HttpUpstreamType: &xds_core.HttpUri_Cluster{Cluster: clusterName},
+					Timeout:          ptypes.DurationProto(jwksFetchTimeout),
+				},
+			},
+		}
+
+	default:
+		return nil, errors.New("One of JWKS or JWKSURI must be specified")
+	}
+
+	return provider, nil
+}
+
+// getJWKSClusterName returns the name of the cluster the proxy fetches the JSON Web Key Set at the given URL from.
+// A host of the form <service>.<namespace>[.svc[.cluster.local]] refers to a meshed service, whose cluster is
+// programmed on the proxy if it is allowed to access the service. Other hosts are fetched from the egress cluster
+// of the host, programmed on the proxy if an Egress policy allows it to access the host and port over HTTP.
+// Only http URLs are supported, as neither of these clusters originates TLS to the host serving the JSON Web Key Set.
+func getJWKSClusterName(jwksURI string) (string, error) {
+	u, err := url.Parse(jwksURI)
+	if err != nil {
+		return "", errors.Wrapf(err, "Invalid JWKS URI %s", jwksURI)
+	}
+	if u.Scheme != "http" {
+		return "", errors.Errorf("Invalid JWKS URI %s: unsupported scheme %q, only http is supported", jwksURI, u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return "", errors.Errorf("Invalid JWKS URI %s: host unspecified", jwksURI)
+	}
+
+	if meshSvc, ok := getMeshServiceFromHost(u.Hostname()); ok {
+		return meshSvc.String(), nil
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "80"
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", errors.Errorf("Invalid JWKS URI %s: invalid port %s", jwksURI, port)
+	}
+
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// getMeshServiceFromHost returns the meshed service referred to by the given host of the form
+// <service>.<namespace>[.svc[.cluster.local]], and a boolean indicating if the host refers to a meshed service
+func getMeshServiceFromHost(host string) (service.MeshService, bool) {
+	parts := strings.Split(host, ".")
+	switch {
+	case len(parts) == 2,
+		len(parts) == 3 && parts[2] == "svc",
+		len(parts) == 5 && strings.Join(parts[2:], ".") == "svc.cluster.local":
+		return service.MeshService{
+			Name:          parts[0],
+			Namespace:     parts[1],
+			ClusterDomain: constants.LocalDomain,
+		}, true
+
+	default:
+		return service.MeshService{}, false
+	}
+}
+
+// getJWTClaimToHeadersFilter returns the Lua filter setting the headers to the claims of the verified JWT, as per
+// the given RequestAuthentication policy spec. The headers are removed from the requests first, so that they cannot
+// be spoofed by the requests without a verified JWT. Returns nil if no claims to forward are specified.
+func getJWTClaimToHeadersFilter(requestAuthn *policyv1alpha1.RequestAuthenticationSpec) (*xds_hcm.HttpFilter, error) {
+	var claimToHeaders []policyv1alpha1.ClaimToHeader
+	for _, rule := range requestAuthn.JWTRules {
+		claimToHeaders = append(claimToHeaders, rule.OutputClaimToHeaders...)
+	}
+	if len(claimToHeaders) == 0 {
+		return nil, nil
+	}
+
+	code := &strings.Builder{}
+	code.WriteString("--\nlocal function claim_value(value)\n")
+	code.WriteString("  if type(value) == \"table\" then\n    return table.concat(value, \",\")\n  end\n")
+	code.WriteString("  return tostring(value)\nend\n")
+	code.WriteString("function envoy_on_request(request_handle)\n")
+	removedHeaders := make(map[string]bool)
+	for _, claimToHeader := range claimToHeaders {
+		header := strings.ToLower(claimToHeader.Header)
+		if removedHeaders[header] {
+			continue
+		}
+		removedHeaders[header] = true
+		code.WriteString(fmt.Sprintf("  request_handle:headers():remove(%q)\n", header))
+	}
+	code.WriteString(fmt.Sprintf("  local metadata = request_handle:streamInfo():dynamicMetadata():get(%q)\n", envoy.HTTPJWTAuthnFilterName))
+	code.WriteString(fmt.Sprintf("  if metadata == nil or metadata[%q] == nil then\n    return\n  end\n", envoy.JWTPayloadMetadataKey))
+	code.WriteString(fmt.Sprintf("  local payload = metadata[%q]\n", envoy.JWTPayloadMetadataKey))
+	for _, claimToHeader := range claimToHeaders {
+		code.WriteString(fmt.Sprintf("  if payload[%q] ~= nil then\n", claimToHeader.Claim))
+		code.WriteString(fmt.Sprintf("    request_handle:headers():replace(%q, claim_value(payload[%q]))\n", strings.ToLower(claimToHeader.Header), claimToHeader.Claim))
+		code.WriteString("  end\n")
+	}
+	code.WriteString("end")
+
+	luaAny, err := ptypes.MarshalAny(&xds_lua.Lua{
+		InlineCode: code.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling JWT claim to headers Lua filter")
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: wellknown.Lua,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: luaAny,
+		},
+	}, nil
+}
@@ -0,0 +1,183 @@
+package lds
+
+import (
+	"testing"
+
+	xds_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	xds_lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/envoy"
+)
+
+func TestBuildJWTAuthentication(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		requestAuthn         *policyv1alpha1.RequestAuthenticationSpec
+		expectErr            bool
+		expectedProviders    int
+		expectedRequirements int // 0 when the requirement is a single provider
+	}{
+		{
+			name: "single JWT rule",
+			requestAuthn: &policyv1alpha1.RequestAuthenticationSpec{
+				JWTRules: []policyv1alpha1.JWTRule{
+					{Issuer: "https://issuer.example.com", JWKS: `{"keys": []}`},
+				},
+			},
+			expectedProviders: 1,
+		},
+		{
+			name: "single JWT rule allowing missing JWTs",
+			requestAuthn: &policyv1alpha1.RequestAuthenticationSpec{
+				JWTRules: []policyv1alpha1.JWTRule{
+					{Issuer: "https://issuer.example.com", JWKS: `{"keys": []}`},
+				},
+				AllowMissing: true,
+			},
+			expectedProviders:    1,
+			expectedRequirements: 2,
+		},
+		{
+			name: "multiple JWT rules",
+			requestAuthn: &policyv1alpha1.RequestAuthenticationSpec{
+				JWTRules: []policyv1alpha1.JWTRule{
+					{Issuer: "https://a.example.com", JWKS: `{"keys": []}`},
+					{Issuer: "https://b.example.com", JWKSURI: "http://jwks.auth/keys"},
+				},
+			},
+			expectedProviders:    2,
+			expectedRequirements: 2,
+		},
+		{
+			name:         "no JWT rules",
+			requestAuthn: &policyv1alpha1.RequestAuthenticationSpec{},
+			expectErr:    true,
+		},
+		{
+			name: "JWT rule without JWKS",
+			requestAuthn: &policyv1alpha1.RequestAuthenticationSpec{
+				JWTRules: []policyv1alpha1.JWTRule{{Issuer: "https://issuer.example.com"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual, err := buildJWTAuthentication(tc.requestAuthn)
+			assert.Equal(tc.expectErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			assert.Len(actual.Providers, tc.expectedProviders)
+			assert.True(actual.BypassCorsPreflight)
+			assert.Len(actual.Rules, 1)
+			assert.Equal("/", actual.Rules[0].Match.GetPrefix())
+
+			requirement := actual.Rules[0].GetRequires()
+			if tc.expectedRequirements == 0 {
+				assert.Equal("jwt-provider-0", requirement.GetProviderName())
+			} else {
+				assert.Len(requirement.GetRequiresAny().Requirements, tc.expectedRequirements)
+			}
+		})
+	}
+}
+
+func TestBuildJWTProvider(t *testing.T) {
+	assert := tassert.New(t)
+
+	provider, err := buildJWTProvider(policyv1alpha1.JWTRule{
+		Issuer:               "https://issuer.example.com",
+		Audiences:            []string{"bookstore"},
+		JWKSURI:              "http://issuer.example.com/.well-known/jwks.json",
+		FromHeaders:          []policyv1alpha1.JWTHeader{{Name: "x-jwt", Prefix: "Bearer "}},
+		FromParams:           []string{"token"},
+		ForwardOriginalToken: true,
+	})
+	assert.Nil(err)
+	assert.Equal("https://issuer.example.com", provider.Issuer)
+	assert.Equal([]string{"bookstore"}, provider.Audiences)
+	assert.True(provider.Forward)
+	assert.Equal([]*xds_jwt.JwtHeader{{Name: "x-jwt", ValuePrefix: "Bearer "}}, provider.FromHeaders)
+	assert.Equal([]string{"token"}, provider.FromParams)
+	assert.Equal(envoy.JWTPayloadMetadataKey, provider.PayloadInMetadata)
+	assert.Equal("issuer.example.com:80", provider.GetRemoteJwks().HttpUri.GetCluster())
+
+	provider, err = buildJWTProvider(policyv1alpha1.JWTRule{Issuer: "https://issuer.example.com", JWKS: `{"keys": []}`})
+	assert.Nil(err)
+	assert.Equal(`{"keys": []}`, provider.GetLocalJwks().GetInlineString())
+
+	_, err = buildJWTProvider(policyv1alpha1.JWTRule{Issuer: "https://issuer.example.com", JWKS: `{"keys": []}`, JWKSURI: "http://jwks.auth/keys"})
+	assert.NotNil(err)
+
+	_, err = buildJWTProvider(policyv1alpha1.JWTRule{Issuer: "https://issuer.example.com", JWKSURI: "https://issuer.example.com/.well-known/jwks.json"})
+	assert.NotNil(err)
+}
+
+func TestGetJWKSClusterName(t *testing.T) {
+	testCases := []struct {
+		jwksURI             string
+		expectedClusterName string
+		expectErr           bool
+	}{
+		{jwksURI: "http://jwks.auth/keys", expectedClusterName: "auth/jwks/local"},
+		{jwksURI: "http://jwks.auth.svc:8080/keys", expectedClusterName: "auth/jwks/local"},
+		{jwksURI: "http://jwks.auth.svc.cluster.local/keys", expectedClusterName: "auth/jwks/local"},
+		{jwksURI: "http://issuer.example.com/keys", expectedClusterName: "issuer.example.com:80"},
+		{jwksURI: "https://issuer.example.com/keys", expectErr: true},
+		{jwksURI: "https://jwks.auth/keys", expectErr: true},
+		{jwksURI: "http://issuer.example.com:8080/keys", expectedClusterName: "issuer.example.com:8080"},
+		{jwksURI: "ftp://issuer.example.com/keys", expectErr: true},
+		{jwksURI: "/keys", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.jwksURI, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual, err := getJWKSClusterName(tc.jwksURI)
+			assert.Equal(tc.expectErr, err != nil)
+			assert.Equal(tc.expectedClusterName, actual)
+		})
+	}
+}
+
+func TestGetJWTClaimToHeadersFilter(t *testing.T) {
+	assert := tassert.New(t)
+
+	filter, err := getJWTClaimToHeadersFilter(&policyv1alpha1.RequestAuthenticationSpec{
+		JWTRules: []policyv1alpha1.JWTRule{{Issuer: "https://issuer.example.com"}},
+	})
+	assert.Nil(err)
+	assert.Nil(filter)
+
+	filter, err = getJWTClaimToHeadersFilter(&policyv1alpha1.RequestAuthenticationSpec{
+		JWTRules: []policyv1alpha1.JWTRule{
+			{
+				Issuer:               "https://a.example.com",
+				OutputClaimToHeaders: []policyv1alpha1.ClaimToHeader{{Header: "X-JWT-Sub", Claim: "sub"}},
+			},
+			{
+				Issuer:               "https://b.example.com",
+				OutputClaimToHeaders: []policyv1alpha1.ClaimToHeader{{Header: "x-jwt-sub", Claim: "sub"}, {Header: "x-jwt-groups", Claim: "groups"}},
+			},
+		},
+	})
+	assert.Nil(err)
+	assert.Equal(wellknown.Lua, filter.Name)
+
+	lua := &xds_lua.Lua{}
+	assert.Nil(ptypes.UnmarshalAny(filter.GetTypedConfig(), lua))
+	assert.Contains(lua.InlineCode, `request_handle:headers():remove("x-jwt-sub")`)
+	assert.Contains(lua.InlineCode, `request_handle:headers():remove("x-jwt-groups")`)
+	assert.Contains(lua.InlineCode, `dynamicMetadata():get("envoy.filters.http.jwt_authn")`)
+	assert.Contains(lua.InlineCode, `request_handle:headers():replace("x-jwt-groups", claim_value(payload["groups"]))`)
+}
@@ -0,0 +1,116 @@
+package lds
+
+import (
+	"fmt"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	xds_ratelimit "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	xds_http_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	xds_http_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	xds_tcp_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+const (
+	inboundTCPLocalRateLimitStatPrefix = "inbound-tcp-local-rate-limit"
+)
+
+func (lb *listenerBuilder) getGlobalRateLimitConfig() *ratelimit.GlobalRateLimitConfig {
+	globalRateLimitConfig := lb.cfg.GetInboundGlobalRateLimitConfig()
+	if globalRateLimitConfig.Enable {
+		return &globalRateLimitConfig
+	}
+	return nil
+}
+
+// isHTTPLocalRateLimitEnabled returns true if the given rate limit policy defines local rate limits for HTTP requests
+func isHTTPLocalRateLimitEnabled(rateLimit *policyv1alpha1.RateLimitSpec) bool {
+	return rateLimit != nil && rateLimit.Local != nil && (rateLimit.Local.HTTP != nil || len(rateLimit.Local.HTTPRoutes) > 0)
+}
+
+// getHTTPLocalRateLimitFilter returns the HTTP local rate limit filter. The filter does not rate limit requests
+// by itself, the rate limits are configured per virtual host and route in the inbound route configuration.
+func getHTTPLocalRateLimitFilter() (*xds_hcm.HttpFilter, error) {
+	localRateLimit := &xds_http_local_ratelimit.LocalRateLimit{
+		StatPrefix: envoy.HTTPLocalRateLimitStatPrefix,
+	}
+
+	marshalledLocalRateLimit, err := ptypes.MarshalAny(localRateLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP local rate limit filter")
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: envoy.HTTPLocalRateLimitFilterName,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledLocalRateLimit,
+		},
+	}, nil
+}
+
+// getTCPLocalRateLimitFilter returns the network local rate limit filter that limits the rate of
+// TCP connections to the given proxy service
+func getTCPLocalRateLimitFilter(rateLimit *policyv1alpha1.TCPLocalRateLimitSpec, proxyService service.MeshService) (*xds_listener.Filter, error) {
+	tokenBucket, err := envoy.GetLocalRateLimitTokenBucket(rateLimit.Connections, rateLimit.Burst, rateLimit.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	localRateLimit := &xds_tcp_local_ratelimit.LocalRateLimit{
+		StatPrefix:  fmt.Sprintf("%s.%s", inboundTCPLocalRateLimitStatPrefix, envoy.GetLocalClusterNameForService(proxyService)),
+		TokenBucket: tokenBucket,
+	}
+
+	marshalledLocalRateLimit, err := ptypes.MarshalAny(localRateLimit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error marshalling TCP local rate limit filter for proxy service %s", proxyService)
+	}
+
+	return &xds_listener.Filter{
+		Name:       envoy.TCPLocalRateLimitFilterName,
+		ConfigType: &xds_listener.Filter_TypedConfig{TypedConfig: marshalledLocalRateLimit},
+	}, nil
+}
+
+// getGlobalRateLimitHTTPFilter returns the HTTP rate limit filter that calls the rate limit service to rate limit requests.
+// The descriptors sent to the rate limit service are configured per virtual host in the route configuration.
+func getGlobalRateLimitHTTPFilter(globalRateLimitConfig *ratelimit.GlobalRateLimitConfig) (*xds_hcm.HttpFilter, error) {
+	globalRateLimit := &xds_http_ratelimit.RateLimit{
+		Domain:          globalRateLimitConfig.Domain,
+		Timeout:         ptypes.DurationProto(globalRateLimitConfig.Timeout),
+		FailureModeDeny: globalRateLimitConfig.FailureModeDeny,
+		RateLimitService: &xds_ratelimit.RateLimitServiceConfig{
+			GrpcService: &xds_core.GrpcService{
+				TargetSpecifier: &xds_core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &xds_core.GrpcService_EnvoyGrpc{
+						ClusterName: constants.EnvoyGlobalRateLimitCluster,
+					},
+				},
+				Timeout: ptypes.DurationProto(globalRateLimitConfig.Timeout),
+			},
+			TransportApiVersion: xds_core.ApiVersion_V3,
+		},
+	}
+
+	marshalledGlobalRateLimit, err := ptypes.MarshalAny(globalRateLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP global rate limit filter")
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: wellknown.HTTPRateLimit,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledGlobalRateLimit,
+		},
+	}, nil
+}
@@ -0,0 +1,87 @@
+package lds
+
+import (
+	"testing"
+	"time"
+
+	xds_http_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+)
+
+func TestGetGlobalRateLimitConfig(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		globalRateLimitConfig ratelimit.GlobalRateLimitConfig
+		expected              *ratelimit.GlobalRateLimitConfig
+	}{
+		{
+			name: "global rate limiting is disabled",
+			globalRateLimitConfig: ratelimit.GlobalRateLimitConfig{
+				Enable:  false,
+				Address: "ratelimit.xyz",
+				Port:    8081,
+			},
+			expected: nil,
+		},
+		{
+			name: "global rate limiting is enabled",
+			globalRateLimitConfig: ratelimit.GlobalRateLimitConfig{
+				Enable:  true,
+				Address: "ratelimit.xyz",
+				Port:    8081,
+			},
+			expected: &ratelimit.GlobalRateLimitConfig{
+				Enable:  true,
+				Address: "ratelimit.xyz",
+				Port:    8081,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			lb := &listenerBuilder{
+				cfg: mockConfigurator,
+			}
+
+			mockConfigurator.EXPECT().GetInboundGlobalRateLimitConfig().Return(tc.globalRateLimitConfig).Times(1)
+
+			actual := lb.getGlobalRateLimitConfig()
+			a.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestGetGlobalRateLimitHTTPFilter(t *testing.T) {
+	a := assert.New(t)
+
+	filter, err := getGlobalRateLimitHTTPFilter(&ratelimit.GlobalRateLimitConfig{
+		Enable:          true,
+		Address:         "ratelimit.xyz",
+		Port:            8081,
+		Domain:          "osm",
+		Timeout:         100 * time.Millisecond,
+		FailureModeDeny: true,
+	})
+	a.Nil(err)
+	a.Equal(wellknown.HTTPRateLimit, filter.Name)
+
+	globalRateLimit := &xds_http_ratelimit.RateLimit{}
+	a.Nil(ptypes.UnmarshalAny(filter.GetTypedConfig(), globalRateLimit))
+	a.Equal("osm", globalRateLimit.Domain)
+	a.True(globalRateLimit.FailureModeDeny)
+	a.Equal(100*time.Millisecond, globalRateLimit.Timeout.AsDuration())
+	a.Equal(constants.EnvoyGlobalRateLimitCluster, globalRateLimit.RateLimitService.GrpcService.GetEnvoyGrpc().ClusterName)
+}
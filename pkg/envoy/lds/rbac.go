@@ -17,12 +17,17 @@ import (
 
 // buildRBACFilter builds an RBAC filter based on SMI TrafficTarget policies.
 // The returned RBAC filter has policies that gives downstream principals full access to the local service.
-func (lb *listenerBuilder) buildRBACFilter() (*xds_listener.Filter, error) {
-	networkRBACPolicy, err := lb.buildInboundRBACPolicies()
+// In permissive mode, the policies are not enforced and the RBAC filter is only built to evaluate the TrafficTarget
+// policies in shadow mode, nil is returned if there are none.
+func (lb *listenerBuilder) buildRBACFilter(permissiveMode bool) (*xds_listener.Filter, error) {
+	networkRBACPolicy, err := lb.buildInboundRBACPolicies(permissiveMode)
 	if err != nil {
 		log.Error().Err(err).Msgf("Error building inbound RBAC policies for principal %q", lb.serviceIdentity)
 		return nil, err
 	}
+	if networkRBACPolicy == nil {
+		return nil, nil
+	}
 
 	marshalledNetworkRBACPolicy, err := ptypes.MarshalAny(networkRBACPolicy)
 	if err != nil {
@@ -39,8 +44,11 @@ func (lb *listenerBuilder) buildRBACFilter() (*xds_listener.Filter, error) {
 	return rbacFilter, nil
 }
 
-// buildInboundRBACPolicies builds the RBAC policies based on allowed principals
-func (lb *listenerBuilder) buildInboundRBACPolicies() (*xds_network_rbac.RBAC, error) {
+// buildInboundRBACPolicies builds the RBAC policies based on allowed principals.
+// The TrafficTarget policies in shadow mode are evaluated in the shadow rules, along with the enforced TrafficTarget
+// policies, such that the connections that would be denied if they were enforced are reported in the stats.
+// The enforced rules are omitted in permissive mode, nil is returned if there are no shadow rules either.
+func (lb *listenerBuilder) buildInboundRBACPolicies(permissiveMode bool) (*xds_network_rbac.RBAC, error) {
 	proxyIdentity := identity.ServiceIdentity(lb.serviceIdentity.String())
 	trafficTargets, err := lb.meshCatalog.ListInboundTrafficTargetsWithRoutes(lb.serviceIdentity)
 	if err != nil {
@@ -50,25 +58,51 @@ func (lb *listenerBuilder) buildInboundRBACPolicies() (*xds_network_rbac.RBAC, e
 	}
 
 	rbacPolicies := make(map[string]*xds_rbac.Policy)
+	shadowRBACPolicies := make(map[string]*xds_rbac.Policy)
+	hasShadowTrafficTargets := false
 	// Build an RBAC policies based on SMI TrafficTarget policies
 	for _, targetPolicy := range trafficTargets {
-		if policy, err := buildRBACPolicyFromTrafficTarget(targetPolicy); err != nil {
+		policy, err := buildRBACPolicyFromTrafficTarget(targetPolicy)
+		if err != nil {
 			log.Error().Err(err).Str(errcode.Kind, errcode.ErrBuildingRBACPolicy.String()).
 				Msgf("Error building RBAC policy for proxy identity %s from TrafficTarget %s", proxyIdentity, targetPolicy.Name)
+			continue
+		}
+
+		if targetPolicy.Shadow {
+			hasShadowTrafficTargets = true
 		} else {
 			rbacPolicies[targetPolicy.Name] = policy
 		}
+		shadowRBACPolicies[targetPolicy.Name] = policy
+	}
+
+	if permissiveMode && !hasShadowTrafficTargets {
+		return nil, nil
 	}
 
 	log.Debug().Msgf("RBAC policy for proxy with identity %s: %+v", proxyIdentity, rbacPolicies)
 
-	// Create an inbound RBAC policy that denies a request by default, unless a policy explicitly allows it
 	networkRBACPolicy := &xds_network_rbac.RBAC{
 		StatPrefix: "network-", // will be displayed as network-rbac.<path>
-		Rules: &xds_rbac.RBAC{
+	}
+
+	if !permissiveMode {
+		// Create an inbound RBAC policy that denies a request by default, unless a policy explicitly allows it
+		networkRBACPolicy.Rules = &xds_rbac.RBAC{
 			Action:   xds_rbac.RBAC_ALLOW, // Allows the request if and only if there is a policy that matches the request
 			Policies: rbacPolicies,
-		},
+		}
+	}
+
+	if hasShadowTrafficTargets {
+		log.Debug().Msgf("Shadow RBAC policy for proxy with identity %s: %+v", proxyIdentity, shadowRBACPolicies)
+
+		// The shadow rules are evaluated without being enforced, and reported as network-rbac.shadow_allowed/shadow_denied
+		networkRBACPolicy.ShadowRules = &xds_rbac.RBAC{
+			Action:   xds_rbac.RBAC_ALLOW,
+			Policies: shadowRBACPolicies,
+		}
 	}
 
 	return networkRBACPolicy, nil
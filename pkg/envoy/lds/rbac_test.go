@@ -221,7 +221,7 @@ func TestBuildInboundRBACPolicies(t *testing.T) {
 			mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(proxySvcAccount.ToServiceIdentity()).Return(tc.trafficTargets, nil).Times(1)
 
 			// Test the RBAC policies
-			policy, err := lb.buildInboundRBACPolicies()
+			policy, err := lb.buildInboundRBACPolicies(false)
 
 			assert.Equal(tc.expectErr, err != nil)
 			assert.Equal(xds_rbac.RBAC_ALLOW, policy.Rules.Action)
@@ -304,10 +304,96 @@ func TestBuildRBACFilter(t *testing.T) {
 			// Mock catalog calls
 			mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(proxySvcAccount).Return(tc.trafficTargets, nil).Times(1)
 
-			rbacFilter, err := lb.buildRBACFilter()
+			rbacFilter, err := lb.buildRBACFilter(false)
 			assert.Equal(err != nil, tc.expectErr)
 
 			assert.Equal(rbacFilter.Name, wellknown.RoleBasedAccessControl)
 		})
 	}
 }
+
+func TestBuildInboundRBACPoliciesInShadowMode(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCatalog := catalog.NewMockMeshCataloger(mockCtrl)
+	proxySvcAccount := identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}
+
+	lb := &listenerBuilder{
+		meshCatalog:     mockCatalog,
+		serviceIdentity: proxySvcAccount.ToServiceIdentity(),
+	}
+
+	enforcedTrafficTarget := trafficpolicy.TrafficTargetWithRoutes{
+		Name:        "ns-1/enforced",
+		Destination: identity.ServiceIdentity("sa-1.ns-1.cluster.local"),
+		Sources:     []identity.ServiceIdentity{identity.ServiceIdentity("sa-2.ns-2.cluster.local")},
+	}
+	shadowTrafficTarget := trafficpolicy.TrafficTargetWithRoutes{
+		Name:        "ns-1/shadow",
+		Destination: identity.ServiceIdentity("sa-1.ns-1.cluster.local"),
+		Sources:     []identity.ServiceIdentity{identity.ServiceIdentity("sa-3.ns-3.cluster.local")},
+		Shadow:      true,
+	}
+
+	testCases := []struct {
+		name           string
+		permissiveMode bool
+		trafficTargets []trafficpolicy.TrafficTargetWithRoutes
+
+		expectNilPolicy          bool
+		expectedPolicyKeys       []string // nil when the enforced rules are omitted
+		expectedShadowPolicyKeys []string // nil when the shadow rules are omitted
+	}{
+		{
+			name:                     "enforced and shadow traffic targets with permissive mode disabled",
+			permissiveMode:           false,
+			trafficTargets:           []trafficpolicy.TrafficTargetWithRoutes{enforcedTrafficTarget, shadowTrafficTarget},
+			expectedPolicyKeys:       []string{"ns-1/enforced"},
+			expectedShadowPolicyKeys: []string{"ns-1/enforced", "ns-1/shadow"},
+		},
+		{
+			name:                     "shadow traffic targets with permissive mode enabled",
+			permissiveMode:           true,
+			trafficTargets:           []trafficpolicy.TrafficTargetWithRoutes{shadowTrafficTarget},
+			expectedPolicyKeys:       nil,
+			expectedShadowPolicyKeys: []string{"ns-1/shadow"},
+		},
+		{
+			name:            "no shadow traffic targets with permissive mode enabled",
+			permissiveMode:  true,
+			trafficTargets:  nil,
+			expectNilPolicy: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			mockCatalog.EXPECT().ListInboundTrafficTargetsWithRoutes(proxySvcAccount.ToServiceIdentity()).Return(tc.trafficTargets, nil).Times(1)
+
+			policy, err := lb.buildInboundRBACPolicies(tc.permissiveMode)
+			assert.Nil(err)
+
+			if tc.expectNilPolicy {
+				assert.Nil(policy)
+				return
+			}
+
+			getPolicyKeys := func(rules *xds_rbac.RBAC) []string {
+				if rules == nil {
+					return nil
+				}
+				var keys []string
+				for key := range rules.Policies {
+					keys = append(keys, key)
+				}
+				return keys
+			}
+			assert.ElementsMatch(tc.expectedPolicyKeys, getPolicyKeys(policy.Rules))
+			assert.ElementsMatch(tc.expectedShadowPolicyKeys, getPolicyKeys(policy.ShadowRules))
+			assert.Equal(tc.permissiveMode, policy.Rules == nil)
+		})
+	}
+}
@@ -69,6 +69,8 @@ type PodMetadata struct {
 	EnvoyNodeID    string
 	WorkloadKind   string
 	WorkloadName   string
+	Region         string
+	Zone           string
 }
 
 // HasPodMetadata answers the question - has the Pod metadata been recorded for the given Envoy proxy
@@ -1,11 +1,18 @@
 package rbac
 
 import (
+	"net"
 	"strconv"
+	"strings"
 
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm/pkg/envoy"
 )
 
 // Generate constructs an RBAC policy for the policy object on which this method is called
@@ -17,45 +24,9 @@ func (p *Policy) Generate() (*xds_rbac.Policy, error) {
 
 	// Each RuleList follows OR semantics with other RuleList in the list of RuleList
 	for _, principalRuleList := range p.Principals {
-		// 'principalRuleList' corresponds to a single Principal in an RBAC policy.
-		// This Principal can be defined in terms of one of AND or OR rules.
-		// When AND/OR semantics are not required to define multiple rules corresponding
-		// to this principal, a single Rule in either the AndRules or OrRules will suffice.
-
-		if len(principalRuleList.AndRules) != 0 && len(principalRuleList.OrRules) != 0 {
-			return nil, errors.New("Principal rule cannot have both AND & OR rules at the same time")
-		}
-
-		var currentPrincipal *xds_rbac.Principal
-
-		switch {
-		case len(principalRuleList.AndRules) != 0:
-			// Combine all the AND rules for this Principal rule with AND semantics
-			var andPrincipalRules []*xds_rbac.Principal
-			for _, andPrincipalRule := range principalRuleList.AndRules {
-				// Fill in the authenticated principal types
-				if andPrincipalRule.Attribute == DownstreamAuthPrincipal {
-					authPrincipal := GetAuthenticatedPrincipal(andPrincipalRule.Value)
-					andPrincipalRules = append(andPrincipalRules, authPrincipal)
-				}
-			}
-			currentPrincipal = andPrincipals(andPrincipalRules)
-
-		case len(principalRuleList.OrRules) != 0:
-			// Combine all the OR rules for this Principal rule with OR semantics
-			var orPrincipalRules []*xds_rbac.Principal
-			for _, orPrincipalRule := range principalRuleList.OrRules {
-				// Fill in the authenticated principal types
-				if orPrincipalRule.Attribute == DownstreamAuthPrincipal {
-					authPrincipal := GetAuthenticatedPrincipal(orPrincipalRule.Value)
-					orPrincipalRules = append(orPrincipalRules, authPrincipal)
-				}
-			}
-			currentPrincipal = orPrincipals(orPrincipalRules)
-
-		default:
-			// Neither AND/OR rules set, set principal to Any
-			currentPrincipal = getAnyPrincipal()
+		currentPrincipal, err := getPrincipalForRulesList(principalRuleList)
+		if err != nil {
+			return nil, err
 		}
 
 		finalPrincipals = append(finalPrincipals, currentPrincipal)
@@ -65,6 +36,21 @@ func (p *Policy) Generate() (*xds_rbac.Policy, error) {
 		finalPrincipals = append(finalPrincipals, getAnyPrincipal())
 	}
 
+	// Each condition RuleList follows AND semantics with the other condition RuleList and with each principal
+	var conditionPrincipals []*xds_rbac.Principal
+	for _, conditionRuleList := range p.Conditions {
+		conditionPrincipal, err := getPrincipalForRulesList(conditionRuleList)
+		if err != nil {
+			return nil, err
+		}
+		conditionPrincipals = append(conditionPrincipals, conditionPrincipal)
+	}
+	if len(conditionPrincipals) != 0 {
+		for i, principal := range finalPrincipals {
+			finalPrincipals[i] = andPrincipals(append([]*xds_rbac.Principal{principal}, conditionPrincipals...))
+		}
+	}
+
 	policy.Principals = finalPrincipals
 
 	// Construct the Permissions ---------------------------
@@ -133,6 +119,68 @@ func (p *Policy) Generate() (*xds_rbac.Policy, error) {
 	return policy, nil
 }
 
+// getPrincipalForRulesList returns the RBAC principal corresponding to the given RulesList.
+// 'rulesList' corresponds to a single Principal in an RBAC policy.
+// This Principal can be defined in terms of one of AND or OR rules.
+// When AND/OR semantics are not required to define multiple rules corresponding
+// to this principal, a single Rule in either the AndRules or OrRules will suffice.
+func getPrincipalForRulesList(rulesList RulesList) (*xds_rbac.Principal, error) {
+	if len(rulesList.AndRules) != 0 && len(rulesList.OrRules) != 0 {
+		return nil, errors.New("Principal rule cannot have both AND & OR rules at the same time")
+	}
+
+	switch {
+	case len(rulesList.AndRules) != 0:
+		// Combine all the AND rules for this Principal rule with AND semantics
+		andPrincipalRules, err := getPrincipalsForRules(rulesList.AndRules)
+		if err != nil {
+			return nil, err
+		}
+		return andPrincipals(andPrincipalRules), nil
+
+	case len(rulesList.OrRules) != 0:
+		// Combine all the OR rules for this Principal rule with OR semantics
+		orPrincipalRules, err := getPrincipalsForRules(rulesList.OrRules)
+		if err != nil {
+			return nil, err
+		}
+		return orPrincipals(orPrincipalRules), nil
+
+	default:
+		// Neither AND/OR rules set, set principal to Any
+		return getAnyPrincipal(), nil
+	}
+}
+
+// getPrincipalsForRules returns the RBAC principals corresponding to the given principal rules
+func getPrincipalsForRules(rules []Rule) ([]*xds_rbac.Principal, error) {
+	var principals []*xds_rbac.Principal
+	for _, rule := range rules {
+		switch rule.Attribute {
+		case DownstreamAuthPrincipal:
+			// Fill in the authenticated principal types
+			principals = append(principals, GetAuthenticatedPrincipal(rule.Value))
+
+		case DownstreamAuthPrincipalSuffix:
+			principals = append(principals, GetAuthenticatedPrincipalSuffix(rule.Value))
+
+		case DownstreamDirectRemoteIP:
+			remoteIPPrincipal, err := GetDirectRemoteIPPrincipal(rule.Value)
+			if err != nil {
+				return nil, err
+			}
+			principals = append(principals, remoteIPPrincipal)
+
+		case RequestHeader:
+			principals = append(principals, GetHeaderPrincipal(rule.Name, rule.Value))
+
+		case JWTClaim:
+			principals = append(principals, GetJWTClaimPrincipal(rule.Name, rule.Value))
+		}
+	}
+	return principals, nil
+}
+
 // GetAuthenticatedPrincipal returns an authenticated RBAC principal object for the given principal
 func GetAuthenticatedPrincipal(principalName string) *xds_rbac.Principal {
 	return &xds_rbac.Principal{
@@ -148,6 +196,104 @@ func GetAuthenticatedPrincipal(principalName string) *xds_rbac.Principal {
 	}
 }
 
+// GetAuthenticatedPrincipalSuffix returns an authenticated RBAC principal object matching the principals with the given suffix
+func GetAuthenticatedPrincipalSuffix(principalSuffix string) *xds_rbac.Principal {
+	return &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_Authenticated_{
+			Authenticated: &xds_rbac.Principal_Authenticated{
+				PrincipalName: &xds_matcher.StringMatcher{
+					MatchPattern: &xds_matcher.StringMatcher_Suffix{
+						Suffix: principalSuffix,
+					},
+				},
+			},
+		},
+	}
+}
+
+// GetDirectRemoteIPPrincipal returns an RBAC principal object matching the downstream connections
+// originating from the given IP address or CIDR range
+func GetDirectRemoteIPPrincipal(ipBlock string) (*xds_rbac.Principal, error) {
+	var cidr *net.IPNet
+	if strings.Contains(ipBlock, "/") {
+		_, ipNet, err := net.ParseCIDR(ipBlock)
+		if err != nil {
+			return nil, errors.Errorf("Error parsing CIDR range %s", ipBlock)
+		}
+		cidr = ipNet
+	} else {
+		ip := net.ParseIP(ipBlock)
+		if ip == nil {
+			return nil, errors.Errorf("Error parsing IP address %s", ipBlock)
+		}
+		bits := 8 * net.IPv6len
+		if ipv4 := ip.To4(); ipv4 != nil {
+			ip, bits = ipv4, 8*net.IPv4len
+		}
+		cidr = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+	prefixLen, _ := cidr.Mask.Size()
+
+	return &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_DirectRemoteIp{
+			DirectRemoteIp: &xds_core.CidrRange{
+				AddressPrefix: cidr.IP.String(),
+				PrefixLen:     &wrappers.UInt32Value{Value: uint32(prefixLen)},
+			},
+		},
+	}, nil
+}
+
+// GetHeaderPrincipal returns an RBAC principal object matching the requests with the given header value
+func GetHeaderPrincipal(name string, value string) *xds_rbac.Principal {
+	return &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_Header{
+			Header: &xds_route.HeaderMatcher{
+				Name:                 name,
+				HeaderMatchSpecifier: &xds_route.HeaderMatcher_ExactMatch{ExactMatch: value},
+			},
+		},
+	}
+}
+
+// GetJWTClaimPrincipal returns an RBAC principal object matching the requests whose verified JWT has the given claim value.
+// For claims whose value is a list, the list must contain the given value.
+func GetJWTClaimPrincipal(claim string, value string) *xds_rbac.Principal {
+	valueMatcher := &xds_matcher.ValueMatcher{
+		MatchPattern: &xds_matcher.ValueMatcher_StringMatch{
+			StringMatch: &xds_matcher.StringMatcher{
+				MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: value},
+			},
+		},
+	}
+
+	claimMatcher := func(value *xds_matcher.ValueMatcher) *xds_rbac.Principal {
+		return &xds_rbac.Principal{
+			Identifier: &xds_rbac.Principal_Metadata{
+				Metadata: &xds_matcher.MetadataMatcher{
+					Filter: envoy.HTTPJWTAuthnFilterName,
+					Path: []*xds_matcher.MetadataMatcher_PathSegment{
+						{Segment: &xds_matcher.MetadataMatcher_PathSegment_Key{Key: envoy.JWTPayloadMetadataKey}},
+						{Segment: &xds_matcher.MetadataMatcher_PathSegment_Key{Key: claim}},
+					},
+					Value: value,
+				},
+			},
+		}
+	}
+
+	return orPrincipals([]*xds_rbac.Principal{
+		claimMatcher(valueMatcher),
+		claimMatcher(&xds_matcher.ValueMatcher{
+			MatchPattern: &xds_matcher.ValueMatcher_ListMatch{
+				ListMatch: &xds_matcher.ListMatcher{
+					MatchPattern: &xds_matcher.ListMatcher_OneOf{OneOf: valueMatcher},
+				},
+			},
+		}),
+	})
+}
+
 func orPrincipals(principals []*xds_rbac.Principal) *xds_rbac.Principal {
 	return &xds_rbac.Principal{
 		Identifier: &xds_rbac.Principal_OrIds{
@@ -7,6 +7,8 @@ import (
 	tassert "github.com/stretchr/testify/assert"
 
 	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+
+	"github.com/openservicemesh/osm/pkg/envoy"
 )
 
 func TestGenerate(t *testing.T) {
@@ -49,6 +51,36 @@ func TestGenerate(t *testing.T) {
 			expectError: false,
 		},
 
+		{
+			name: "testing principal suffix rule",
+			p: &Policy{
+				Principals: []RulesList{
+					{
+						OrRules: []Rule{
+							{Attribute: DownstreamAuthPrincipalSuffix, Value: ".ns.cluster.local"},
+						},
+					},
+				},
+			},
+			expectedPrincipals: []*xds_rbac.Principal{
+				{
+					Identifier: &xds_rbac.Principal_OrIds{
+						OrIds: &xds_rbac.Principal_Set{
+							Ids: []*xds_rbac.Principal{
+								GetAuthenticatedPrincipalSuffix(".ns.cluster.local"),
+							},
+						},
+					},
+				},
+			},
+			expectedPermissions: []*xds_rbac.Permission{
+				{
+					Rule: &xds_rbac.Permission_Any{Any: true},
+				},
+			},
+			expectError: false,
+		},
+
 		{
 			name: "testing OR rules for single principal",
 			p: &Policy{
@@ -271,6 +303,63 @@ func TestGenerate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "testing conditions ANDed with each principal",
+			p: &Policy{
+				Principals: []RulesList{
+					{
+						OrRules: []Rule{
+							{Attribute: DownstreamAuthPrincipal, Value: "foo.domain"},
+						},
+					},
+					{
+						OrRules: []Rule{
+							{Attribute: DownstreamAuthPrincipal, Value: "bar.domain"},
+						},
+					},
+				},
+				Conditions: []RulesList{
+					{
+						OrRules: []Rule{
+							{Attribute: JWTClaim, Name: "role", Value: "admin"},
+						},
+					},
+					{
+						OrRules: []Rule{
+							{Attribute: RequestHeader, Name: "x-tenant", Value: "a"},
+							{Attribute: RequestHeader, Name: "x-tenant", Value: "b"},
+						},
+					},
+				},
+			},
+			expectedPrincipals: []*xds_rbac.Principal{
+				andPrincipals([]*xds_rbac.Principal{
+					orPrincipals([]*xds_rbac.Principal{GetAuthenticatedPrincipal("foo.domain")}),
+					orPrincipals([]*xds_rbac.Principal{GetJWTClaimPrincipal("role", "admin")}),
+					orPrincipals([]*xds_rbac.Principal{GetHeaderPrincipal("x-tenant", "a"), GetHeaderPrincipal("x-tenant", "b")}),
+				}),
+				andPrincipals([]*xds_rbac.Principal{
+					orPrincipals([]*xds_rbac.Principal{GetAuthenticatedPrincipal("bar.domain")}),
+					orPrincipals([]*xds_rbac.Principal{GetJWTClaimPrincipal("role", "admin")}),
+					orPrincipals([]*xds_rbac.Principal{GetHeaderPrincipal("x-tenant", "a"), GetHeaderPrincipal("x-tenant", "b")}),
+				}),
+			},
+			expectedPermissions: []*xds_rbac.Permission{getAnyPermission()},
+			expectError:         false,
+		},
+		{
+			name: "testing invalid condition",
+			p: &Policy{
+				Conditions: []RulesList{
+					{
+						OrRules: []Rule{
+							{Attribute: DownstreamDirectRemoteIP, Value: "10.0.0.0/33"},
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -290,3 +379,52 @@ func TestGenerate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDirectRemoteIPPrincipal(t *testing.T) {
+	testCases := []struct {
+		ipBlock           string
+		expectedPrefix    string
+		expectedPrefixLen uint32
+		expectError       bool
+	}{
+		{ipBlock: "10.0.0.0/16", expectedPrefix: "10.0.0.0", expectedPrefixLen: 16},
+		{ipBlock: "10.0.1.1/16", expectedPrefix: "10.0.0.0", expectedPrefixLen: 16},
+		{ipBlock: "10.0.1.1", expectedPrefix: "10.0.1.1", expectedPrefixLen: 32},
+		{ipBlock: "fd00::1", expectedPrefix: "fd00::1", expectedPrefixLen: 128},
+		{ipBlock: "fd00::/8", expectedPrefix: "fd00::", expectedPrefixLen: 8},
+		{ipBlock: "not-an-ip", expectError: true},
+		{ipBlock: "10.0.0.0/40", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ipBlock, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			principal, err := GetDirectRemoteIPPrincipal(tc.ipBlock)
+			assert.Equal(tc.expectError, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(tc.expectedPrefix, principal.GetDirectRemoteIp().AddressPrefix)
+			assert.Equal(tc.expectedPrefixLen, principal.GetDirectRemoteIp().PrefixLen.GetValue())
+		})
+	}
+}
+
+func TestGetJWTClaimPrincipal(t *testing.T) {
+	assert := tassert.New(t)
+
+	principal := GetJWTClaimPrincipal("groups", "admins")
+
+	// The claim value matches either a string claim or a list claim containing the value
+	ids := principal.GetOrIds().Ids
+	assert.Len(ids, 2)
+	for _, id := range ids {
+		metadata := id.GetMetadata()
+		assert.Equal(envoy.HTTPJWTAuthnFilterName, metadata.Filter)
+		assert.Equal(envoy.JWTPayloadMetadataKey, metadata.Path[0].GetKey())
+		assert.Equal("groups", metadata.Path[1].GetKey())
+	}
+	assert.Equal("admins", ids[0].GetMetadata().Value.GetStringMatch().GetExact())
+	assert.Equal("admins", ids[1].GetMetadata().Value.GetListMatch().GetOneOf().GetStringMatch().GetExact())
+}
@@ -8,6 +8,18 @@ type RuleAttribute string
 const (
 	// DownstreamAuthPrincipal is the key used for the name of the downstream principal in a policy Rule
 	DownstreamAuthPrincipal RuleAttribute = "downstreamAuthPrincipal"
+
+	// DownstreamAuthPrincipalSuffix is the key used for the suffix of the name of the downstream principal in a policy Rule
+	DownstreamAuthPrincipalSuffix RuleAttribute = "downstreamAuthPrincipalSuffix"
+
+	// DownstreamDirectRemoteIP is the key used for the IP address or CIDR range of the downstream connection in a policy Rule
+	DownstreamDirectRemoteIP RuleAttribute = "downstreamDirectRemoteIP"
+
+	// RequestHeader is the key used for the value of a request header in a policy Rule, named by the Rule's Name
+	RequestHeader RuleAttribute = "requestHeader"
+
+	// JWTClaim is the key used for the value of a claim of the verified JWT in a policy Rule, named by the Rule's Name
+	JWTClaim RuleAttribute = "jwtClaim"
 )
 
 // Supported attributes for an RBAC permission
@@ -20,6 +32,9 @@ const (
 type Rule struct {
 	Attribute RuleAttribute
 	Value     string
+
+	// Name is the name of the attribute for the attributes keyed by name, such as request headers and JWT claims
+	Name string
 }
 
 // RulesList is a list of Rule types represented using AND or OR semantics
@@ -32,4 +47,8 @@ type RulesList struct {
 type Policy struct {
 	Permissions []RulesList
 	Principals  []RulesList
+
+	// Conditions are the rules on the attributes of a request that each of the Principals must additionally match.
+	// Each RulesList follows AND semantics with the other RulesList in Conditions.
+	Conditions []RulesList
 }
@@ -0,0 +1,111 @@
+package route
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+const (
+	// corsWildcardOrigin is the origin used in a CORSPolicy policy to allow all origins
+	corsWildcardOrigin = "*"
+
+	// corsOriginHeaderKey is the header set by browsers on cross-origin requests
+	corsOriginHeaderKey = "origin"
+
+	// corsRequestMethodHeaderKey is the header set by browsers on CORS preflight requests
+	corsRequestMethodHeaderKey = "access-control-request-method"
+
+	// corsPreflightRouteName is the name of the route matching CORS preflight requests
+	corsPreflightRouteName = "cors-preflight"
+)
+
+// applyVirtualHostCORS applies the given CORS settings to the virtual host.
+// CORS preflight requests use the OPTIONS method, which the routes of the virtual host may not allow,
+// so a route matching preflight requests is prepended to the routes of the virtual host for the
+// CORS filter to respond to them. Preflight requests from disallowed origins get a response without CORS headers.
+func applyVirtualHostCORS(virtualHost *xds_route.VirtualHost, cors *policyv1alpha1.CORSPolicySpec) {
+	if cors == nil {
+		return
+	}
+
+	virtualHost.Cors = buildCORSPolicy(cors)
+	virtualHost.Routes = append([]*xds_route.Route{buildCORSPreflightRoute()}, virtualHost.Routes...)
+}
+
+// buildCORSPolicy returns the CORS policy corresponding to the given CORS settings
+func buildCORSPolicy(cors *policyv1alpha1.CORSPolicySpec) *xds_route.CorsPolicy {
+	corsPolicy := &xds_route.CorsPolicy{
+		AllowMethods:     strings.Join(cors.AllowMethods, ","),
+		AllowHeaders:     strings.Join(cors.AllowHeaders, ","),
+		ExposeHeaders:    strings.Join(cors.ExposeHeaders, ","),
+		AllowCredentials: &wrappers.BoolValue{Value: cors.AllowCredentials},
+	}
+
+	for _, origin := range cors.AllowOrigins {
+		corsPolicy.AllowOriginStringMatch = append(corsPolicy.AllowOriginStringMatch, getCORSOriginMatcher(origin))
+	}
+
+	if cors.MaxAge != nil {
+		corsPolicy.MaxAge = strconv.FormatInt(int64(cors.MaxAge.Duration.Seconds()), 10)
+	}
+
+	return corsPolicy
+}
+
+// getCORSOriginMatcher returns the matcher for the given allowed origin
+func getCORSOriginMatcher(origin string) *xds_matcher.StringMatcher {
+	if origin == corsWildcardOrigin {
+		return &xds_matcher.StringMatcher{
+			MatchPattern: &xds_matcher.StringMatcher_SafeRegex{
+				SafeRegex: &xds_matcher.RegexMatcher{
+					EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+					Regex:      constants.RegexMatchAll,
+				},
+			},
+		}
+	}
+
+	return &xds_matcher.StringMatcher{
+		MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: origin},
+	}
+}
+
+// buildCORSPreflightRoute returns the route matching CORS preflight requests, i.e. OPTIONS requests
+// with the Origin and Access-Control-Request-Method headers set
+func buildCORSPreflightRoute() *xds_route.Route {
+	return &xds_route.Route{
+		Name: corsPreflightRouteName,
+		Match: &xds_route.RouteMatch{
+			PathSpecifier: &xds_route.RouteMatch_Prefix{
+				Prefix: "/",
+			},
+			Headers: []*xds_route.HeaderMatcher{
+				{
+					Name:                 methodHeaderKey,
+					HeaderMatchSpecifier: &xds_route.HeaderMatcher_ExactMatch{ExactMatch: http.MethodOptions},
+				},
+				{
+					Name:                 corsOriginHeaderKey,
+					HeaderMatchSpecifier: &xds_route.HeaderMatcher_PresentMatch{PresentMatch: true},
+				},
+				{
+					Name:                 corsRequestMethodHeaderKey,
+					HeaderMatchSpecifier: &xds_route.HeaderMatcher_PresentMatch{PresentMatch: true},
+				},
+			},
+		},
+		Action: &xds_route.Route_DirectResponse{
+			DirectResponse: &xds_route.DirectResponseAction{
+				Status: http.StatusOK,
+			},
+		},
+	}
+}
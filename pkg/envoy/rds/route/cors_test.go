@@ -0,0 +1,96 @@
+package route
+
+import (
+	"testing"
+	"time"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+func TestBuildCORSPolicy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cors     *policyv1alpha1.CORSPolicySpec
+		expected *xds_route.CorsPolicy
+	}{
+		{
+			name: "exact origins with all the settings",
+			cors: &policyv1alpha1.CORSPolicySpec{
+				AllowOrigins:     []string{"https://a.example.com", "https://b.example.com"},
+				AllowMethods:     []string{"GET", "POST"},
+				AllowHeaders:     []string{"content-type", "authorization"},
+				ExposeHeaders:    []string{"x-request-id"},
+				MaxAge:           &metav1.Duration{Duration: 24 * time.Hour},
+				AllowCredentials: true,
+			},
+			expected: &xds_route.CorsPolicy{
+				AllowOriginStringMatch: []*xds_matcher.StringMatcher{
+					{MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: "https://a.example.com"}},
+					{MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: "https://b.example.com"}},
+				},
+				AllowMethods:     "GET,POST",
+				AllowHeaders:     "content-type,authorization",
+				ExposeHeaders:    "x-request-id",
+				MaxAge:           "86400",
+				AllowCredentials: &wrappers.BoolValue{Value: true},
+			},
+		},
+		{
+			name: "wildcard origin",
+			cors: &policyv1alpha1.CORSPolicySpec{
+				AllowOrigins: []string{"*"},
+			},
+			expected: &xds_route.CorsPolicy{
+				AllowOriginStringMatch: []*xds_matcher.StringMatcher{
+					{
+						MatchPattern: &xds_matcher.StringMatcher_SafeRegex{
+							SafeRegex: &xds_matcher.RegexMatcher{
+								EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+								Regex:      ".*",
+							},
+						},
+					},
+				},
+				AllowCredentials: &wrappers.BoolValue{Value: false},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := buildCORSPolicy(tc.cors)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestApplyVirtualHostCORS(t *testing.T) {
+	assert := tassert.New(t)
+
+	route := &xds_route.Route{Name: "books"}
+
+	virtualHost := &xds_route.VirtualHost{Routes: []*xds_route.Route{route}}
+	applyVirtualHostCORS(virtualHost, nil)
+	assert.Nil(virtualHost.Cors)
+	assert.Equal([]*xds_route.Route{route}, virtualHost.Routes)
+
+	applyVirtualHostCORS(virtualHost, &policyv1alpha1.CORSPolicySpec{AllowOrigins: []string{"https://example.com"}})
+	assert.NotNil(virtualHost.Cors)
+	assert.Len(virtualHost.Routes, 2)
+
+	// The preflight route must be matched before the routes of the virtual host
+	preflightRoute := virtualHost.Routes[0]
+	assert.Equal(corsPreflightRouteName, preflightRoute.Name)
+	assert.Len(preflightRoute.Match.Headers, 3)
+	assert.Equal(methodHeaderKey, preflightRoute.Match.Headers[0].Name)
+	assert.Equal(uint32(200), preflightRoute.GetDirectResponse().GetStatus())
+	assert.Equal(route, virtualHost.Routes[1])
+}
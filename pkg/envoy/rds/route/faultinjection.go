@@ -0,0 +1,72 @@
+package route
+
+import (
+	xds_fault_common "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	xds_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+// buildRouteFaultInjectionConfig returns the HTTP fault per filter config for a route, or nil if
+// no faults are injected into the requests on the route
+func buildRouteFaultInjectionConfig(faultInjection *policyv1alpha1.FaultInjectionSpec) map[string]*any.Any {
+	if faultInjection == nil {
+		return nil
+	}
+
+	marshalled, err := buildFaultInjectionConfig(faultInjection)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error building fault injection config for host %s, skipping fault injection", faultInjection.Host)
+		return nil
+	}
+
+	return map[string]*any.Any{wellknown.Fault: marshalled}
+}
+
+// buildFaultInjectionConfig returns the marshalled HTTP fault per filter config for the given fault injection
+func buildFaultInjectionConfig(faultInjection *policyv1alpha1.FaultInjectionSpec) (*any.Any, error) {
+	httpFault := &xds_http_fault.HTTPFault{}
+
+	if faultInjection.Delay != nil {
+		httpFault.Delay = &xds_fault_common.FaultDelay{
+			FaultDelaySecifier: &xds_fault_common.FaultDelay_FixedDelay{
+				FixedDelay: ptypes.DurationProto(faultInjection.Delay.Duration.Duration),
+			},
+			Percentage: getFaultPercentage(faultInjection.Delay.Percentage),
+		}
+	}
+
+	if faultInjection.Abort != nil {
+		httpFault.Abort = &xds_http_fault.FaultAbort{
+			Percentage: getFaultPercentage(faultInjection.Abort.Percentage),
+		}
+		switch {
+		case faultInjection.Abort.GRPCStatus != nil:
+			httpFault.Abort.ErrorType = &xds_http_fault.FaultAbort_GrpcStatus{GrpcStatus: *faultInjection.Abort.GRPCStatus}
+		case faultInjection.Abort.HTTPStatus != 0:
+			httpFault.Abort.ErrorType = &xds_http_fault.FaultAbort_HttpStatus{HttpStatus: faultInjection.Abort.HTTPStatus}
+		default:
+			return nil, errors.New("Fault abort must specify an HTTP or gRPC status")
+		}
+	}
+
+	marshalled, err := ptypes.MarshalAny(httpFault)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP fault config")
+	}
+
+	return marshalled, nil
+}
+
+// getFaultPercentage returns the fractional percent of requests a fault is injected into
+func getFaultPercentage(percentage uint32) *xds_type.FractionalPercent {
+	return &xds_type.FractionalPercent{
+		Numerator:   percentage,
+		Denominator: xds_type.FractionalPercent_HUNDRED,
+	}
+}
@@ -0,0 +1,120 @@
+package route
+
+import (
+	"testing"
+	"time"
+
+	xds_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+func TestBuildRouteFaultInjectionConfig(t *testing.T) {
+	assert := tassert.New(t)
+
+	assert.Nil(buildRouteFaultInjectionConfig(nil))
+
+	actual := buildRouteFaultInjectionConfig(&policyv1alpha1.FaultInjectionSpec{
+		Host:  "s1.test.svc.cluster.local",
+		Abort: &policyv1alpha1.FaultAbortSpec{HTTPStatus: 503, Percentage: 10},
+	})
+	assert.Len(actual, 1)
+	assert.Contains(actual, wellknown.Fault)
+
+	// Invalid abort without a status is skipped
+	actual = buildRouteFaultInjectionConfig(&policyv1alpha1.FaultInjectionSpec{
+		Host:  "s1.test.svc.cluster.local",
+		Abort: &policyv1alpha1.FaultAbortSpec{Percentage: 10},
+	})
+	assert.Nil(actual)
+}
+
+func TestBuildFaultInjectionConfig(t *testing.T) {
+	grpcUnavailable := uint32(14)
+
+	testCases := []struct {
+		name           string
+		faultInjection *policyv1alpha1.FaultInjectionSpec
+		expectedErr    bool
+	}{
+		{
+			name: "fixed delay",
+			faultInjection: &policyv1alpha1.FaultInjectionSpec{
+				Delay: &policyv1alpha1.FaultDelaySpec{
+					Duration:   metav1.Duration{Duration: 5 * time.Second},
+					Percentage: 50,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "HTTP abort",
+			faultInjection: &policyv1alpha1.FaultInjectionSpec{
+				Abort: &policyv1alpha1.FaultAbortSpec{
+					HTTPStatus: 503,
+					Percentage: 10,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "gRPC abort with delay",
+			faultInjection: &policyv1alpha1.FaultInjectionSpec{
+				Delay: &policyv1alpha1.FaultDelaySpec{
+					Duration:   metav1.Duration{Duration: time.Second},
+					Percentage: 100,
+				},
+				Abort: &policyv1alpha1.FaultAbortSpec{
+					GRPCStatus: &grpcUnavailable,
+					Percentage: 25,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "abort without a status",
+			faultInjection: &policyv1alpha1.FaultInjectionSpec{
+				Abort: &policyv1alpha1.FaultAbortSpec{
+					Percentage: 10,
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			marshalled, err := buildFaultInjectionConfig(tc.faultInjection)
+			assert.Equal(tc.expectedErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			httpFault := &xds_http_fault.HTTPFault{}
+			assert.Nil(ptypes.UnmarshalAny(marshalled, httpFault))
+
+			if tc.faultInjection.Delay != nil {
+				assert.Equal(tc.faultInjection.Delay.Duration.Duration, httpFault.Delay.GetFixedDelay().AsDuration())
+				assert.Equal(tc.faultInjection.Delay.Percentage, httpFault.Delay.Percentage.Numerator)
+			} else {
+				assert.Nil(httpFault.Delay)
+			}
+
+			if tc.faultInjection.Abort != nil {
+				assert.Equal(tc.faultInjection.Abort.HTTPStatus, httpFault.Abort.GetHttpStatus())
+				if tc.faultInjection.Abort.GRPCStatus != nil {
+					assert.Equal(*tc.faultInjection.Abort.GRPCStatus, httpFault.Abort.GetGrpcStatus())
+				}
+				assert.Equal(tc.faultInjection.Abort.Percentage, httpFault.Abort.Percentage.Numerator)
+			} else {
+				assert.Nil(httpFault.Abort)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package route
+
+import (
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+// applyRouteHashPolicy sets the hash policies on the given route, so that the requests on the route carrying the same
+// keys are sent to the same host when the upstream cluster uses a consistent hash load balancer
+func applyRouteHashPolicy(route *xds_route.Route, loadBalancer *policyv1alpha1.LoadBalancerSpec) {
+	routeAction := route.GetRoute()
+	if routeAction == nil {
+		return
+	}
+
+	routeAction.HashPolicy = buildHashPolicies(loadBalancer)
+}
+
+// buildHashPolicies returns the route hash policies corresponding to the keys hashed by the given load balancer.
+// Hash policies are only returned for consistent hash load balancers.
+func buildHashPolicies(loadBalancer *policyv1alpha1.LoadBalancerSpec) []*xds_route.RouteAction_HashPolicy {
+	if loadBalancer == nil || loadBalancer.ConsistentHash == nil {
+		return nil
+	}
+	if loadBalancer.Type != policyv1alpha1.RingHashLoadBalancer && loadBalancer.Type != policyv1alpha1.MaglevLoadBalancer {
+		return nil
+	}
+
+	var hashPolicies []*xds_route.RouteAction_HashPolicy
+	consistentHash := loadBalancer.ConsistentHash
+
+	if consistentHash.Header != "" {
+		hashPolicies = append(hashPolicies, &xds_route.RouteAction_HashPolicy{
+			PolicySpecifier: &xds_route.RouteAction_HashPolicy_Header_{
+				Header: &xds_route.RouteAction_HashPolicy_Header{
+					HeaderName: consistentHash.Header,
+				},
+			},
+		})
+	}
+
+	if cookie := consistentHash.Cookie; cookie != nil {
+		cookieHashPolicy := &xds_route.RouteAction_HashPolicy_Cookie{
+			Name: cookie.Name,
+			Path: cookie.Path,
+		}
+		if cookie.TTL != nil {
+			cookieHashPolicy.Ttl = ptypes.DurationProto(cookie.TTL.Duration)
+		}
+		hashPolicies = append(hashPolicies, &xds_route.RouteAction_HashPolicy{
+			PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+				Cookie: cookieHashPolicy,
+			},
+		})
+	}
+
+	if consistentHash.SourceIP {
+		hashPolicies = append(hashPolicies, &xds_route.RouteAction_HashPolicy{
+			PolicySpecifier: &xds_route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &xds_route.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			},
+		})
+	}
+
+	return hashPolicies
+}
@@ -0,0 +1,122 @@
+package route
+
+import (
+	"testing"
+	"time"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+func TestBuildHashPolicies(t *testing.T) {
+	consistentHash := &policyv1alpha1.ConsistentHashSpec{
+		Header: "x-user-id",
+		Cookie: &policyv1alpha1.CookieHashSpec{
+			Name: "session",
+			Path: "/",
+			TTL:  &metav1.Duration{Duration: time.Hour},
+		},
+		SourceIP: true,
+	}
+
+	testCases := []struct {
+		name                 string
+		loadBalancer         *policyv1alpha1.LoadBalancerSpec
+		expectedHashPolicies []*xds_route.RouteAction_HashPolicy
+	}{
+		{
+			name:                 "no load balancer",
+			loadBalancer:         nil,
+			expectedHashPolicies: nil,
+		},
+		{
+			name:                 "consistent hash load balancer without hash keys",
+			loadBalancer:         &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RingHashLoadBalancer},
+			expectedHashPolicies: nil,
+		},
+		{
+			name: "round robin load balancer with hash keys",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:           policyv1alpha1.RoundRobinLoadBalancer,
+				ConsistentHash: consistentHash,
+			},
+			expectedHashPolicies: nil,
+		},
+		{
+			name: "maglev load balancer hashing a header",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:           policyv1alpha1.MaglevLoadBalancer,
+				ConsistentHash: &policyv1alpha1.ConsistentHashSpec{Header: "x-user-id"},
+			},
+			expectedHashPolicies: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Header_{
+						Header: &xds_route.RouteAction_HashPolicy_Header{HeaderName: "x-user-id"},
+					},
+				},
+			},
+		},
+		{
+			name: "ring hash load balancer hashing a header, a cookie and the source IP",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:           policyv1alpha1.RingHashLoadBalancer,
+				ConsistentHash: consistentHash,
+			},
+			expectedHashPolicies: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Header_{
+						Header: &xds_route.RouteAction_HashPolicy_Header{HeaderName: "x-user-id"},
+					},
+				},
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+						Cookie: &xds_route.RouteAction_HashPolicy_Cookie{
+							Name: "session",
+							Path: "/",
+							Ttl:  ptypes.DurationProto(time.Hour),
+						},
+					},
+				},
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_ConnectionProperties_{
+						ConnectionProperties: &xds_route.RouteAction_HashPolicy_ConnectionProperties{SourceIp: true},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := buildHashPolicies(tc.loadBalancer)
+			assert.Equal(tc.expectedHashPolicies, actual)
+		})
+	}
+}
+
+func TestApplyRouteHashPolicy(t *testing.T) {
+	assert := tassert.New(t)
+
+	loadBalancer := &policyv1alpha1.LoadBalancerSpec{
+		Type:           policyv1alpha1.RingHashLoadBalancer,
+		ConsistentHash: &policyv1alpha1.ConsistentHashSpec{SourceIP: true},
+	}
+
+	route := &xds_route.Route{Action: &xds_route.Route_Route{Route: &xds_route.RouteAction{}}}
+	applyRouteHashPolicy(route, nil)
+	assert.Nil(route.GetRoute().HashPolicy)
+
+	applyRouteHashPolicy(route, loadBalancer)
+	assert.Len(route.GetRoute().HashPolicy, 1)
+
+	// Redirect routes do not forward requests to a cluster
+	redirectRoute := &xds_route.Route{Action: &xds_route.Route_Redirect{Redirect: &xds_route.RedirectAction{}}}
+	applyRouteHashPolicy(redirectRoute, loadBalancer)
+	assert.Nil(redirectRoute.GetRoute())
+}
@@ -0,0 +1,87 @@
+package route
+
+import (
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+// getVirtualHostHeaderModifier returns the header modifications applied to all the routes of a virtual host
+// in the given direction, or nil if no header modifications are specified for the direction
+func getVirtualHostHeaderModifier(headerModifier *policyv1alpha1.HeaderModifierSpec, direction Direction) *policyv1alpha1.HTTPHeaderModifierSpec {
+	if headerModifier == nil {
+		return nil
+	}
+
+	if direction == inboundRoute {
+		return headerModifier.Inbound
+	}
+	return headerModifier.Outbound
+}
+
+// getHTTPRouteHeaderModifier returns the header modifications specified for the route with the given path
+// in the given direction, or nil if no header modifications are specified for the route
+func getHTTPRouteHeaderModifier(headerModifier *policyv1alpha1.HeaderModifierSpec, path string, direction Direction) *policyv1alpha1.HTTPHeaderModifierSpec {
+	if headerModifier == nil {
+		return nil
+	}
+
+	for _, routeHeaderModifier := range headerModifier.HTTPRoutes {
+		if routeHeaderModifier.Path != path {
+			continue
+		}
+		if direction == inboundRoute {
+			return routeHeaderModifier.Inbound
+		}
+		return routeHeaderModifier.Outbound
+	}
+
+	return nil
+}
+
+// applyVirtualHostHeaderModifier applies the given header modifications to the requests and responses on the virtual host
+func applyVirtualHostHeaderModifier(virtualHost *xds_route.VirtualHost, headerModifier *policyv1alpha1.HTTPHeaderModifierSpec) {
+	if headerModifier == nil {
+		return
+	}
+
+	virtualHost.RequestHeadersToAdd = buildHeaderValueOptions(headerModifier.RequestHeadersToAdd, headerModifier.RequestHeadersToSet)
+	virtualHost.RequestHeadersToRemove = headerModifier.RequestHeadersToRemove
+	virtualHost.ResponseHeadersToAdd = buildHeaderValueOptions(headerModifier.ResponseHeadersToAdd, headerModifier.ResponseHeadersToSet)
+	virtualHost.ResponseHeadersToRemove = headerModifier.ResponseHeadersToRemove
+}
+
+// applyRouteHeaderModifier applies the given header modifications to the requests and responses on the route
+func applyRouteHeaderModifier(route *xds_route.Route, headerModifier *policyv1alpha1.HTTPHeaderModifierSpec) {
+	if headerModifier == nil {
+		return
+	}
+
+	route.RequestHeadersToAdd = buildHeaderValueOptions(headerModifier.RequestHeadersToAdd, headerModifier.RequestHeadersToSet)
+	route.RequestHeadersToRemove = headerModifier.RequestHeadersToRemove
+	route.ResponseHeadersToAdd = buildHeaderValueOptions(headerModifier.ResponseHeadersToAdd, headerModifier.ResponseHeadersToSet)
+	route.ResponseHeadersToRemove = headerModifier.ResponseHeadersToRemove
+}
+
+// buildHeaderValueOptions returns the header value options appending the headers to add to the existing values,
+// and overwriting the existing values with the headers to set
+func buildHeaderValueOptions(headersToAdd, headersToSet []policyv1alpha1.HTTPHeaderSpec) []*core.HeaderValueOption {
+	var headerValueOptions []*core.HeaderValueOption
+
+	for _, header := range headersToAdd {
+		headerValueOptions = append(headerValueOptions, &core.HeaderValueOption{
+			Header: &core.HeaderValue{Key: header.Name, Value: header.Value},
+			Append: &wrappers.BoolValue{Value: true},
+		})
+	}
+	for _, header := range headersToSet {
+		headerValueOptions = append(headerValueOptions, &core.HeaderValueOption{
+			Header: &core.HeaderValue{Key: header.Name, Value: header.Value},
+			Append: &wrappers.BoolValue{Value: false},
+		})
+	}
+
+	return headerValueOptions
+}
@@ -0,0 +1,92 @@
+package route
+
+import (
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+func TestGetHeaderModifier(t *testing.T) {
+	assert := tassert.New(t)
+
+	inbound := &policyv1alpha1.HTTPHeaderModifierSpec{RequestHeadersToRemove: []string{"x-inbound"}}
+	outbound := &policyv1alpha1.HTTPHeaderModifierSpec{RequestHeadersToRemove: []string{"x-outbound"}}
+	routeInbound := &policyv1alpha1.HTTPHeaderModifierSpec{RequestHeadersToRemove: []string{"x-route-inbound"}}
+	routeOutbound := &policyv1alpha1.HTTPHeaderModifierSpec{RequestHeadersToRemove: []string{"x-route-outbound"}}
+
+	headerModifier := &policyv1alpha1.HeaderModifierSpec{
+		Host:     "s1.test.svc.cluster.local",
+		Inbound:  inbound,
+		Outbound: outbound,
+		HTTPRoutes: []policyv1alpha1.HTTPRouteHeaderModifierSpec{
+			{
+				Path:     "/books",
+				Inbound:  routeInbound,
+				Outbound: routeOutbound,
+			},
+		},
+	}
+
+	assert.Nil(getVirtualHostHeaderModifier(nil, inboundRoute))
+	assert.Equal(inbound, getVirtualHostHeaderModifier(headerModifier, inboundRoute))
+	assert.Equal(outbound, getVirtualHostHeaderModifier(headerModifier, outboundRoute))
+
+	assert.Nil(getHTTPRouteHeaderModifier(nil, "/books", inboundRoute))
+	assert.Nil(getHTTPRouteHeaderModifier(headerModifier, "/authors", inboundRoute))
+	assert.Equal(routeInbound, getHTTPRouteHeaderModifier(headerModifier, "/books", inboundRoute))
+	assert.Equal(routeOutbound, getHTTPRouteHeaderModifier(headerModifier, "/books", outboundRoute))
+}
+
+func TestApplyHeaderModifier(t *testing.T) {
+	assert := tassert.New(t)
+
+	headerModifier := &policyv1alpha1.HTTPHeaderModifierSpec{
+		RequestHeadersToAdd:     []policyv1alpha1.HTTPHeaderSpec{{Name: "x-tenant", Value: "t1"}},
+		RequestHeadersToSet:     []policyv1alpha1.HTTPHeaderSpec{{Name: "x-env", Value: "prod"}},
+		RequestHeadersToRemove:  []string{"x-internal"},
+		ResponseHeadersToSet:    []policyv1alpha1.HTTPHeaderSpec{{Name: "x-frame-options", Value: "DENY"}},
+		ResponseHeadersToRemove: []string{"server"},
+	}
+
+	expectedRequestHeadersToAdd := []*core.HeaderValueOption{
+		{
+			Header: &core.HeaderValue{Key: "x-tenant", Value: "t1"},
+			Append: &wrappers.BoolValue{Value: true},
+		},
+		{
+			Header: &core.HeaderValue{Key: "x-env", Value: "prod"},
+			Append: &wrappers.BoolValue{Value: false},
+		},
+	}
+	expectedResponseHeadersToAdd := []*core.HeaderValueOption{
+		{
+			Header: &core.HeaderValue{Key: "x-frame-options", Value: "DENY"},
+			Append: &wrappers.BoolValue{Value: false},
+		},
+	}
+
+	virtualHost := &xds_route.VirtualHost{}
+	applyVirtualHostHeaderModifier(virtualHost, nil)
+	assert.Equal(&xds_route.VirtualHost{}, virtualHost)
+
+	applyVirtualHostHeaderModifier(virtualHost, headerModifier)
+	assert.Equal(expectedRequestHeadersToAdd, virtualHost.RequestHeadersToAdd)
+	assert.Equal([]string{"x-internal"}, virtualHost.RequestHeadersToRemove)
+	assert.Equal(expectedResponseHeadersToAdd, virtualHost.ResponseHeadersToAdd)
+	assert.Equal([]string{"server"}, virtualHost.ResponseHeadersToRemove)
+
+	route := &xds_route.Route{}
+	applyRouteHeaderModifier(route, nil)
+	assert.Equal(&xds_route.Route{}, route)
+
+	applyRouteHeaderModifier(route, headerModifier)
+	assert.Equal(expectedRequestHeadersToAdd, route.RequestHeadersToAdd)
+	assert.Equal([]string{"x-internal"}, route.RequestHeadersToRemove)
+	assert.Equal(expectedResponseHeadersToAdd, route.ResponseHeadersToAdd)
+	assert.Equal([]string{"server"}, route.ResponseHeadersToRemove)
+}
@@ -0,0 +1,30 @@
+package route
+
+import (
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// applyRouteMirror sets the request mirror policy on the given route, so that the given percentage of the requests
+// on the route are copied to the mirror cluster. The responses to the mirrored requests are discarded by Envoy.
+func applyRouteMirror(route *xds_route.Route, mirror *trafficpolicy.RequestMirror) {
+	routeAction := route.GetRoute()
+	if routeAction == nil || mirror == nil {
+		return
+	}
+
+	routeAction.RequestMirrorPolicies = []*xds_route.RouteAction_RequestMirrorPolicy{
+		{
+			Cluster: string(mirror.ClusterName),
+			RuntimeFraction: &core.RuntimeFractionalPercent{
+				DefaultValue: &xds_type.FractionalPercent{
+					Numerator:   mirror.Percentage,
+					Denominator: xds_type.FractionalPercent_HUNDRED,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package route
+
+import (
+	"testing"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestApplyRouteMirror(t *testing.T) {
+	assert := tassert.New(t)
+
+	route := &xds_route.Route{Action: &xds_route.Route_Route{Route: &xds_route.RouteAction{}}}
+	applyRouteMirror(route, nil)
+	assert.Nil(route.GetRoute().RequestMirrorPolicies)
+
+	applyRouteMirror(route, &trafficpolicy.RequestMirror{ClusterName: "test/s1-v2/local", Percentage: 10})
+	assert.Len(route.GetRoute().RequestMirrorPolicies, 1)
+
+	mirrorPolicy := route.GetRoute().RequestMirrorPolicies[0]
+	assert.Equal("test/s1-v2/local", mirrorPolicy.Cluster)
+	assert.Equal(uint32(10), mirrorPolicy.RuntimeFraction.DefaultValue.Numerator)
+	assert.Equal(xds_type.FractionalPercent_HUNDRED, mirrorPolicy.RuntimeFraction.DefaultValue.Denominator)
+
+	// Redirect routes do not forward, and hence do not mirror, requests
+	redirectRoute := &xds_route.Route{Action: &xds_route.Route_Redirect{Redirect: &xds_route.RedirectAction{}}}
+	applyRouteMirror(redirectRoute, &trafficpolicy.RequestMirror{ClusterName: "test/s1-v2/local", Percentage: 10})
+	assert.Nil(redirectRoute.GetRoute())
+}
@@ -0,0 +1,62 @@
+package route
+
+import (
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// applyRouteQueryParams sets the query parameter matches specified on the HTTP route match on the given route
+func applyRouteQueryParams(route *xds_route.Route, httpRouteMatch trafficpolicy.HTTPRouteMatch) {
+	if route.Match == nil || len(httpRouteMatch.QueryParams) == 0 {
+		return
+	}
+
+	route.Match.QueryParameters = getQueryParamsForRoute(httpRouteMatch.QueryParams)
+}
+
+// getQueryParamsForRoute returns the query parameter matchers corresponding to the given query parameter matches
+func getQueryParamsForRoute(queryParams []trafficpolicy.QueryParamMatch) []*xds_route.QueryParameterMatcher {
+	var matchers []*xds_route.QueryParameterMatcher
+
+	for _, queryParam := range queryParams {
+		matcher := &xds_route.QueryParameterMatcher{
+			Name: queryParam.Name,
+		}
+
+		switch queryParam.MatchType {
+		case trafficpolicy.QueryParamMatchExact:
+			matcher.QueryParameterMatchSpecifier = &xds_route.QueryParameterMatcher_StringMatch{
+				StringMatch: &xds_matcher.StringMatcher{
+					MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: queryParam.Value},
+				},
+			}
+
+		case trafficpolicy.QueryParamMatchRegex:
+			matcher.QueryParameterMatchSpecifier = &xds_route.QueryParameterMatcher_StringMatch{
+				StringMatch: &xds_matcher.StringMatcher{
+					MatchPattern: &xds_matcher.StringMatcher_SafeRegex{
+						SafeRegex: &xds_matcher.RegexMatcher{
+							EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+							Regex:      queryParam.Value,
+						},
+					},
+				},
+			}
+
+		case trafficpolicy.QueryParamMatchPresent:
+			matcher.QueryParameterMatchSpecifier = &xds_route.QueryParameterMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+
+		default:
+			log.Error().Msgf("Unsupported match type %d for query parameter %s, skipping query parameter match", queryParam.MatchType, queryParam.Name)
+			continue
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	return matchers
+}
@@ -0,0 +1,60 @@
+package route
+
+import (
+	"testing"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestApplyRouteQueryParams(t *testing.T) {
+	assert := tassert.New(t)
+
+	route := &xds_route.Route{Match: &xds_route.RouteMatch{}}
+	applyRouteQueryParams(route, trafficpolicy.HTTPRouteMatch{Path: "/books"})
+	assert.Nil(route.Match.QueryParameters)
+
+	applyRouteQueryParams(route, trafficpolicy.HTTPRouteMatch{
+		Path: "/books",
+		QueryParams: []trafficpolicy.QueryParamMatch{
+			{Name: "version", Value: "v2", MatchType: trafficpolicy.QueryParamMatchExact},
+			{Name: "id", Value: "[0-9]+", MatchType: trafficpolicy.QueryParamMatchRegex},
+			{Name: "debug", MatchType: trafficpolicy.QueryParamMatchPresent},
+			{Name: "unsupported", MatchType: trafficpolicy.QueryParamMatchType(100)},
+		},
+	})
+
+	expected := []*xds_route.QueryParameterMatcher{
+		{
+			Name: "version",
+			QueryParameterMatchSpecifier: &xds_route.QueryParameterMatcher_StringMatch{
+				StringMatch: &xds_matcher.StringMatcher{
+					MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: "v2"},
+				},
+			},
+		},
+		{
+			Name: "id",
+			QueryParameterMatchSpecifier: &xds_route.QueryParameterMatcher_StringMatch{
+				StringMatch: &xds_matcher.StringMatcher{
+					MatchPattern: &xds_matcher.StringMatcher_SafeRegex{
+						SafeRegex: &xds_matcher.RegexMatcher{
+							EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+							Regex:      "[0-9]+",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "debug",
+			QueryParameterMatchSpecifier: &xds_route.QueryParameterMatcher_PresentMatch{
+				PresentMatch: true,
+			},
+		},
+	}
+	assert.Equal(expected, route.Match.QueryParameters)
+}
@@ -0,0 +1,162 @@
+package route
+
+import (
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_http_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+)
+
+const (
+	// defaultLocalRateLimitStatusCode is the HTTP status code returned for requests rejected by the local rate limit filter
+	defaultLocalRateLimitStatusCode = uint32(xds_type.StatusCode_TooManyRequests)
+)
+
+// buildVirtualHostLocalRateLimitConfig returns the HTTP local rate limit per filter config applied to all the routes
+// of a virtual host, or nil if no local rate limit is specified for HTTP requests
+func buildVirtualHostLocalRateLimitConfig(rateLimit *policyv1alpha1.RateLimitSpec) map[string]*any.Any {
+	if rateLimit == nil || rateLimit.Local == nil || rateLimit.Local.HTTP == nil {
+		return nil
+	}
+
+	marshalled, err := buildLocalRateLimitConfig(rateLimit.Local.HTTP)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error building local rate limit config for host %s, skipping rate limit", rateLimit.Host)
+		return nil
+	}
+
+	return map[string]*any.Any{envoy.HTTPLocalRateLimitFilterName: marshalled}
+}
+
+// getHTTPRouteLocalRateLimit returns the local rate limit specified for the route with the given path, or nil
+// if no local rate limit is specified for the route
+func getHTTPRouteLocalRateLimit(rateLimit *policyv1alpha1.RateLimitSpec, path string) *policyv1alpha1.HTTPLocalRateLimitSpec {
+	if rateLimit == nil || rateLimit.Local == nil {
+		return nil
+	}
+
+	for _, routeRateLimit := range rateLimit.Local.HTTPRoutes {
+		if routeRateLimit.Path == path {
+			return &routeRateLimit.RateLimit
+		}
+	}
+
+	return nil
+}
+
+// buildGlobalRateLimits returns the rate limit actions that generate the descriptors sent to the rate limit service
+// for requests on a virtual host, or nil if global rate limiting is disabled
+func buildGlobalRateLimits(globalRateLimitConfig ratelimit.GlobalRateLimitConfig) []*xds_route.RateLimit {
+	if !globalRateLimitConfig.Enable {
+		return nil
+	}
+
+	var rateLimits []*xds_route.RateLimit
+	for _, descriptor := range globalRateLimitConfig.Descriptors {
+		rateLimit := &xds_route.RateLimit{}
+		for _, entry := range descriptor.Entries {
+			action := buildGlobalRateLimitAction(entry)
+			if action == nil {
+				log.Error().Msgf("Invalid global rate limit descriptor entry type %q, skipping entry", entry.Type)
+				continue
+			}
+			rateLimit.Actions = append(rateLimit.Actions, action)
+		}
+		if len(rateLimit.Actions) == 0 {
+			continue
+		}
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// buildGlobalRateLimitAction returns the rate limit action that generates the given descriptor entry, or nil
+// if the entry type is unknown
+func buildGlobalRateLimitAction(entry configv1alpha1.GlobalRateLimitDescriptorEntrySpec) *xds_route.RateLimit_Action {
+	switch entry.Type {
+	case configv1alpha1.DestinationClusterDescriptorEntry:
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_DestinationCluster_{
+				DestinationCluster: &xds_route.RateLimit_Action_DestinationCluster{},
+			},
+		}
+
+	case configv1alpha1.RemoteAddressDescriptorEntry:
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_RemoteAddress_{
+				RemoteAddress: &xds_route.RateLimit_Action_RemoteAddress{},
+			},
+		}
+
+	case configv1alpha1.RequestHeaderDescriptorEntry:
+		descriptorKey := entry.Key
+		if descriptorKey == "" {
+			descriptorKey = entry.Header
+		}
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &xds_route.RateLimit_Action_RequestHeaders{
+					HeaderName:    entry.Header,
+					DescriptorKey: descriptorKey,
+				},
+			},
+		}
+
+	case configv1alpha1.GenericKeyDescriptorEntry:
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_GenericKey_{
+				GenericKey: &xds_route.RateLimit_Action_GenericKey{
+					DescriptorKey:   entry.Key,
+					DescriptorValue: entry.Value,
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+// buildLocalRateLimitConfig returns the marshalled HTTP local rate limit per filter config for the given rate limit
+func buildLocalRateLimitConfig(rateLimit *policyv1alpha1.HTTPLocalRateLimitSpec) (*any.Any, error) {
+	tokenBucket, err := envoy.GetLocalRateLimitTokenBucket(rateLimit.Requests, rateLimit.Burst, rateLimit.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode := defaultLocalRateLimitStatusCode
+	if rateLimit.ResponseStatusCode != 0 {
+		statusCode = rateLimit.ResponseStatusCode
+	}
+
+	// The filter is enabled and enforced for all requests the config applies to
+	allRequests := &xds_core.RuntimeFractionalPercent{
+		DefaultValue: &xds_type.FractionalPercent{
+			Numerator:   100,
+			Denominator: xds_type.FractionalPercent_HUNDRED,
+		},
+	}
+
+	localRateLimit := &xds_http_local_ratelimit.LocalRateLimit{
+		StatPrefix:     envoy.HTTPLocalRateLimitStatPrefix,
+		Status:         &xds_type.HttpStatus{Code: xds_type.StatusCode(statusCode)},
+		TokenBucket:    tokenBucket,
+		FilterEnabled:  allRequests,
+		FilterEnforced: allRequests,
+	}
+
+	marshalled, err := ptypes.MarshalAny(localRateLimit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error marshalling HTTP local rate limit config")
+	}
+
+	return marshalled, nil
+}
@@ -0,0 +1,269 @@
+package route
+
+import (
+	"testing"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_http_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes"
+	tassert "github.com/stretchr/testify/assert"
+
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/envoy"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
+)
+
+func TestBuildVirtualHostLocalRateLimitConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		rateLimit      *policyv1alpha1.RateLimitSpec
+		expectedConfig bool
+	}{
+		{
+			name:           "no rate limit",
+			rateLimit:      nil,
+			expectedConfig: false,
+		},
+		{
+			name: "no HTTP local rate limit",
+			rateLimit: &policyv1alpha1.RateLimitSpec{
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					TCP: &policyv1alpha1.TCPLocalRateLimitSpec{
+						Connections: 10,
+						Unit:        "second",
+					},
+				},
+			},
+			expectedConfig: false,
+		},
+		{
+			name: "HTTP local rate limit",
+			rateLimit: &policyv1alpha1.RateLimitSpec{
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					HTTP: &policyv1alpha1.HTTPLocalRateLimitSpec{
+						Requests: 10,
+						Unit:     "second",
+					},
+				},
+			},
+			expectedConfig: true,
+		},
+		{
+			name: "HTTP local rate limit with invalid unit",
+			rateLimit: &policyv1alpha1.RateLimitSpec{
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					HTTP: &policyv1alpha1.HTTPLocalRateLimitSpec{
+						Requests: 10,
+						Unit:     "day",
+					},
+				},
+			},
+			expectedConfig: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := buildVirtualHostLocalRateLimitConfig(tc.rateLimit)
+			if tc.expectedConfig {
+				assert.Contains(actual, envoy.HTTPLocalRateLimitFilterName)
+			} else {
+				assert.Nil(actual)
+			}
+		})
+	}
+}
+
+func TestGetHTTPRouteLocalRateLimit(t *testing.T) {
+	assert := tassert.New(t)
+
+	rateLimit := &policyv1alpha1.RateLimitSpec{
+		Local: &policyv1alpha1.LocalRateLimitSpec{
+			HTTPRoutes: []policyv1alpha1.HTTPRouteLocalRateLimitSpec{
+				{
+					Path: "/foo",
+					RateLimit: policyv1alpha1.HTTPLocalRateLimitSpec{
+						Requests: 10,
+						Unit:     "second",
+					},
+				},
+				{
+					Path: "/bar",
+					RateLimit: policyv1alpha1.HTTPLocalRateLimitSpec{
+						Requests: 20,
+						Unit:     "minute",
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(&rateLimit.Local.HTTPRoutes[1].RateLimit, getHTTPRouteLocalRateLimit(rateLimit, "/bar"))
+	assert.Nil(getHTTPRouteLocalRateLimit(rateLimit, "/baz"))
+	assert.Nil(getHTTPRouteLocalRateLimit(nil, "/foo"))
+}
+
+func TestBuildLocalRateLimitConfig(t *testing.T) {
+	testCases := []struct {
+		name               string
+		rateLimit          *policyv1alpha1.HTTPLocalRateLimitSpec
+		expectedStatusCode xds_type.StatusCode
+		expectedMaxTokens  uint32
+		expectedErr        bool
+	}{
+		{
+			name: "default response status code",
+			rateLimit: &policyv1alpha1.HTTPLocalRateLimitSpec{
+				Requests: 10,
+				Unit:     "second",
+			},
+			expectedStatusCode: xds_type.StatusCode_TooManyRequests,
+			expectedMaxTokens:  10,
+			expectedErr:        false,
+		},
+		{
+			name: "custom response status code and burst",
+			rateLimit: &policyv1alpha1.HTTPLocalRateLimitSpec{
+				Requests:           10,
+				Unit:               "minute",
+				Burst:              5,
+				ResponseStatusCode: 503,
+			},
+			expectedStatusCode: xds_type.StatusCode_ServiceUnavailable,
+			expectedMaxTokens:  15,
+			expectedErr:        false,
+		},
+		{
+			name: "invalid unit",
+			rateLimit: &policyv1alpha1.HTTPLocalRateLimitSpec{
+				Requests: 10,
+				Unit:     "day",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			marshalled, err := buildLocalRateLimitConfig(tc.rateLimit)
+			assert.Equal(tc.expectedErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			localRateLimit := &xds_http_local_ratelimit.LocalRateLimit{}
+			assert.Nil(ptypes.UnmarshalAny(marshalled, localRateLimit))
+			assert.Equal(tc.expectedStatusCode, localRateLimit.Status.Code)
+			assert.Equal(tc.expectedMaxTokens, localRateLimit.TokenBucket.MaxTokens)
+			assert.Equal(uint32(100), localRateLimit.FilterEnforced.DefaultValue.Numerator)
+		})
+	}
+}
+
+func TestBuildGlobalRateLimits(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		globalRateLimitConfig ratelimit.GlobalRateLimitConfig
+		expected              []*xds_route.RateLimit
+	}{
+		{
+			name: "global rate limiting is disabled",
+			globalRateLimitConfig: ratelimit.GlobalRateLimitConfig{
+				Enable: false,
+				Descriptors: []configv1alpha1.GlobalRateLimitDescriptorSpec{
+					{
+						Entries: []configv1alpha1.GlobalRateLimitDescriptorEntrySpec{
+							{Type: configv1alpha1.DestinationClusterDescriptorEntry},
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "descriptors with all entry types",
+			globalRateLimitConfig: ratelimit.GlobalRateLimitConfig{
+				Enable: true,
+				Descriptors: []configv1alpha1.GlobalRateLimitDescriptorSpec{
+					{
+						Entries: []configv1alpha1.GlobalRateLimitDescriptorEntrySpec{
+							{Type: configv1alpha1.DestinationClusterDescriptorEntry},
+							{Type: configv1alpha1.RemoteAddressDescriptorEntry},
+						},
+					},
+					{
+						Entries: []configv1alpha1.GlobalRateLimitDescriptorEntrySpec{
+							{Type: configv1alpha1.RequestHeaderDescriptorEntry, Header: "x-user-id"},
+							{Type: configv1alpha1.GenericKeyDescriptorEntry, Key: "tier", Value: "free"},
+						},
+					},
+				},
+			},
+			expected: []*xds_route.RateLimit{
+				{
+					Actions: []*xds_route.RateLimit_Action{
+						{
+							ActionSpecifier: &xds_route.RateLimit_Action_DestinationCluster_{
+								DestinationCluster: &xds_route.RateLimit_Action_DestinationCluster{},
+							},
+						},
+						{
+							ActionSpecifier: &xds_route.RateLimit_Action_RemoteAddress_{
+								RemoteAddress: &xds_route.RateLimit_Action_RemoteAddress{},
+							},
+						},
+					},
+				},
+				{
+					Actions: []*xds_route.RateLimit_Action{
+						{
+							ActionSpecifier: &xds_route.RateLimit_Action_RequestHeaders_{
+								RequestHeaders: &xds_route.RateLimit_Action_RequestHeaders{
+									HeaderName:    "x-user-id",
+									DescriptorKey: "x-user-id",
+								},
+							},
+						},
+						{
+							ActionSpecifier: &xds_route.RateLimit_Action_GenericKey_{
+								GenericKey: &xds_route.RateLimit_Action_GenericKey{
+									DescriptorKey:   "tier",
+									DescriptorValue: "free",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "descriptor with only invalid entries is skipped",
+			globalRateLimitConfig: ratelimit.GlobalRateLimitConfig{
+				Enable: true,
+				Descriptors: []configv1alpha1.GlobalRateLimitDescriptorSpec{
+					{
+						Entries: []configv1alpha1.GlobalRateLimitDescriptorEntrySpec{
+							{Type: "invalid"},
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := buildGlobalRateLimits(tc.globalRateLimitConfig)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
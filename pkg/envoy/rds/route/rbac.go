@@ -1,6 +1,8 @@
 package route
 
 import (
+	"fmt"
+
 	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -8,8 +10,8 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/envoy/rbac"
-
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
@@ -21,11 +23,26 @@ const (
 // buildInboundRBACFilterForRule builds an HTTP RBAC per route filter based on the given traffic policy rule.
 // The principals in the RBAC policy are derived from the allowed service accounts specified in the given rule.
 // The permissions in the RBAC policy are implicitly set to ANY (all permissions).
+// A rule allowing no service accounts results in an RBAC filter without policies, which denies all the requests.
 func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule) (map[string]*any.Any, error) {
 	if rule.AllowedServiceAccounts == nil {
 		return nil, errors.Errorf("traffipolicy.Rule.AllowedServiceAccounts not set")
 	}
 
+	if rule.AllowedServiceAccounts.Cardinality() == 0 {
+		marshalled, err := ptypes.MarshalAny(&xds_http_rbac.RBACPerRoute{
+			Rbac: &xds_http_rbac.RBAC{
+				Rules: &xds_rbac.RBAC{
+					Action: xds_rbac.RBAC_ALLOW,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]*any.Any{wellknown.HTTPRoleBasedAccessControl: marshalled}, nil
+	}
+
 	policy := &rbac.Policy{}
 
 	// Create the list of principals for this policy
@@ -56,14 +73,11 @@ func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule) (map[string]*any.An
 
 	policy.Principals = principalRuleList
 
-	rbacPolicy, err := policy.Generate()
+	rbacPolicyMap, err := buildRBACPoliciesForConditions(policy, rule.AuthorizationConditions)
 	if err != nil {
 		return nil, err
 	}
 
-	// A single RBAC policy per route
-	rbacPolicyMap := map[string]*xds_rbac.Policy{rbacPerRoutePolicyName: rbacPolicy}
-
 	// Map generic RBAC policy to HTTP RBAC policy
 	httpRBAC := &xds_http_rbac.RBAC{
 		Rules: &xds_rbac.RBAC{
@@ -71,6 +85,21 @@ func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule) (map[string]*any.An
 			Policies: rbacPolicyMap,
 		},
 	}
+
+	// The shadow rules are evaluated as if the shadow authorization conditions were enforced in addition to the
+	// authorization conditions, the requests they would deny are reported in the stats and access logs
+	if len(rule.ShadowAuthorizationConditions) != 0 {
+		shadowConditions := append(append([]policyv1alpha1.AuthorizationConditions{}, rule.AuthorizationConditions...), rule.ShadowAuthorizationConditions...)
+		shadowPolicyMap, err := buildRBACPoliciesForConditions(policy, shadowConditions)
+		if err != nil {
+			return nil, err
+		}
+		httpRBAC.ShadowRules = &xds_rbac.RBAC{
+			Action:   xds_rbac.RBAC_ALLOW,
+			Policies: shadowPolicyMap,
+		}
+	}
+
 	httpRBACPerRoute := &xds_http_rbac.RBACPerRoute{
 		Rbac: httpRBAC,
 	}
@@ -83,3 +112,67 @@ func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule) (map[string]*any.An
 	rbacFilter := map[string]*any.Any{wellknown.HTTPRoleBasedAccessControl: marshalled}
 	return rbacFilter, nil
 }
+
+// buildRBACPoliciesForConditions returns the RBAC policies for the principals of the given policy and the given authorization conditions.
+// A single RBAC policy is returned when no conditions are given, otherwise an RBAC policy is returned per authorization conditions,
+// such that the request is allowed if it matches any of the policies.
+func buildRBACPoliciesForConditions(policy *rbac.Policy, authzConditionsList []policyv1alpha1.AuthorizationConditions) (map[string]*xds_rbac.Policy, error) {
+	rbacPolicyMap := make(map[string]*xds_rbac.Policy)
+	if len(authzConditionsList) == 0 {
+		rbacPolicy, err := policy.Generate()
+		if err != nil {
+			return nil, err
+		}
+
+		// A single RBAC policy per route
+		rbacPolicyMap[rbacPerRoutePolicyName] = rbacPolicy
+		return rbacPolicyMap, nil
+	}
+
+	for i, authzConditions := range authzConditionsList {
+		conditionsPolicy := &rbac.Policy{
+			Principals: policy.Principals,
+			Conditions: getRBACConditions(authzConditions),
+		}
+
+		rbacPolicy, err := conditionsPolicy.Generate()
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error generating RBAC policy for authorization conditions %v", authzConditions)
+		}
+		rbacPolicyMap[fmt.Sprintf("%s-%d", rbacPerRoutePolicyName, i)] = rbacPolicy
+	}
+
+	return rbacPolicyMap, nil
+}
+
+// getRBACConditions returns the RBAC policy conditions corresponding to the given authorization conditions.
+// Each condition is matched if the request matches any of the values of the condition.
+func getRBACConditions(authzConditions policyv1alpha1.AuthorizationConditions) []rbac.RulesList {
+	var conditions []rbac.RulesList
+
+	for _, claim := range authzConditions.Claims {
+		var claimRules rbac.RulesList
+		for _, value := range claim.Values {
+			claimRules.OrRules = append(claimRules.OrRules, rbac.Rule{Attribute: rbac.JWTClaim, Name: claim.Name, Value: value})
+		}
+		conditions = append(conditions, claimRules)
+	}
+
+	if len(authzConditions.SourceIPBlocks) != 0 {
+		var sourceIPRules rbac.RulesList
+		for _, ipBlock := range authzConditions.SourceIPBlocks {
+			sourceIPRules.OrRules = append(sourceIPRules.OrRules, rbac.Rule{Attribute: rbac.DownstreamDirectRemoteIP, Value: ipBlock})
+		}
+		conditions = append(conditions, sourceIPRules)
+	}
+
+	for _, header := range authzConditions.Headers {
+		var headerRules rbac.RulesList
+		for _, value := range header.Values {
+			headerRules.OrRules = append(headerRules.OrRules, rbac.Rule{Attribute: rbac.RequestHeader, Name: header.Name, Value: value})
+		}
+		conditions = append(conditions, headerRules)
+	}
+
+	return conditions
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	tassert "github.com/stretchr/testify/assert"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/envoy/rbac"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/tests"
@@ -132,3 +133,157 @@ func TestBuildInboundRBACFilterForRule(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildInboundRBACFilterForRuleWithoutAllowedServiceAccounts(t *testing.T) {
+	assert := tassert.New(t)
+
+	rbacFilter, err := buildInboundRBACFilterForRule(&trafficpolicy.Rule{
+		Route: trafficpolicy.RouteWeightedClusters{
+			HTTPRouteMatch:   tests.BookstoreBuyHTTPRoute,
+			WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
+		},
+		AllowedServiceAccounts: mapset.NewSet(),
+		AuthorizationConditions: []policyv1alpha1.AuthorizationConditions{
+			{SourceIPBlocks: []string{"10.0.0.0/8"}},
+		},
+	})
+	assert.Nil(err)
+
+	httpRBACPerRoute := &xds_http_rbac.RBACPerRoute{}
+	assert.Nil(ptypes.UnmarshalAny(rbacFilter[wellknown.HTTPRoleBasedAccessControl], httpRBACPerRoute))
+
+	// An ALLOW action without policies denies all the requests
+	assert.Equal(xds_rbac.RBAC_ALLOW, httpRBACPerRoute.Rbac.Rules.Action)
+	assert.Empty(httpRBACPerRoute.Rbac.Rules.Policies)
+}
+
+func TestBuildInboundRBACFilterForRuleWithAuthorizationConditions(t *testing.T) {
+	assert := tassert.New(t)
+
+	rule := &trafficpolicy.Rule{
+		Route: trafficpolicy.RouteWeightedClusters{
+			HTTPRouteMatch:   tests.BookstoreBuyHTTPRoute,
+			WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
+		},
+		AllowedServiceAccounts: mapset.NewSetFromSlice([]interface{}{
+			identity.K8sServiceAccount{Name: "foo", Namespace: "ns-1"},
+		}),
+		AuthorizationConditions: []policyv1alpha1.AuthorizationConditions{
+			{
+				Claims: []policyv1alpha1.ClaimCondition{{Name: "role", Values: []string{"admin"}}},
+			},
+			{
+				SourceIPBlocks: []string{"10.0.0.0/16", "10.1.0.0/16"},
+				Headers:        []policyv1alpha1.HeaderCondition{{Name: "x-tenant", Values: []string{"a"}}},
+			},
+		},
+	}
+
+	rbacFilter, err := buildInboundRBACFilterForRule(rule)
+	assert.Nil(err)
+
+	httpRBACPerRoute := &xds_http_rbac.RBACPerRoute{}
+	err = ptypes.UnmarshalAny(rbacFilter[wellknown.HTTPRoleBasedAccessControl], httpRBACPerRoute)
+	assert.Nil(err)
+
+	// A policy per authorization conditions, without the unconditional policy
+	policies := httpRBACPerRoute.Rbac.Rules.Policies
+	assert.Len(policies, 2)
+	assert.NotContains(policies, rbacPerRoutePolicyName)
+
+	downstreamPrincipal := &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_OrIds{
+			OrIds: &xds_rbac.Principal_Set{
+				Ids: []*xds_rbac.Principal{rbac.GetAuthenticatedPrincipal("foo.ns-1.cluster.local")},
+			},
+		},
+	}
+
+	claimPolicy := policies[rbacPerRoutePolicyName+"-0"]
+	assert.Len(claimPolicy.Principals, 1)
+	claimPrincipals := claimPolicy.Principals[0].GetAndIds().Ids
+	assert.Len(claimPrincipals, 2)
+	assert.Equal(downstreamPrincipal, claimPrincipals[0])
+	assert.Equal(rbac.GetJWTClaimPrincipal("role", "admin"), claimPrincipals[1].GetOrIds().Ids[0])
+
+	ipAndHeaderPolicy := policies[rbacPerRoutePolicyName+"-1"]
+	assert.Len(ipAndHeaderPolicy.Principals, 1)
+	ipAndHeaderPrincipals := ipAndHeaderPolicy.Principals[0].GetAndIds().Ids
+	assert.Len(ipAndHeaderPrincipals, 3)
+	assert.Equal(downstreamPrincipal, ipAndHeaderPrincipals[0])
+	assert.Len(ipAndHeaderPrincipals[1].GetOrIds().Ids, 2)
+	assert.Equal(rbac.GetHeaderPrincipal("x-tenant", "a"), ipAndHeaderPrincipals[2].GetOrIds().Ids[0])
+}
+
+func TestBuildInboundRBACFilterForRuleWithShadowAuthorizationConditions(t *testing.T) {
+	assert := tassert.New(t)
+
+	rule := &trafficpolicy.Rule{
+		Route: trafficpolicy.RouteWeightedClusters{
+			HTTPRouteMatch:   tests.BookstoreBuyHTTPRoute,
+			WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
+		},
+		AllowedServiceAccounts: mapset.NewSetFromSlice([]interface{}{
+			identity.K8sServiceAccount{Name: "foo", Namespace: "ns-1"},
+		}),
+		ShadowAuthorizationConditions: []policyv1alpha1.AuthorizationConditions{
+			{
+				Claims: []policyv1alpha1.ClaimCondition{{Name: "role", Values: []string{"admin"}}},
+			},
+		},
+	}
+
+	rbacFilter, err := buildInboundRBACFilterForRule(rule)
+	assert.Nil(err)
+
+	httpRBACPerRoute := &xds_http_rbac.RBACPerRoute{}
+	err = ptypes.UnmarshalAny(rbacFilter[wellknown.HTTPRoleBasedAccessControl], httpRBACPerRoute)
+	assert.Nil(err)
+
+	// The enforced rules only allow the downstream principal
+	policies := httpRBACPerRoute.Rbac.Rules.Policies
+	assert.Len(policies, 1)
+	assert.Contains(policies, rbacPerRoutePolicyName)
+
+	// The shadow rules additionally evaluate the shadow authorization conditions
+	assert.Equal(xds_rbac.RBAC_ALLOW, httpRBACPerRoute.Rbac.ShadowRules.Action)
+	shadowPolicies := httpRBACPerRoute.Rbac.ShadowRules.Policies
+	assert.Len(shadowPolicies, 1)
+	claimPrincipals := shadowPolicies[rbacPerRoutePolicyName+"-0"].Principals[0].GetAndIds().Ids
+	assert.Len(claimPrincipals, 2)
+	assert.Equal(rbac.GetJWTClaimPrincipal("role", "admin"), claimPrincipals[1].GetOrIds().Ids[0])
+}
+
+func TestGetRBACConditions(t *testing.T) {
+	assert := tassert.New(t)
+
+	conditions := getRBACConditions(policyv1alpha1.AuthorizationConditions{
+		Claims: []policyv1alpha1.ClaimCondition{
+			{Name: "role", Values: []string{"admin", "owner"}},
+			{Name: "tenant", Values: []string{"a"}},
+		},
+		SourceIPBlocks: []string{"10.0.0.0/16"},
+		Headers:        []policyv1alpha1.HeaderCondition{{Name: "x-tenant", Values: []string{"a"}}},
+	})
+
+	expected := []rbac.RulesList{
+		{
+			OrRules: []rbac.Rule{
+				{Attribute: rbac.JWTClaim, Name: "role", Value: "admin"},
+				{Attribute: rbac.JWTClaim, Name: "role", Value: "owner"},
+			},
+		},
+		{
+			OrRules: []rbac.Rule{{Attribute: rbac.JWTClaim, Name: "tenant", Value: "a"}},
+		},
+		{
+			OrRules: []rbac.Rule{{Attribute: rbac.DownstreamDirectRemoteIP, Value: "10.0.0.0/16"}},
+		},
+		{
+			OrRules: []rbac.Rule{{Attribute: rbac.RequestHeader, Name: "x-tenant", Value: "a"}},
+		},
+	}
+	assert.Equal(expected, conditions)
+
+	assert.Nil(getRBACConditions(policyv1alpha1.AuthorizationConditions{}))
+}
@@ -0,0 +1,100 @@
+package route
+
+import (
+	"net/http"
+	"regexp"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// redirectResponseCodes maps the HTTP status codes of redirects to their xds_route.RedirectAction_RedirectResponseCode
+var redirectResponseCodes = map[uint32]xds_route.RedirectAction_RedirectResponseCode{
+	http.StatusMovedPermanently:  xds_route.RedirectAction_MOVED_PERMANENTLY,
+	http.StatusFound:             xds_route.RedirectAction_FOUND,
+	http.StatusSeeOther:          xds_route.RedirectAction_SEE_OTHER,
+	http.StatusTemporaryRedirect: xds_route.RedirectAction_TEMPORARY_REDIRECT,
+	http.StatusPermanentRedirect: xds_route.RedirectAction_PERMANENT_REDIRECT,
+}
+
+// applyRouteRewrite sets the path and host rewrites specified on the HTTP route match on the given route
+func applyRouteRewrite(route *xds_route.Route, httpRouteMatch trafficpolicy.HTTPRouteMatch) {
+	routeAction := route.GetRoute()
+	if routeAction == nil || httpRouteMatch.Rewrite == nil {
+		return
+	}
+	rewrite := httpRouteMatch.Rewrite
+
+	switch {
+	case rewrite.PathPrefix != "" && httpRouteMatch.PathMatchType == trafficpolicy.PathMatchRegex:
+		// A prefix rewrite is only supported by Envoy for prefix path matches, so the literal
+		// prefix of the path regex is replaced using a regex rewrite instead
+		pathRegex, err := regexp.Compile(httpRouteMatch.Path)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error compiling path regex %s, skipping path prefix rewrite", httpRouteMatch.Path)
+			break
+		}
+		literalPrefix, _ := pathRegex.LiteralPrefix()
+		routeAction.RegexRewrite = buildRegexRewrite("^"+regexp.QuoteMeta(literalPrefix), rewrite.PathPrefix)
+
+	case rewrite.PathPrefix != "":
+		routeAction.PrefixRewrite = rewrite.PathPrefix
+
+	case rewrite.PathRegex != "":
+		routeAction.RegexRewrite = buildRegexRewrite(rewrite.PathRegex, rewrite.PathRegexSubstitution)
+	}
+
+	if rewrite.Host != "" {
+		routeAction.HostRewriteSpecifier = &xds_route.RouteAction_HostRewriteLiteral{
+			HostRewriteLiteral: rewrite.Host,
+		}
+	}
+}
+
+// buildRegexRewrite returns the xds_matcher.RegexMatchAndSubstitute replacing the given pattern with the given substitution
+func buildRegexRewrite(pattern string, substitution string) *xds_matcher.RegexMatchAndSubstitute {
+	return &xds_matcher.RegexMatchAndSubstitute{
+		Pattern: &xds_matcher.RegexMatcher{
+			EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+			Regex:      pattern,
+		},
+		Substitution: substitution,
+	}
+}
+
+// applyRouteRedirect replaces the action of the given route with the redirect specified on the HTTP route match,
+// so that the requests matching the route are redirected instead of being forwarded
+func applyRouteRedirect(route *xds_route.Route, httpRouteMatch trafficpolicy.HTTPRouteMatch) {
+	redirect := httpRouteMatch.Redirect
+	if redirect == nil {
+		return
+	}
+
+	redirectAction := &xds_route.RedirectAction{
+		HostRedirect: redirect.Host,
+		PortRedirect: redirect.Port,
+	}
+
+	if redirect.Scheme != "" {
+		redirectAction.SchemeRewriteSpecifier = &xds_route.RedirectAction_SchemeRedirect{SchemeRedirect: redirect.Scheme}
+	}
+
+	switch {
+	case redirect.Path != "":
+		redirectAction.PathRewriteSpecifier = &xds_route.RedirectAction_PathRedirect{PathRedirect: redirect.Path}
+	case redirect.PathPrefix != "":
+		redirectAction.PathRewriteSpecifier = &xds_route.RedirectAction_PrefixRewrite{PrefixRewrite: redirect.PathPrefix}
+	}
+
+	if redirect.ResponseCode != 0 {
+		responseCode, ok := redirectResponseCodes[redirect.ResponseCode]
+		if !ok {
+			log.Error().Msgf("Invalid redirect response code %d, using the default response code %d", redirect.ResponseCode, http.StatusMovedPermanently)
+		}
+		redirectAction.ResponseCode = responseCode
+	}
+
+	route.Action = &xds_route.Route_Redirect{Redirect: redirectAction}
+}
@@ -0,0 +1,127 @@
+package route
+
+import (
+	"testing"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestApplyRouteRewrite(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		httpRouteMatch        trafficpolicy.HTTPRouteMatch
+		expectedPrefixRewrite string
+		expectedRegex         string
+		expectedSubstitution  string
+		expectedHostRewrite   string
+	}{
+		{
+			name: "no rewrite",
+			httpRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/v1/books",
+				PathMatchType: trafficpolicy.PathMatchPrefix,
+			},
+		},
+		{
+			name: "prefix rewrite on prefix path match",
+			httpRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/v1/",
+				PathMatchType: trafficpolicy.PathMatchPrefix,
+				Rewrite:       &trafficpolicy.HTTPRouteRewrite{PathPrefix: "/"},
+			},
+			expectedPrefixRewrite: "/",
+		},
+		{
+			name: "prefix rewrite on regex path match replaces the literal prefix",
+			httpRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          `/v1\.0/books.*`,
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Rewrite:       &trafficpolicy.HTTPRouteRewrite{PathPrefix: "/"},
+			},
+			expectedRegex:        `^/v1\.0/books`,
+			expectedSubstitution: "/",
+		},
+		{
+			name: "regex and host rewrite",
+			httpRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/v1/.*",
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Rewrite: &trafficpolicy.HTTPRouteRewrite{
+					PathRegex:             "^/v1/(.*)$",
+					PathRegexSubstitution: `/\1`,
+					Host:                  "bookstore-v2",
+				},
+			},
+			expectedRegex:        "^/v1/(.*)$",
+			expectedSubstitution: `/\1`,
+			expectedHostRewrite:  "bookstore-v2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			route := &xds_route.Route{Action: &xds_route.Route_Route{Route: &xds_route.RouteAction{}}}
+			applyRouteRewrite(route, tc.httpRouteMatch)
+
+			routeAction := route.GetRoute()
+			assert.Equal(tc.expectedPrefixRewrite, routeAction.PrefixRewrite)
+			assert.Equal(tc.expectedRegex, routeAction.RegexRewrite.GetPattern().GetRegex())
+			assert.Equal(tc.expectedSubstitution, routeAction.RegexRewrite.GetSubstitution())
+			assert.Equal(tc.expectedHostRewrite, routeAction.GetHostRewriteLiteral())
+		})
+	}
+}
+
+func TestApplyRouteRedirect(t *testing.T) {
+	testCases := []struct {
+		name             string
+		redirect         *trafficpolicy.HTTPRouteRedirect
+		expectedRedirect *xds_route.RedirectAction
+	}{
+		{
+			name:             "no redirect",
+			redirect:         nil,
+			expectedRedirect: nil,
+		},
+		{
+			name:     "prefix redirect with permanent redirect response code",
+			redirect: &trafficpolicy.HTTPRouteRedirect{PathPrefix: "/v2/", ResponseCode: 308},
+			expectedRedirect: &xds_route.RedirectAction{
+				PathRewriteSpecifier: &xds_route.RedirectAction_PrefixRewrite{PrefixRewrite: "/v2/"},
+				ResponseCode:         xds_route.RedirectAction_PERMANENT_REDIRECT,
+			},
+		},
+		{
+			name:     "scheme, host, port and path redirect",
+			redirect: &trafficpolicy.HTTPRouteRedirect{Scheme: "https", Host: "bookstore-v2", Port: 8443, Path: "/books", ResponseCode: 302},
+			expectedRedirect: &xds_route.RedirectAction{
+				SchemeRewriteSpecifier: &xds_route.RedirectAction_SchemeRedirect{SchemeRedirect: "https"},
+				HostRedirect:           "bookstore-v2",
+				PortRedirect:           8443,
+				PathRewriteSpecifier:   &xds_route.RedirectAction_PathRedirect{PathRedirect: "/books"},
+				ResponseCode:           xds_route.RedirectAction_FOUND,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			route := &xds_route.Route{Action: &xds_route.Route_Route{Route: &xds_route.RouteAction{}}}
+			applyRouteRedirect(route, trafficpolicy.HTTPRouteMatch{Redirect: tc.redirect})
+
+			if tc.expectedRedirect == nil {
+				assert.NotNil(route.GetRoute())
+				return
+			}
+			assert.Nil(route.GetRoute())
+			assert.Equal(tc.expectedRedirect, route.GetRedirect())
+		})
+	}
+}
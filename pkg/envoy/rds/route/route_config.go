@@ -2,14 +2,19 @@ package route
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
+	"strings"
+	"time"
 
 	mapset "github.com/deckarep/golang-set"
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/envoy"
@@ -70,10 +75,16 @@ func BuildRouteConfiguration(inbound []*trafficpolicy.InboundTrafficPolicy, outb
 	// For both Inbound and Outbound routes, we will always generate the route resource stubs and send them even when empty,
 	// as it's a guarantee to be consistent with potential references from LDS.
 	// If envoy is not requesting these, they will just be ignored.
+	requestTimeout := cfg.GetRequestTimeout()
+	globalRateLimits := buildGlobalRateLimits(cfg.GetInboundGlobalRateLimitConfig())
 	inboundRouteConfig := NewRouteConfigurationStub(InboundRouteConfigName)
 	for _, in := range inbound {
 		virtualHost := buildVirtualHostStub(inboundVirtualHost, in.Name, in.Hostnames)
-		virtualHost.Routes = buildInboundRoutes(in.Rules)
+		virtualHost.Routes = buildInboundRoutes(in.Rules, in.RateLimit, in.HeaderModifier, requestTimeout)
+		virtualHost.TypedPerFilterConfig = buildVirtualHostLocalRateLimitConfig(in.RateLimit)
+		virtualHost.RateLimits = globalRateLimits
+		applyVirtualHostHeaderModifier(virtualHost, getVirtualHostHeaderModifier(in.HeaderModifier, inboundRoute))
+		applyVirtualHostCORS(virtualHost, in.CORS)
 		inboundRouteConfig.VirtualHosts = append(inboundRouteConfig.VirtualHosts, virtualHost)
 	}
 
@@ -93,7 +104,11 @@ func BuildRouteConfiguration(inbound []*trafficpolicy.InboundTrafficPolicy, outb
 
 	for _, out := range outbound {
 		virtualHost := buildVirtualHostStub(outboundVirtualHost, out.Name, out.Hostnames)
-		virtualHost.Routes = buildOutboundRoutes(out.Routes)
+		virtualHost.Routes = buildOutboundRoutes(out.Routes, out.HeaderModifier, requestTimeout)
+		applyVirtualHostHeaderModifier(virtualHost, getVirtualHostHeaderModifier(out.HeaderModifier, outboundRoute))
+		for _, route := range virtualHost.Routes {
+			applyRouteHashPolicy(route, out.LoadBalancer)
+		}
 		outboundRouteConfig.VirtualHosts = append(outboundRouteConfig.VirtualHosts, virtualHost)
 	}
 	routeConfiguration = append(routeConfiguration, outboundRouteConfig)
@@ -107,10 +122,16 @@ func BuildIngressConfiguration(ingress []*trafficpolicy.InboundTrafficPolicy, pr
 		return nil
 	}
 
+	requestTimeout := cfg.GetRequestTimeout()
+	globalRateLimits := buildGlobalRateLimits(cfg.GetInboundGlobalRateLimitConfig())
 	ingressRouteConfig := NewRouteConfigurationStub(IngressRouteConfigName)
 	for _, in := range ingress {
 		virtualHost := buildVirtualHostStub(ingressVirtualHost, in.Name, in.Hostnames)
-		virtualHost.Routes = buildInboundRoutes(in.Rules)
+		virtualHost.Routes = buildInboundRoutes(in.Rules, in.RateLimit, in.HeaderModifier, requestTimeout)
+		virtualHost.TypedPerFilterConfig = buildVirtualHostLocalRateLimitConfig(in.RateLimit)
+		virtualHost.RateLimits = globalRateLimits
+		applyVirtualHostHeaderModifier(virtualHost, getVirtualHostHeaderModifier(in.HeaderModifier, inboundRoute))
+		applyVirtualHostCORS(virtualHost, in.CORS)
 		ingressRouteConfig.VirtualHosts = append(ingressRouteConfig.VirtualHosts, virtualHost)
 	}
 
@@ -140,6 +161,7 @@ func BuildEgressRouteConfiguration(portSpecificRouteConfigs map[int][]*trafficpo
 		for _, config := range configs {
 			virtualHost := buildVirtualHostStub(egressVirtualHost, config.Name, config.Hostnames)
 			virtualHost.Routes = buildEgressRoutes(config.RoutingRules)
+			applyVirtualHostHeaderModifier(virtualHost, config.HeaderModifier)
 			routeConfig.VirtualHosts = append(routeConfig.VirtualHosts, virtualHost)
 		}
 		routeConfigs = append(routeConfigs, routeConfig)
@@ -171,7 +193,7 @@ func buildVirtualHostStub(namePrefix string, host string, domains []string) *xds
 }
 
 // buildInboundRoutes takes a route information from the given inbound traffic policy and returns a list of xds routes
-func buildInboundRoutes(rules []*trafficpolicy.Rule) []*xds_route.Route {
+func buildInboundRoutes(rules []*trafficpolicy.Rule, rateLimit *policyv1alpha1.RateLimitSpec, headerModifier *policyv1alpha1.HeaderModifierSpec, requestTimeout time.Duration) []*xds_route.Route {
 	var routes []*xds_route.Route
 	for _, rule := range rules {
 		// For a given route path, sanitize the methods in case there
@@ -186,23 +208,62 @@ func buildInboundRoutes(rules []*trafficpolicy.Rule) []*xds_route.Route {
 			continue
 		}
 
+		// Apply the local rate limit specified for the route, which overrides the virtual host's local rate limit
+		if routeRateLimit := getHTTPRouteLocalRateLimit(rateLimit, rule.Route.HTTPRouteMatch.Path); routeRateLimit != nil {
+			localRateLimitConfig, err := buildLocalRateLimitConfig(routeRateLimit)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error building local rate limit config for rule [%v], skipping rate limit", rule)
+			} else {
+				rbacPolicyForRoute[envoy.HTTPLocalRateLimitFilterName] = localRateLimitConfig
+			}
+		}
+
+		routeHeaderModifier := getHTTPRouteHeaderModifier(headerModifier, rule.Route.HTTPRouteMatch.Path, inboundRoute)
+
 		// Each HTTP method corresponds to a separate route
 		for _, method := range allowedMethods {
-			route := buildRoute(rule.Route.HTTPRouteMatch.PathMatchType, rule.Route.HTTPRouteMatch.Path, method, rule.Route.HTTPRouteMatch.Headers, rule.Route.WeightedClusters, 100, inboundRoute)
+			route := buildRoute(rule.Route.HTTPRouteMatch.PathMatchType, rule.Route.HTTPRouteMatch.Path, method, rule.Route.HTTPRouteMatch.Headers, rule.Route.WeightedClusters, 100, inboundRoute, nil)
 			route.TypedPerFilterConfig = rbacPolicyForRoute
+			applyRouteQueryParams(route, rule.Route.HTTPRouteMatch)
+			setRouteTimeouts(route, rule.Route.HTTPRouteMatch, requestTimeout)
+			applyRouteRewrite(route, rule.Route.HTTPRouteMatch)
+			applyRouteRedirect(route, rule.Route.HTTPRouteMatch)
+			applyRouteHeaderModifier(route, routeHeaderModifier)
 			routes = append(routes, route)
 		}
 	}
 	return routes
 }
 
-func buildOutboundRoutes(outRoutes []*trafficpolicy.RouteWeightedClusters) []*xds_route.Route {
-	var routes []*xds_route.Route
+func buildOutboundRoutes(outRoutes []*trafficpolicy.RouteWeightedClusters, headerModifier *policyv1alpha1.HeaderModifierSpec, requestTimeout time.Duration) []*xds_route.Route {
+	var matchRoutes []*xds_route.Route
+	var wildcardRoutes []*xds_route.Route
 	for _, outRoute := range outRoutes {
-		emptyHeaders := map[string]string{}
-		routes = append(routes, buildRoute(trafficpolicy.PathMatchRegex, constants.RegexMatchAll, constants.WildcardHTTPMethod, emptyHeaders, outRoute.WeightedClusters, outRoute.TotalClustersWeight(), outboundRoute))
+		routeHeaderModifier := getHTTPRouteHeaderModifier(headerModifier, outRoute.HTTPRouteMatch.Path, outboundRoute)
+
+		// Each HTTP method corresponds to a separate route
+		var routes []*xds_route.Route
+		for _, method := range sanitizeHTTPMethods(outRoute.HTTPRouteMatch.Methods) {
+			route := buildRoute(outRoute.HTTPRouteMatch.PathMatchType, outRoute.HTTPRouteMatch.Path, method, outRoute.HTTPRouteMatch.Headers, outRoute.WeightedClusters, outRoute.TotalClustersWeight(), outboundRoute, outRoute.RetryPolicy)
+			route.TypedPerFilterConfig = buildRouteFaultInjectionConfig(outRoute.FaultInjection)
+			applyRouteQueryParams(route, outRoute.HTTPRouteMatch)
+			setRouteTimeouts(route, outRoute.HTTPRouteMatch, requestTimeout)
+			applyRouteRewrite(route, outRoute.HTTPRouteMatch)
+			applyRouteRedirect(route, outRoute.HTTPRouteMatch)
+			applyRouteMirror(route, outRoute.Mirror)
+			applyRouteHeaderModifier(route, routeHeaderModifier)
+			routes = append(routes, route)
+		}
+
+		// The routes matching specific requests, such as the routes for the matches of a TrafficSplit or a
+		// FaultInjection policy, must precede the wildcard routes matching all the requests
+		if reflect.DeepEqual(outRoute.HTTPRouteMatch, trafficpolicy.WildCardRouteMatch) {
+			wildcardRoutes = append(wildcardRoutes, routes...)
+		} else {
+			matchRoutes = append(matchRoutes, routes...)
+		}
 	}
-	return routes
+	return append(matchRoutes, wildcardRoutes...)
 }
 
 func buildEgressRoutes(routingRules []*trafficpolicy.EgressHTTPRoutingRule) []*xds_route.Route {
@@ -215,14 +276,14 @@ func buildEgressRoutes(routingRules []*trafficpolicy.EgressHTTPRoutingRule) []*x
 		// Build the route for the given egress routing rule and method
 		// Each HTTP method corresponds to a separate route
 		for _, httpMethod := range allowedHTTPMethods {
-			route := buildRoute(rule.Route.HTTPRouteMatch.PathMatchType, rule.Route.HTTPRouteMatch.Path, httpMethod, nil, rule.Route.WeightedClusters, rule.Route.TotalClustersWeight(), outboundRoute)
+			route := buildRoute(rule.Route.HTTPRouteMatch.PathMatchType, rule.Route.HTTPRouteMatch.Path, httpMethod, nil, rule.Route.WeightedClusters, rule.Route.TotalClustersWeight(), outboundRoute, nil)
 			routes = append(routes, route)
 		}
 	}
 	return routes
 }
 
-func buildRoute(pathMatchTypeType trafficpolicy.PathMatchType, path string, method string, headersMap map[string]string, weightedClusters mapset.Set, totalWeight int, direction Direction) *xds_route.Route {
+func buildRoute(pathMatchTypeType trafficpolicy.PathMatchType, path string, method string, headersMap map[string]string, weightedClusters mapset.Set, totalWeight int, direction Direction, retryPolicy *policyv1alpha1.RetryPolicySpec) *xds_route.Route {
 	route := xds_route.Route{
 		Match: &xds_route.RouteMatch{
 			Headers: getHeadersForRoute(method, headersMap),
@@ -232,6 +293,7 @@ func buildRoute(pathMatchTypeType trafficpolicy.PathMatchType, path string, meth
 				ClusterSpecifier: &xds_route.RouteAction_WeightedClusters{
 					WeightedClusters: buildWeightedCluster(weightedClusters, totalWeight, direction),
 				},
+				RetryPolicy: buildRetryPolicy(retryPolicy),
 			},
 		},
 	}
@@ -285,6 +347,61 @@ func buildWeightedCluster(weightedClusters mapset.Set, totalWeight int, directio
 	return &wc
 }
 
+// setRouteTimeouts sets the request and idle timeouts on the given route. The timeouts specified on the HTTP route match
+// take precedence over the given mesh-wide request timeout.
+func setRouteTimeouts(route *xds_route.Route, httpRouteMatch trafficpolicy.HTTPRouteMatch, requestTimeout time.Duration) {
+	routeAction := route.GetRoute()
+	if routeAction == nil {
+		return
+	}
+
+	if httpRouteMatch.Timeout != nil {
+		requestTimeout = *httpRouteMatch.Timeout
+	}
+	routeAction.Timeout = ptypes.DurationProto(requestTimeout)
+
+	if httpRouteMatch.IdleTimeout != nil {
+		routeAction.IdleTimeout = ptypes.DurationProto(*httpRouteMatch.IdleTimeout)
+	}
+}
+
+// buildRetryPolicy returns the xds_route.RetryPolicy corresponding to the given RetryPolicySpec,
+// or nil if no retry policy is specified
+func buildRetryPolicy(retryPolicy *policyv1alpha1.RetryPolicySpec) *xds_route.RetryPolicy {
+	if retryPolicy == nil {
+		return nil
+	}
+
+	rp := &xds_route.RetryPolicy{
+		RetryOn: getRetryOn(retryPolicy),
+	}
+	if retryPolicy.NumRetries != nil {
+		rp.NumRetries = &wrappers.UInt32Value{Value: *retryPolicy.NumRetries}
+	}
+	if retryPolicy.PerTryTimeout != nil {
+		rp.PerTryTimeout = ptypes.DurationProto(retryPolicy.PerTryTimeout.Duration)
+	}
+	if retryPolicy.RetryBackoffBaseInterval != nil {
+		rp.RetryBackOff = &xds_route.RetryPolicy_RetryBackOff{
+			BaseInterval: ptypes.DurationProto(retryPolicy.RetryBackoffBaseInterval.Duration),
+		}
+	}
+
+	return rp
+}
+
+// getRetryOn returns the comma delimited list of retry conditions for the given RetryPolicySpec,
+// combining the HTTP conditions in RetryOn with the gRPC status conditions in GRPCRetryOn
+func getRetryOn(retryPolicy *policyv1alpha1.RetryPolicySpec) string {
+	var conditions []string
+	if retryPolicy.RetryOn != "" {
+		conditions = append(conditions, retryPolicy.RetryOn)
+	}
+	conditions = append(conditions, retryPolicy.GRPCRetryOn...)
+
+	return strings.Join(conditions, ",")
+}
+
 // sanitizeHTTPMethods takes in a list of HTTP methods including a wildcard (*) and returns a wildcard if any of
 // the methods is a wildcard or sanitizes the input list to avoid duplicates.
 func sanitizeHTTPMethods(allowedMethods []string) []string {
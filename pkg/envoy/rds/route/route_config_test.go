@@ -3,19 +3,25 @@ package route
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	mapset "github.com/deckarep/golang-set"
 	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/envoy"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/ratelimit"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/tests"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
@@ -24,6 +30,8 @@ import (
 func TestBuildRouteConfiguration(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockCfg.EXPECT().GetRequestTimeout().Return(15 * time.Second).AnyTimes()
+	mockCfg.EXPECT().GetInboundGlobalRateLimitConfig().Return(ratelimit.GlobalRateLimitConfig{}).AnyTimes()
 
 	testInbound := &trafficpolicy.InboundTrafficPolicy{
 		Name:      "bookstore-v1-default",
@@ -136,6 +144,8 @@ func TestBuildRouteConfiguration(t *testing.T) {
 func TestBuildIngressRouteConfiguration(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockCfg.EXPECT().GetRequestTimeout().Return(15 * time.Second).AnyTimes()
+	mockCfg.EXPECT().GetInboundGlobalRateLimitConfig().Return(ratelimit.GlobalRateLimitConfig{}).AnyTimes()
 
 	testCases := []struct {
 		name                      string
@@ -275,10 +285,13 @@ func TestBuildInboundRoutes(t *testing.T) {
 		ClusterName: "default/testCluster/local",
 		Weight:      100,
 	}
+	zeroTimeout := time.Duration(0)
+	idleTimeout := 10 * time.Minute
 
 	testCases := []struct {
 		name       string
 		inputRules []*trafficpolicy.Rule
+		rateLimit  *policyv1alpha1.RateLimitSpec
 		expectFunc func(assert *tassert.Assertions, actual []*xds_route.Route)
 	}{
 		{
@@ -308,6 +321,82 @@ func TestBuildInboundRoutes(t *testing.T) {
 				assert.Equal("default/testCluster/local-local", actual[0].GetRoute().GetWeightedClusters().Clusters[0].Name)
 				assert.Equal(uint32(100), actual[0].GetRoute().GetWeightedClusters().Clusters[0].Weight.GetValue())
 				assert.NotNil(actual[0].TypedPerFilterConfig)
+				assert.Equal(ptypes.DurationProto(15*time.Second), actual[0].GetRoute().Timeout)
+				assert.Nil(actual[0].GetRoute().IdleTimeout)
+			},
+		},
+		{
+			name: "valid route rule with timeouts",
+			inputRules: []*trafficpolicy.Rule{
+				{
+					Route: trafficpolicy.RouteWeightedClusters{
+						HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+							Path:          "/events",
+							PathMatchType: trafficpolicy.PathMatchRegex,
+							Methods:       []string{"GET"},
+							Timeout:       &zeroTimeout,
+							IdleTimeout:   &idleTimeout,
+						},
+						WeightedClusters: mapset.NewSet(testWeightedCluster),
+					},
+					AllowedServiceAccounts: mapset.NewSetFromSlice(
+						[]interface{}{identity.K8sServiceAccount{Name: "foo", Namespace: "bar"}},
+					),
+				},
+			},
+			expectFunc: func(assert *tassert.Assertions, actual []*xds_route.Route) {
+				assert.Equal(1, len(actual))
+				assert.Equal(ptypes.DurationProto(0), actual[0].GetRoute().Timeout)
+				assert.Equal(ptypes.DurationProto(idleTimeout), actual[0].GetRoute().IdleTimeout)
+			},
+		},
+		{
+			name: "valid route rule with local rate limit",
+			inputRules: []*trafficpolicy.Rule{
+				{
+					Route: trafficpolicy.RouteWeightedClusters{
+						HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+							Path:          "/hello",
+							PathMatchType: trafficpolicy.PathMatchRegex,
+							Methods:       []string{"GET"},
+						},
+						WeightedClusters: mapset.NewSet(testWeightedCluster),
+					},
+					AllowedServiceAccounts: mapset.NewSetFromSlice(
+						[]interface{}{identity.K8sServiceAccount{Name: "foo", Namespace: "bar"}},
+					),
+				},
+				{
+					Route: trafficpolicy.RouteWeightedClusters{
+						HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+							Path:          "/world",
+							PathMatchType: trafficpolicy.PathMatchRegex,
+							Methods:       []string{"GET"},
+						},
+						WeightedClusters: mapset.NewSet(testWeightedCluster),
+					},
+					AllowedServiceAccounts: mapset.NewSetFromSlice(
+						[]interface{}{identity.K8sServiceAccount{Name: "foo", Namespace: "bar"}},
+					),
+				},
+			},
+			rateLimit: &policyv1alpha1.RateLimitSpec{
+				Local: &policyv1alpha1.LocalRateLimitSpec{
+					HTTPRoutes: []policyv1alpha1.HTTPRouteLocalRateLimitSpec{
+						{
+							Path: "/hello",
+							RateLimit: policyv1alpha1.HTTPLocalRateLimitSpec{
+								Requests: 10,
+								Unit:     "second",
+							},
+						},
+					},
+				},
+			},
+			expectFunc: func(assert *tassert.Assertions, actual []*xds_route.Route) {
+				assert.Equal(2, len(actual))
+				assert.Contains(actual[0].TypedPerFilterConfig, envoy.HTTPLocalRateLimitFilterName)
+				assert.NotContains(actual[1].TypedPerFilterConfig, envoy.HTTPLocalRateLimitFilterName)
 			},
 		},
 		{
@@ -334,7 +423,7 @@ func TestBuildInboundRoutes(t *testing.T) {
 
 	for i, tc := range testCases {
 		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
-			actual := buildInboundRoutes(tc.inputRules)
+			actual := buildInboundRoutes(tc.inputRules, tc.rateLimit, nil, 15*time.Second)
 			tc.expectFunc(tassert.New(t), actual)
 		})
 	}
@@ -358,14 +447,53 @@ func TestBuildOutboundRoutes(t *testing.T) {
 			WeightedClusters: mapset.NewSet(testWeightedCluster),
 		},
 	}
-	actual := buildOutboundRoutes(input)
+	actual := buildOutboundRoutes(input, nil, time.Minute)
 	assert.Equal(1, len(actual))
-	assert.Equal(".*", actual[0].GetMatch().GetSafeRegex().Regex)
-	assert.Equal(".*", actual[0].GetMatch().GetHeaders()[0].GetSafeRegexMatch().Regex)
+	assert.Equal("/hello", actual[0].GetMatch().GetSafeRegex().Regex)
+	assert.Equal("GET", actual[0].GetMatch().GetHeaders()[0].GetSafeRegexMatch().Regex)
+	assert.Equal("world", actual[0].GetMatch().GetHeaders()[1].GetSafeRegexMatch().Regex)
 	assert.Equal(1, len(actual[0].GetRoute().GetWeightedClusters().Clusters))
 	assert.Equal(uint32(100), actual[0].GetRoute().GetWeightedClusters().TotalWeight.GetValue())
 	assert.Equal("testCluster", actual[0].GetRoute().GetWeightedClusters().Clusters[0].Name)
 	assert.Equal(uint32(100), actual[0].GetRoute().GetWeightedClusters().Clusters[0].Weight.GetValue())
+	assert.Equal(ptypes.DurationProto(time.Minute), actual[0].GetRoute().Timeout)
+}
+
+func TestBuildOutboundRoutesWithFaultInjection(t *testing.T) {
+	assert := tassert.New(t)
+
+	testWeightedCluster := service.WeightedCluster{
+		ClusterName: "testCluster",
+		Weight:      100,
+	}
+	faultInjection := &policyv1alpha1.FaultInjectionSpec{
+		Host:  "testCluster.default.svc.cluster.local",
+		Abort: &policyv1alpha1.FaultAbortSpec{HTTPStatus: 503, Percentage: 10},
+	}
+	input := []*trafficpolicy.RouteWeightedClusters{
+		{
+			HTTPRouteMatch:   trafficpolicy.WildCardRouteMatch,
+			WeightedClusters: mapset.NewSet(testWeightedCluster),
+		},
+		{
+			HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/hello",
+				PathMatchType: trafficpolicy.PathMatchRegex,
+				Methods:       []string{"GET"},
+			},
+			WeightedClusters: mapset.NewSet(testWeightedCluster),
+			FaultInjection:   faultInjection,
+		},
+	}
+	actual := buildOutboundRoutes(input, nil, time.Minute)
+	assert.Equal(2, len(actual))
+
+	// The route injecting faults into the requests matching its match precedes the wildcard route
+	assert.Equal("/hello", actual[0].GetMatch().GetSafeRegex().Regex)
+	assert.Equal("GET", actual[0].GetMatch().GetHeaders()[0].GetSafeRegexMatch().Regex)
+	assert.Contains(actual[0].TypedPerFilterConfig, wellknown.Fault)
+	assert.Equal(".*", actual[1].GetMatch().GetSafeRegex().Regex)
+	assert.Nil(actual[1].TypedPerFilterConfig)
 }
 
 func TestBuildRoute(t *testing.T) {
@@ -617,7 +745,7 @@ func TestBuildRoute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := tassert.New(t)
 
-			actual := buildRoute(tc.pathMatchType, tc.path, tc.method, tc.headersMap, tc.weightedClusters, tc.totalWeight, tc.direction)
+			actual := buildRoute(tc.pathMatchType, tc.path, tc.method, tc.headersMap, tc.weightedClusters, tc.totalWeight, tc.direction, nil)
 
 			// Assert route.Match
 			assert.Equal(tc.expectedRoute.Match.PathSpecifier, actual.Match.PathSpecifier)
@@ -666,6 +794,76 @@ func TestBuildWeightedCluster(t *testing.T) {
 	}
 }
 
+func TestBuildRetryPolicy(t *testing.T) {
+	var numRetries uint32 = 3
+
+	testCases := []struct {
+		name        string
+		retryPolicy *policyv1alpha1.RetryPolicySpec
+		expected    *xds_route.RetryPolicy
+	}{
+		{
+			name:        "no retry policy",
+			retryPolicy: nil,
+			expected:    nil,
+		},
+		{
+			name: "retry policy with only retryOn set",
+			retryPolicy: &policyv1alpha1.RetryPolicySpec{
+				RetryOn: "5xx",
+			},
+			expected: &xds_route.RetryPolicy{
+				RetryOn: "5xx",
+			},
+		},
+		{
+			name: "retry policy with all fields set",
+			retryPolicy: &policyv1alpha1.RetryPolicySpec{
+				RetryOn:                  "5xx,connect-failure",
+				PerTryTimeout:            &metav1.Duration{Duration: time.Second},
+				NumRetries:               &numRetries,
+				RetryBackoffBaseInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+			},
+			expected: &xds_route.RetryPolicy{
+				RetryOn:       "5xx,connect-failure",
+				PerTryTimeout: ptypes.DurationProto(time.Second),
+				NumRetries:    &wrappers.UInt32Value{Value: 3},
+				RetryBackOff: &xds_route.RetryPolicy_RetryBackOff{
+					BaseInterval: ptypes.DurationProto(100 * time.Millisecond),
+				},
+			},
+		},
+		{
+			name: "retry policy with gRPC status conditions",
+			retryPolicy: &policyv1alpha1.RetryPolicySpec{
+				RetryOn:     "connect-failure",
+				GRPCRetryOn: []string{"unavailable", "deadline-exceeded"},
+			},
+			expected: &xds_route.RetryPolicy{
+				RetryOn: "connect-failure,unavailable,deadline-exceeded",
+			},
+		},
+		{
+			name: "retry policy with only gRPC status conditions",
+			retryPolicy: &policyv1alpha1.RetryPolicySpec{
+				GRPCRetryOn: []string{"cancelled"},
+			},
+			expected: &xds_route.RetryPolicy{
+				RetryOn: "cancelled",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := buildRetryPolicy(tc.retryPolicy)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
 func TestSanitizeHTTPMethods(t *testing.T) {
 	testCases := []struct {
 		name                   string
@@ -1224,6 +1422,43 @@ func TestBuildEgressRouteConfiguration(t *testing.T) {
 	}
 }
 
+func TestBuildEgressRouteConfigurationWithHeaderModifier(t *testing.T) {
+	assert := tassert.New(t)
+
+	portSpecificRouteConfigs := map[int][]*trafficpolicy.EgressHTTPRouteConfig{
+		80: {
+			{
+				Name:      "foo.com",
+				Hostnames: []string{"foo.com", "foo.com:80"},
+				RoutingRules: []*trafficpolicy.EgressHTTPRoutingRule{
+					{
+						Route: trafficpolicy.RouteWeightedClusters{
+							HTTPRouteMatch: trafficpolicy.WildCardRouteMatch,
+							WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+								service.WeightedCluster{ClusterName: service.ClusterName("foo.com:80"), Weight: 100},
+							}),
+						},
+					},
+				},
+				HeaderModifier: &policyv1alpha1.HTTPHeaderModifierSpec{
+					RequestHeadersToSet:    []policyv1alpha1.HTTPHeaderSpec{{Name: "x-egress", Value: "true"}},
+					RequestHeadersToRemove: []string{"x-internal-token"},
+				},
+			},
+		},
+	}
+
+	actual := BuildEgressRouteConfiguration(portSpecificRouteConfigs)
+	assert.Len(actual, 1)
+	assert.Len(actual[0].VirtualHosts, 1)
+
+	virtualHost := actual[0].VirtualHosts[0]
+	assert.Equal([]string{"x-internal-token"}, virtualHost.RequestHeadersToRemove)
+	assert.Len(virtualHost.RequestHeadersToAdd, 1)
+	assert.Equal("x-egress", virtualHost.RequestHeadersToAdd[0].Header.Key)
+	assert.False(virtualHost.RequestHeadersToAdd[0].Append.GetValue())
+}
+
 func TestGetEgressRouteConfigNameForPort(t *testing.T) {
 	testCases := []struct {
 		name         string
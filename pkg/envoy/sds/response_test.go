@@ -114,7 +114,7 @@ func TestGetRootCert(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(false).Times(1)
 				allowedInboundSvcAccounts := []identity.ServiceIdentity{
 					identity.K8sServiceAccount{Name: "sa-2", Namespace: "ns-2"}.ToServiceIdentity(),
 					identity.K8sServiceAccount{Name: "sa-3", Namespace: "ns-3"}.ToServiceIdentity(),
@@ -140,7 +140,7 @@ func TestGetRootCert(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(false).Times(1)
 				associatedSvcAccounts := []identity.ServiceIdentity{
 					identity.K8sServiceAccount{Name: "sa-2", Namespace: "ns-2"}.ToServiceIdentity(),
 					identity.K8sServiceAccount{Name: "sa-3", Namespace: "ns-2"}.ToServiceIdentity(),
@@ -169,7 +169,7 @@ func TestGetRootCert(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(true).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(true).Times(1)
 				d.mockCertificater.EXPECT().GetIssuingCA().Return([]byte("foo")).Times(1)
 			},
 
@@ -189,7 +189,7 @@ func TestGetRootCert(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(false).Times(1)
 				d.mockCertificater.EXPECT().GetIssuingCA().Return([]byte("foo")).Times(1)
 			},
 
@@ -316,7 +316,7 @@ func TestGetSDSSecrets(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(false).Times(1)
 				allowedInboundSvcAccounts := []identity.ServiceIdentity{
 					identity.K8sServiceAccount{Name: "sa-2", Namespace: "ns-2"}.ToServiceIdentity(),
 					identity.K8sServiceAccount{Name: "sa-3", Namespace: "ns-3"}.ToServiceIdentity(),
@@ -340,7 +340,7 @@ func TestGetSDSSecrets(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(false).Times(1)
 				associatedSvcAccounts := []identity.ServiceIdentity{
 					identity.K8sServiceAccount{Name: "sa-2", Namespace: "ns-2"}.ToServiceIdentity(),
 					identity.K8sServiceAccount{Name: "sa-3", Namespace: "ns-2"}.ToServiceIdentity(),
@@ -369,7 +369,7 @@ func TestGetSDSSecrets(t *testing.T) {
 			serviceIdentity: identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
 
 			prepare: func(d *dynamicMock) {
-				d.mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).Times(1)
+				d.mockCatalog.EXPECT().IsPermissiveTrafficPolicyMode(gomock.Any()).Return(false).Times(1)
 				d.mockCertificater.EXPECT().GetIssuingCA().Return([]byte("foo")).Times(1)
 			},
 
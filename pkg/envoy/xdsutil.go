@@ -3,12 +3,14 @@ package envoy
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_accesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
 	xds_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	extensions_upstream_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	structpb "github.com/golang/protobuf/ptypes/struct"
@@ -52,6 +54,21 @@ const (
 
 	// AccessLoggerName is name used for the envoy access loggers.
 	AccessLoggerName = "envoy.access_loggers.stream"
+
+	// HTTPLocalRateLimitFilterName is the name of the HTTP local rate limit filter
+	HTTPLocalRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+
+	// HTTPLocalRateLimitStatPrefix is the stat prefix used by the HTTP local rate limit filter and its per route configs
+	HTTPLocalRateLimitStatPrefix = "inbound-http-local-rate-limit"
+
+	// TCPLocalRateLimitFilterName is the name of the network local rate limit filter
+	TCPLocalRateLimitFilterName = "envoy.filters.network.local_ratelimit"
+
+	// HTTPJWTAuthnFilterName is the name of the HTTP JWT authentication filter
+	HTTPJWTAuthnFilterName = "envoy.filters.http.jwt_authn"
+
+	// JWTPayloadMetadataKey is the key of the verified JWT payload in the dynamic metadata of the HTTP JWT authentication filter
+	JWTPayloadMetadataKey = "jwt_payload"
 )
 
 // ALPNInMesh indicates that the proxy is connecting to an in-mesh destination.
@@ -123,6 +140,7 @@ func getStdoutAccessLog() *xds_accesslog.StdoutAccessLog {
 							"requested_server_name": pbStringValue("%REQUESTED_SERVER_NAME%"),
 							"authority":             pbStringValue(`%REQ(:AUTHORITY)%`),
 							"upstream_host":         pbStringValue(`%UPSTREAM_HOST%`),
+							"rbac_shadow_result":    pbStringValue(`%DYNAMIC_METADATA(envoy.filters.http.rbac:shadow_engine_result)%`),
 						},
 					},
 				},
@@ -240,6 +258,28 @@ func GetHTTP2ProtocolOptions() (map[string]*any.Any, error) {
 	}, nil
 }
 
+// GetLocalRateLimitTokenBucket returns the token bucket used by the local rate limit filters to allow the given
+// number of tokens per time unit, with an additional burst of tokens
+func GetLocalRateLimitTokenBucket(tokens uint32, burst uint32, unit string) (*xds_type.TokenBucket, error) {
+	var fillInterval time.Duration
+	switch unit {
+	case "second":
+		fillInterval = time.Second
+	case "minute":
+		fillInterval = time.Minute
+	case "hour":
+		fillInterval = time.Hour
+	default:
+		return nil, errors.Errorf("Invalid rate limit unit %q, must be one of second, minute or hour", unit)
+	}
+
+	return &xds_type.TokenBucket{
+		MaxTokens:     tokens + burst,
+		TokensPerFill: &wrappers.UInt32Value{Value: tokens},
+		FillInterval:  ptypes.DurationProto(fillInterval),
+	}, nil
+}
+
 // GetADSConfigSource creates an Envoy ConfigSource struct.
 func GetADSConfigSource() *xds_core.ConfigSource {
 	return &xds_core.ConfigSource{
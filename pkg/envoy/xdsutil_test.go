@@ -2,11 +2,14 @@ package envoy
 
 import (
 	"testing"
+	"time"
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_accesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
 	auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	tassert "github.com/stretchr/testify/assert"
@@ -61,6 +64,7 @@ func TestGetStdoutAccessLog(t *testing.T) {
 							"requested_server_name": pbStringValue("%REQUESTED_SERVER_NAME%"),
 							"authority":             pbStringValue(`%REQ(:AUTHORITY)%`),
 							"upstream_host":         pbStringValue(`%UPSTREAM_HOST%`),
+							"rbac_shadow_result":    pbStringValue(`%DYNAMIC_METADATA(envoy.filters.http.rbac:shadow_engine_result)%`),
 						},
 					},
 				},
@@ -72,6 +76,69 @@ func TestGetStdoutAccessLog(t *testing.T) {
 	assert.Equal(resAccessLogger, expAccessLogger)
 }
 
+func TestGetLocalRateLimitTokenBucket(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tokens      uint32
+		burst       uint32
+		unit        string
+		expected    *xds_type.TokenBucket
+		expectedErr bool
+	}{
+		{
+			name:   "tokens per second",
+			tokens: 10,
+			unit:   "second",
+			expected: &xds_type.TokenBucket{
+				MaxTokens:     10,
+				TokensPerFill: &wrappers.UInt32Value{Value: 10},
+				FillInterval:  ptypes.DurationProto(time.Second),
+			},
+			expectedErr: false,
+		},
+		{
+			name:   "tokens per minute with burst",
+			tokens: 100,
+			burst:  20,
+			unit:   "minute",
+			expected: &xds_type.TokenBucket{
+				MaxTokens:     120,
+				TokensPerFill: &wrappers.UInt32Value{Value: 100},
+				FillInterval:  ptypes.DurationProto(time.Minute),
+			},
+			expectedErr: false,
+		},
+		{
+			name:   "tokens per hour",
+			tokens: 1000,
+			unit:   "hour",
+			expected: &xds_type.TokenBucket{
+				MaxTokens:     1000,
+				TokensPerFill: &wrappers.UInt32Value{Value: 1000},
+				FillInterval:  ptypes.DurationProto(time.Hour),
+			},
+			expectedErr: false,
+		},
+		{
+			name:        "invalid unit",
+			tokens:      10,
+			unit:        "day",
+			expected:    nil,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual, err := GetLocalRateLimitTokenBucket(tc.tokens, tc.burst, tc.unit)
+			assert.Equal(tc.expectedErr, err != nil)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
+
 var _ = Describe("Test Envoy tools", func() {
 	Context("Test GetLocalClusterNameForServiceCluster", func() {
 		It("", func() {
@@ -25,10 +25,54 @@ type FakePolicyV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakePolicyV1alpha1) AuthorizationPolicies(namespace string) v1alpha1.AuthorizationPolicyInterface {
+	return &FakeAuthorizationPolicies{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) CORSPolicies(namespace string) v1alpha1.CORSPolicyInterface {
+	return &FakeCORSPolicies{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) Denies(namespace string) v1alpha1.DenyInterface {
+	return &FakeDenies{c, namespace}
+}
+
 func (c *FakePolicyV1alpha1) Egresses(namespace string) v1alpha1.EgressInterface {
 	return &FakeEgresses{c, namespace}
 }
 
+func (c *FakePolicyV1alpha1) FaultInjections(namespace string) v1alpha1.FaultInjectionInterface {
+	return &FakeFaultInjections{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) GRPCRouteGroups(namespace string) v1alpha1.GRPCRouteGroupInterface {
+	return &FakeGRPCRouteGroups{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) HeaderModifiers(namespace string) v1alpha1.HeaderModifierInterface {
+	return &FakeHeaderModifiers{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) RateLimits(namespace string) v1alpha1.RateLimitInterface {
+	return &FakeRateLimits{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) RequestAuthentications(namespace string) v1alpha1.RequestAuthenticationInterface {
+	return &FakeRequestAuthentications{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) Retries(namespace string) v1alpha1.RetryInterface {
+	return &FakeRetries{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) TrafficMirrors(namespace string) v1alpha1.TrafficMirrorInterface {
+	return &FakeTrafficMirrors{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) UpstreamTrafficSettings(namespace string) v1alpha1.UpstreamTrafficSettingInterface {
+	return &FakeUpstreamTrafficSettings{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakePolicyV1alpha1) RESTClient() rest.Interface {
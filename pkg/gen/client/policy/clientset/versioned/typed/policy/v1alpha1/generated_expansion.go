@@ -15,4 +15,26 @@ limitations under the License.
 
 package v1alpha1
 
+type AuthorizationPolicyExpansion interface{}
+
+type CORSPolicyExpansion interface{}
+
+type DenyExpansion interface{}
+
 type EgressExpansion interface{}
+
+type FaultInjectionExpansion interface{}
+
+type GRPCRouteGroupExpansion interface{}
+
+type HeaderModifierExpansion interface{}
+
+type RateLimitExpansion interface{}
+
+type RequestAuthenticationExpansion interface{}
+
+type RetryExpansion interface{}
+
+type TrafficMirrorExpansion interface{}
+
+type UpstreamTrafficSettingExpansion interface{}
@@ -23,7 +23,18 @@ import (
 
 type PolicyV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AuthorizationPoliciesGetter
+	CORSPoliciesGetter
+	DeniesGetter
 	EgressesGetter
+	FaultInjectionsGetter
+	GRPCRouteGroupsGetter
+	HeaderModifiersGetter
+	RateLimitsGetter
+	RequestAuthenticationsGetter
+	RetriesGetter
+	TrafficMirrorsGetter
+	UpstreamTrafficSettingsGetter
 }
 
 // PolicyV1alpha1Client is used to interact with features provided by the policy.openservicemesh.io group.
@@ -31,10 +42,54 @@ type PolicyV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *PolicyV1alpha1Client) AuthorizationPolicies(namespace string) AuthorizationPolicyInterface {
+	return newAuthorizationPolicies(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) CORSPolicies(namespace string) CORSPolicyInterface {
+	return newCORSPolicies(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) Denies(namespace string) DenyInterface {
+	return newDenies(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) Egresses(namespace string) EgressInterface {
 	return newEgresses(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) FaultInjections(namespace string) FaultInjectionInterface {
+	return newFaultInjections(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) GRPCRouteGroups(namespace string) GRPCRouteGroupInterface {
+	return newGRPCRouteGroups(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) HeaderModifiers(namespace string) HeaderModifierInterface {
+	return newHeaderModifiers(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) RateLimits(namespace string) RateLimitInterface {
+	return newRateLimits(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) RequestAuthentications(namespace string) RequestAuthenticationInterface {
+	return newRequestAuthentications(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) Retries(namespace string) RetryInterface {
+	return newRetries(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) TrafficMirrors(namespace string) TrafficMirrorInterface {
+	return newTrafficMirrors(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) UpstreamTrafficSettings(namespace string) UpstreamTrafficSettingInterface {
+	return newUpstreamTrafficSettings(c, namespace)
+}
+
 // NewForConfig creates a new PolicyV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*PolicyV1alpha1Client, error) {
 	config := *c
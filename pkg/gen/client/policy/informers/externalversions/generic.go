@@ -50,8 +50,30 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=policy.openservicemesh.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("authorizationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AuthorizationPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("corspolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().CORSPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("denies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Denies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("egresses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Egresses().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("faultinjections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().FaultInjections().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("grpcroutegroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().GRPCRouteGroups().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("headermodifiers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().HeaderModifiers().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("ratelimits"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().RateLimits().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("requestauthentications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().RequestAuthentications().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("retries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Retries().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("trafficmirrors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().TrafficMirrors().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("upstreamtrafficsettings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().UpstreamTrafficSettings().Informer()}, nil
 
 	}
 
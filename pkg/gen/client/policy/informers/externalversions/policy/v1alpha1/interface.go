@@ -21,8 +21,30 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AuthorizationPolicies returns a AuthorizationPolicyInformer.
+	AuthorizationPolicies() AuthorizationPolicyInformer
+	// CORSPolicies returns a CORSPolicyInformer.
+	CORSPolicies() CORSPolicyInformer
+	// Denies returns a DenyInformer.
+	Denies() DenyInformer
 	// Egresses returns a EgressInformer.
 	Egresses() EgressInformer
+	// FaultInjections returns a FaultInjectionInformer.
+	FaultInjections() FaultInjectionInformer
+	// GRPCRouteGroups returns a GRPCRouteGroupInformer.
+	GRPCRouteGroups() GRPCRouteGroupInformer
+	// HeaderModifiers returns a HeaderModifierInformer.
+	HeaderModifiers() HeaderModifierInformer
+	// RateLimits returns a RateLimitInformer.
+	RateLimits() RateLimitInformer
+	// RequestAuthentications returns a RequestAuthenticationInformer.
+	RequestAuthentications() RequestAuthenticationInformer
+	// Retries returns a RetryInformer.
+	Retries() RetryInformer
+	// TrafficMirrors returns a TrafficMirrorInformer.
+	TrafficMirrors() TrafficMirrorInformer
+	// UpstreamTrafficSettings returns a UpstreamTrafficSettingInformer.
+	UpstreamTrafficSettings() UpstreamTrafficSettingInformer
 }
 
 type version struct {
@@ -36,7 +58,62 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AuthorizationPolicies returns a AuthorizationPolicyInformer.
+func (v *version) AuthorizationPolicies() AuthorizationPolicyInformer {
+	return &authorizationPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CORSPolicies returns a CORSPolicyInformer.
+func (v *version) CORSPolicies() CORSPolicyInformer {
+	return &cORSPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Denies returns a DenyInformer.
+func (v *version) Denies() DenyInformer {
+	return &denyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Egresses returns a EgressInformer.
 func (v *version) Egresses() EgressInformer {
 	return &egressInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// FaultInjections returns a FaultInjectionInformer.
+func (v *version) FaultInjections() FaultInjectionInformer {
+	return &faultInjectionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GRPCRouteGroups returns a GRPCRouteGroupInformer.
+func (v *version) GRPCRouteGroups() GRPCRouteGroupInformer {
+	return &gRPCRouteGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// HeaderModifiers returns a HeaderModifierInformer.
+func (v *version) HeaderModifiers() HeaderModifierInformer {
+	return &headerModifierInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RateLimits returns a RateLimitInformer.
+func (v *version) RateLimits() RateLimitInformer {
+	return &rateLimitInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RequestAuthentications returns a RequestAuthenticationInformer.
+func (v *version) RequestAuthentications() RequestAuthenticationInformer {
+	return &requestAuthenticationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Retries returns a RetryInformer.
+func (v *version) Retries() RetryInformer {
+	return &retryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TrafficMirrors returns a TrafficMirrorInformer.
+func (v *version) TrafficMirrors() TrafficMirrorInformer {
+	return &trafficMirrorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// UpstreamTrafficSettings returns a UpstreamTrafficSettingInformer.
+func (v *version) UpstreamTrafficSettings() UpstreamTrafficSettingInformer {
+	return &upstreamTrafficSettingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
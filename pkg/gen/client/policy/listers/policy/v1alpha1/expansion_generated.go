@@ -15,6 +15,30 @@ limitations under the License.
 
 package v1alpha1
 
+// AuthorizationPolicyListerExpansion allows custom methods to be added to
+// AuthorizationPolicyLister.
+type AuthorizationPolicyListerExpansion interface{}
+
+// AuthorizationPolicyNamespaceListerExpansion allows custom methods to be added to
+// AuthorizationPolicyNamespaceLister.
+type AuthorizationPolicyNamespaceListerExpansion interface{}
+
+// CORSPolicyListerExpansion allows custom methods to be added to
+// CORSPolicyLister.
+type CORSPolicyListerExpansion interface{}
+
+// CORSPolicyNamespaceListerExpansion allows custom methods to be added to
+// CORSPolicyNamespaceLister.
+type CORSPolicyNamespaceListerExpansion interface{}
+
+// DenyListerExpansion allows custom methods to be added to
+// DenyLister.
+type DenyListerExpansion interface{}
+
+// DenyNamespaceListerExpansion allows custom methods to be added to
+// DenyNamespaceLister.
+type DenyNamespaceListerExpansion interface{}
+
 // EgressListerExpansion allows custom methods to be added to
 // EgressLister.
 type EgressListerExpansion interface{}
@@ -22,3 +46,67 @@ type EgressListerExpansion interface{}
 // EgressNamespaceListerExpansion allows custom methods to be added to
 // EgressNamespaceLister.
 type EgressNamespaceListerExpansion interface{}
+
+// FaultInjectionListerExpansion allows custom methods to be added to
+// FaultInjectionLister.
+type FaultInjectionListerExpansion interface{}
+
+// FaultInjectionNamespaceListerExpansion allows custom methods to be added to
+// FaultInjectionNamespaceLister.
+type FaultInjectionNamespaceListerExpansion interface{}
+
+// GRPCRouteGroupListerExpansion allows custom methods to be added to
+// GRPCRouteGroupLister.
+type GRPCRouteGroupListerExpansion interface{}
+
+// GRPCRouteGroupNamespaceListerExpansion allows custom methods to be added to
+// GRPCRouteGroupNamespaceLister.
+type GRPCRouteGroupNamespaceListerExpansion interface{}
+
+// HeaderModifierListerExpansion allows custom methods to be added to
+// HeaderModifierLister.
+type HeaderModifierListerExpansion interface{}
+
+// HeaderModifierNamespaceListerExpansion allows custom methods to be added to
+// HeaderModifierNamespaceLister.
+type HeaderModifierNamespaceListerExpansion interface{}
+
+// RateLimitListerExpansion allows custom methods to be added to
+// RateLimitLister.
+type RateLimitListerExpansion interface{}
+
+// RateLimitNamespaceListerExpansion allows custom methods to be added to
+// RateLimitNamespaceLister.
+type RateLimitNamespaceListerExpansion interface{}
+
+// RequestAuthenticationListerExpansion allows custom methods to be added to
+// RequestAuthenticationLister.
+type RequestAuthenticationListerExpansion interface{}
+
+// RequestAuthenticationNamespaceListerExpansion allows custom methods to be added to
+// RequestAuthenticationNamespaceLister.
+type RequestAuthenticationNamespaceListerExpansion interface{}
+
+// RetryListerExpansion allows custom methods to be added to
+// RetryLister.
+type RetryListerExpansion interface{}
+
+// RetryNamespaceListerExpansion allows custom methods to be added to
+// RetryNamespaceLister.
+type RetryNamespaceListerExpansion interface{}
+
+// TrafficMirrorListerExpansion allows custom methods to be added to
+// TrafficMirrorLister.
+type TrafficMirrorListerExpansion interface{}
+
+// TrafficMirrorNamespaceListerExpansion allows custom methods to be added to
+// TrafficMirrorNamespaceLister.
+type TrafficMirrorNamespaceListerExpansion interface{}
+
+// UpstreamTrafficSettingListerExpansion allows custom methods to be added to
+// UpstreamTrafficSettingLister.
+type UpstreamTrafficSettingListerExpansion interface{}
+
+// UpstreamTrafficSettingNamespaceListerExpansion allows custom methods to be added to
+// UpstreamTrafficSettingNamespaceLister.
+type UpstreamTrafficSettingNamespaceListerExpansion interface{}
@@ -35,11 +35,12 @@ func NewKubernetesController(kubeClient kubernetes.Interface, meshName string, s
 		ServiceAccounts: client.initServiceAccountsMonitor,
 		Pods:            client.initPodMonitor,
 		Endpoints:       client.initEndpointMonitor,
+		Nodes:           client.initNodeMonitor,
 	}
 
 	// If specific informers are not selected to be initialized, initialize all informers
 	if len(selectInformers) == 0 {
-		selectInformers = []InformerKey{Namespaces, Services, ServiceAccounts, Pods, Endpoints}
+		selectInformers = []InformerKey{Namespaces, Services, ServiceAccounts, Pods, Endpoints, Nodes}
 	}
 
 	for _, informer := range selectInformers {
@@ -136,6 +137,14 @@ func (c *Client) initEndpointMonitor() {
 	c.informers[Endpoints].AddEventHandler(GetKubernetesEventHandlers((string)(Endpoints), providerName, c.shouldObserve, eptEventTypes))
 }
 
+// Initializes Node monitoring
+// Nodes are only used to look up the topology of the endpoints, and changes to the nodes are picked up
+// when the endpoints scheduled on them change, so no events are dispatched for nodes.
+func (c *Client) initNodeMonitor() {
+	informerFactory := informers.NewSharedInformerFactory(c.kubeClient, DefaultKubeEventResyncInterval)
+	c.informers[Nodes] = informerFactory.Core().V1().Nodes().Informer()
+}
+
 func (c *Client) run(stop <-chan struct{}) error {
 	log.Info().Msg("Namespace controller client started")
 	var hasSynced []cache.InformerSynced
@@ -266,6 +275,15 @@ func (c Client) GetEndpoints(svc service.MeshService) (*corev1.Endpoints, error)
 	return nil, nil
 }
 
+// GetNode returns the node with the given name if found, nil otherwise.
+func (c Client) GetNode(name string) *corev1.Node {
+	nodeIf, exists, err := c.informers[Nodes].GetStore().GetByKey(name)
+	if exists && err == nil {
+		return nodeIf.(*corev1.Node)
+	}
+	return nil
+}
+
 // ListServiceIdentitiesForService lists ServiceAccounts associated with the given service
 func (c Client) ListServiceIdentitiesForService(svc service.MeshService) ([]identity.K8sServiceAccount, error) {
 	var svcAccounts []identity.K8sServiceAccount
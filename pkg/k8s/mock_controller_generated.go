@@ -65,6 +65,20 @@ func (mr *MockControllerMockRecorder) GetNamespace(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockController)(nil).GetNamespace), arg0)
 }
 
+// GetNode mocks base method
+func (m *MockController) GetNode(arg0 string) *v1.Node {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNode", arg0)
+	ret0, _ := ret[0].(*v1.Node)
+	return ret0
+}
+
+// GetNode indicates an expected call of GetNode
+func (mr *MockControllerMockRecorder) GetNode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNode", reflect.TypeOf((*MockController)(nil).GetNode), arg0)
+}
+
 // GetService mocks base method
 func (m *MockController) GetService(arg0 service.MeshService) *v1.Service {
 	m.ctrl.T.Helper()
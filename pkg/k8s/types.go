@@ -58,6 +58,8 @@ const (
 	Endpoints InformerKey = "Endpoints"
 	// ServiceAccounts lookup identifier
 	ServiceAccounts InformerKey = "ServiceAccounts"
+	// Nodes lookup identifier
+	Nodes InformerKey = "Nodes"
 )
 
 // informerCollection is the type holding the collection of informers we keep
@@ -100,6 +102,9 @@ type Controller interface {
 	// GetEndpoints returns the endpoints for a given service, if found
 	GetEndpoints(svc service.MeshService) (*corev1.Endpoints, error)
 
+	// GetNode returns the node with the given name, if found
+	GetNode(name string) *corev1.Node
+
 	// IsMetricsEnabled returns true if the pod in the mesh is correctly annotated for prometheus scrapping
 	IsMetricsEnabled(*corev1.Pod) bool
 }
@@ -101,3 +101,23 @@ func GetKubernetesServerVersionNumber(kubeClient kubernetes.Interface) ([]int, e
 
 	return ver.Segments(), nil
 }
+
+// GetTopologyFromNode returns the region and zone of the given node from its well-known topology labels.
+// The deprecated failure-domain labels are used when the node does not have the topology labels.
+func GetTopologyFromNode(node *corev1.Node) (region string, zone string) {
+	if node == nil {
+		return "", ""
+	}
+
+	region = node.Labels[corev1.LabelTopologyRegion]
+	if region == "" {
+		region = node.Labels[corev1.LabelFailureDomainBetaRegion]
+	}
+
+	zone = node.Labels[corev1.LabelTopologyZone]
+	if zone == "" {
+		zone = node.Labels[corev1.LabelFailureDomainBetaZone]
+	}
+
+	return region, zone
+}
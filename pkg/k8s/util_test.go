@@ -6,6 +6,7 @@ import (
 
 	tassert "github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/version"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/kubernetes"
@@ -145,6 +146,64 @@ func TestGetAppProtocolFromPortName(t *testing.T) {
 	}
 }
 
+func TestGetTopologyFromNode(t *testing.T) {
+	testCases := []struct {
+		name           string
+		node           *corev1.Node
+		expectedRegion string
+		expectedZone   string
+	}{
+		{
+			name:           "nil node",
+			node:           nil,
+			expectedRegion: "",
+			expectedZone:   "",
+		},
+		{
+			name: "node with topology labels",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						corev1.LabelTopologyRegion: "us-east-1",
+						corev1.LabelTopologyZone:   "us-east-1a",
+					},
+				},
+			},
+			expectedRegion: "us-east-1",
+			expectedZone:   "us-east-1a",
+		},
+		{
+			name: "node with deprecated failure-domain labels",
+			node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						corev1.LabelFailureDomainBetaRegion: "us-west-2",
+						corev1.LabelFailureDomainBetaZone:   "us-west-2b",
+					},
+				},
+			},
+			expectedRegion: "us-west-2",
+			expectedZone:   "us-west-2b",
+		},
+		{
+			name:           "node without topology labels",
+			node:           &corev1.Node{},
+			expectedRegion: "",
+			expectedZone:   "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			region, zone := GetTopologyFromNode(tc.node)
+			assert.Equal(tc.expectedRegion, region)
+			assert.Equal(tc.expectedZone, zone)
+		})
+	}
+}
+
 func TestGetKubernetesServerVersionNumber(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
@@ -13,6 +15,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/announcements"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/service"
 )
 
 const (
@@ -21,6 +24,9 @@ const (
 
 	// egressSourceKindSvcAccount is the ServiceAccount kind for a source defined in Egress policy
 	egressSourceKindSvcAccount = "ServiceAccount"
+
+	// retrySourceKindSvcAccount is the ServiceAccount kind for a source defined in Retry policy
+	retrySourceKindSvcAccount = "ServiceAccount"
 )
 
 // NewPolicyController returns a policy.Controller interface related to functionality provided by the resources in the policy.openservicemesh.io API group
@@ -41,11 +47,33 @@ func newPolicyClient(policyClient policyV1alpha1Client.Interface, kubeController
 	informerFactory := policyV1alpha1Informers.NewSharedInformerFactory(policyClient, k8s.DefaultKubeEventResyncInterval)
 
 	informerCollection := informerCollection{
-		egress: informerFactory.Policy().V1alpha1().Egresses().Informer(),
+		egress:                 informerFactory.Policy().V1alpha1().Egresses().Informer(),
+		retry:                  informerFactory.Policy().V1alpha1().Retries().Informer(),
+		upstreamTrafficSetting: informerFactory.Policy().V1alpha1().UpstreamTrafficSettings().Informer(),
+		rateLimit:              informerFactory.Policy().V1alpha1().RateLimits().Informer(),
+		faultInjection:         informerFactory.Policy().V1alpha1().FaultInjections().Informer(),
+		headerModifier:         informerFactory.Policy().V1alpha1().HeaderModifiers().Informer(),
+		trafficMirror:          informerFactory.Policy().V1alpha1().TrafficMirrors().Informer(),
+		grpcRouteGroup:         informerFactory.Policy().V1alpha1().GRPCRouteGroups().Informer(),
+		corsPolicy:             informerFactory.Policy().V1alpha1().CORSPolicies().Informer(),
+		requestAuthentication:  informerFactory.Policy().V1alpha1().RequestAuthentications().Informer(),
+		authorizationPolicy:    informerFactory.Policy().V1alpha1().AuthorizationPolicies().Informer(),
+		deny:                   informerFactory.Policy().V1alpha1().Denies().Informer(),
 	}
 
 	cacheCollection := cacheCollection{
-		egress: informerCollection.egress.GetStore(),
+		egress:                 informerCollection.egress.GetStore(),
+		retry:                  informerCollection.retry.GetStore(),
+		upstreamTrafficSetting: informerCollection.upstreamTrafficSetting.GetStore(),
+		rateLimit:              informerCollection.rateLimit.GetStore(),
+		faultInjection:         informerCollection.faultInjection.GetStore(),
+		headerModifier:         informerCollection.headerModifier.GetStore(),
+		trafficMirror:          informerCollection.trafficMirror.GetStore(),
+		grpcRouteGroup:         informerCollection.grpcRouteGroup.GetStore(),
+		corsPolicy:             informerCollection.corsPolicy.GetStore(),
+		requestAuthentication:  informerCollection.requestAuthentication.GetStore(),
+		authorizationPolicy:    informerCollection.authorizationPolicy.GetStore(),
+		deny:                   informerCollection.deny.GetStore(),
 	}
 
 	client := client{
@@ -69,6 +97,83 @@ func newPolicyClient(policyClient policyV1alpha1Client.Interface, kubeController
 	}
 	informerCollection.egress.AddEventHandler(k8s.GetKubernetesEventHandlers("Egress", "Policy", shouldObserve, egressEventTypes))
 
+	retryEventTypes := k8s.EventTypes{
+		Add:    announcements.RetryPolicyAdded,
+		Update: announcements.RetryPolicyUpdated,
+		Delete: announcements.RetryPolicyDeleted,
+	}
+	informerCollection.retry.AddEventHandler(k8s.GetKubernetesEventHandlers("Retry", "Policy", shouldObserve, retryEventTypes))
+
+	upstreamTrafficSettingEventTypes := k8s.EventTypes{
+		Add:    announcements.UpstreamTrafficSettingAdded,
+		Update: announcements.UpstreamTrafficSettingUpdated,
+		Delete: announcements.UpstreamTrafficSettingDeleted,
+	}
+	informerCollection.upstreamTrafficSetting.AddEventHandler(k8s.GetKubernetesEventHandlers("UpstreamTrafficSetting", "Policy", shouldObserve, upstreamTrafficSettingEventTypes))
+
+	rateLimitEventTypes := k8s.EventTypes{
+		Add:    announcements.RateLimitPolicyAdded,
+		Update: announcements.RateLimitPolicyUpdated,
+		Delete: announcements.RateLimitPolicyDeleted,
+	}
+	informerCollection.rateLimit.AddEventHandler(k8s.GetKubernetesEventHandlers("RateLimit", "Policy", shouldObserve, rateLimitEventTypes))
+
+	faultInjectionEventTypes := k8s.EventTypes{
+		Add:    announcements.FaultInjectionPolicyAdded,
+		Update: announcements.FaultInjectionPolicyUpdated,
+		Delete: announcements.FaultInjectionPolicyDeleted,
+	}
+	informerCollection.faultInjection.AddEventHandler(k8s.GetKubernetesEventHandlers("FaultInjection", "Policy", shouldObserve, faultInjectionEventTypes))
+
+	headerModifierEventTypes := k8s.EventTypes{
+		Add:    announcements.HeaderModifierPolicyAdded,
+		Update: announcements.HeaderModifierPolicyUpdated,
+		Delete: announcements.HeaderModifierPolicyDeleted,
+	}
+	informerCollection.headerModifier.AddEventHandler(k8s.GetKubernetesEventHandlers("HeaderModifier", "Policy", shouldObserve, headerModifierEventTypes))
+
+	trafficMirrorEventTypes := k8s.EventTypes{
+		Add:    announcements.TrafficMirrorPolicyAdded,
+		Update: announcements.TrafficMirrorPolicyUpdated,
+		Delete: announcements.TrafficMirrorPolicyDeleted,
+	}
+	informerCollection.trafficMirror.AddEventHandler(k8s.GetKubernetesEventHandlers("TrafficMirror", "Policy", shouldObserve, trafficMirrorEventTypes))
+
+	grpcRouteGroupEventTypes := k8s.EventTypes{
+		Add:    announcements.GRPCRouteGroupAdded,
+		Update: announcements.GRPCRouteGroupUpdated,
+		Delete: announcements.GRPCRouteGroupDeleted,
+	}
+	informerCollection.grpcRouteGroup.AddEventHandler(k8s.GetKubernetesEventHandlers("GRPCRouteGroup", "Policy", shouldObserve, grpcRouteGroupEventTypes))
+
+	corsPolicyEventTypes := k8s.EventTypes{
+		Add:    announcements.CORSPolicyAdded,
+		Update: announcements.CORSPolicyUpdated,
+		Delete: announcements.CORSPolicyDeleted,
+	}
+	informerCollection.corsPolicy.AddEventHandler(k8s.GetKubernetesEventHandlers("CORSPolicy", "Policy", shouldObserve, corsPolicyEventTypes))
+
+	requestAuthenticationEventTypes := k8s.EventTypes{
+		Add:    announcements.RequestAuthenticationAdded,
+		Update: announcements.RequestAuthenticationUpdated,
+		Delete: announcements.RequestAuthenticationDeleted,
+	}
+	informerCollection.requestAuthentication.AddEventHandler(k8s.GetKubernetesEventHandlers("RequestAuthentication", "Policy", shouldObserve, requestAuthenticationEventTypes))
+
+	authorizationPolicyEventTypes := k8s.EventTypes{
+		Add:    announcements.AuthorizationPolicyAdded,
+		Update: announcements.AuthorizationPolicyUpdated,
+		Delete: announcements.AuthorizationPolicyDeleted,
+	}
+	informerCollection.authorizationPolicy.AddEventHandler(k8s.GetKubernetesEventHandlers("AuthorizationPolicy", "Policy", shouldObserve, authorizationPolicyEventTypes))
+
+	denyEventTypes := k8s.EventTypes{
+		Add:    announcements.DenyPolicyAdded,
+		Update: announcements.DenyPolicyUpdated,
+		Delete: announcements.DenyPolicyDeleted,
+	}
+	informerCollection.deny.AddEventHandler(k8s.GetKubernetesEventHandlers("Deny", "Policy", shouldObserve, denyEventTypes))
+
 	err := client.run(stop)
 	if err != nil {
 		return client, errors.Errorf("Could not start %s client: %s", apiGroup, err)
@@ -85,13 +190,24 @@ func (c client) run(stop <-chan struct{}) error {
 	}
 
 	go c.informers.egress.Run(stop)
+	go c.informers.retry.Run(stop)
+	go c.informers.upstreamTrafficSetting.Run(stop)
+	go c.informers.rateLimit.Run(stop)
+	go c.informers.faultInjection.Run(stop)
+	go c.informers.headerModifier.Run(stop)
+	go c.informers.trafficMirror.Run(stop)
+	go c.informers.grpcRouteGroup.Run(stop)
+	go c.informers.corsPolicy.Run(stop)
+	go c.informers.requestAuthentication.Run(stop)
+	go c.informers.authorizationPolicy.Run(stop)
+	go c.informers.deny.Run(stop)
 
-	log.Info().Msgf("Waiting for %s Egress informers' cache to sync", apiGroup)
-	if !cache.WaitForCacheSync(stop, c.informers.egress.HasSynced) {
+	log.Info().Msgf("Waiting for %s Egress, Retry, UpstreamTrafficSetting, RateLimit, FaultInjection, HeaderModifier, TrafficMirror, GRPCRouteGroup, CORSPolicy, RequestAuthentication, AuthorizationPolicy and Deny informers' cache to sync", apiGroup)
+	if !cache.WaitForCacheSync(stop, c.informers.egress.HasSynced, c.informers.retry.HasSynced, c.informers.upstreamTrafficSetting.HasSynced, c.informers.rateLimit.HasSynced, c.informers.faultInjection.HasSynced, c.informers.headerModifier.HasSynced, c.informers.trafficMirror.HasSynced, c.informers.grpcRouteGroup.HasSynced, c.informers.corsPolicy.HasSynced, c.informers.requestAuthentication.HasSynced, c.informers.authorizationPolicy.HasSynced, c.informers.deny.HasSynced) {
 		return errSyncingCaches
 	}
 
-	log.Info().Msgf("Cache sync finished for %s Egress informers", apiGroup)
+	log.Info().Msgf("Cache sync finished for %s Egress, Retry, UpstreamTrafficSetting, RateLimit, FaultInjection, HeaderModifier, TrafficMirror, GRPCRouteGroup, CORSPolicy, RequestAuthentication, AuthorizationPolicy and Deny informers", apiGroup)
 	return nil
 }
 
@@ -115,3 +231,265 @@ func (c client) ListEgressPoliciesForSourceIdentity(source identity.K8sServiceAc
 
 	return policies
 }
+
+// ListRetryPolicies returns the retry policies for the given source identity based on service accounts.
+func (c client) ListRetryPolicies(source identity.K8sServiceAccount) []*policyV1alpha1.Retry {
+	var retries []*policyV1alpha1.Retry
+
+	for _, retryIface := range c.caches.retry.List() {
+		retry := retryIface.(*policyV1alpha1.Retry)
+
+		if !c.kubeController.IsMonitoredNamespace(retry.Namespace) {
+			continue
+		}
+
+		if retry.Spec.Source.Kind == retrySourceKindSvcAccount && retry.Spec.Source.Name == source.Name && retry.Spec.Source.Namespace == source.Namespace {
+			retries = append(retries, retry)
+		}
+	}
+
+	return retries
+}
+
+// GetUpstreamTrafficSetting returns the UpstreamTrafficSetting policy whose host matches the given upstream service.
+// An UpstreamTrafficSetting policy only applies to services in the same namespace as the policy.
+func (c client) GetUpstreamTrafficSetting(upstreamSvc service.MeshService) *policyV1alpha1.UpstreamTrafficSetting {
+	for _, settingIface := range c.caches.upstreamTrafficSetting.List() {
+		setting := settingIface.(*policyV1alpha1.UpstreamTrafficSetting)
+
+		if setting.Namespace != upstreamSvc.Namespace || !c.kubeController.IsMonitoredNamespace(setting.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(setting.Spec.Host, upstreamSvc) {
+			return setting
+		}
+	}
+
+	return nil
+}
+
+// ListUpstreamTrafficSettings lists the UpstreamTrafficSetting policies in the monitored namespaces.
+func (c client) ListUpstreamTrafficSettings() []*policyV1alpha1.UpstreamTrafficSetting {
+	var settings []*policyV1alpha1.UpstreamTrafficSetting
+
+	for _, settingIface := range c.caches.upstreamTrafficSetting.List() {
+		setting := settingIface.(*policyV1alpha1.UpstreamTrafficSetting)
+
+		if !c.kubeController.IsMonitoredNamespace(setting.Namespace) {
+			continue
+		}
+
+		settings = append(settings, setting)
+	}
+
+	return settings
+}
+
+// GetRateLimitPolicy returns the RateLimit policy whose host matches the given service.
+// A RateLimit policy only applies to services in the same namespace as the policy.
+func (c client) GetRateLimitPolicy(svc service.MeshService) *policyV1alpha1.RateLimit {
+	for _, rateLimitIface := range c.caches.rateLimit.List() {
+		rateLimit := rateLimitIface.(*policyV1alpha1.RateLimit)
+
+		if rateLimit.Namespace != svc.Namespace || !c.kubeController.IsMonitoredNamespace(rateLimit.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(rateLimit.Spec.Host, svc) {
+			return rateLimit
+		}
+	}
+
+	return nil
+}
+
+// ListFaultInjectionPolicies returns the FaultInjection policies whose host matches the given upstream service.
+// A FaultInjection policy only applies to services in the same namespace as the policy.
+func (c client) ListFaultInjectionPolicies(upstreamSvc service.MeshService) []*policyV1alpha1.FaultInjection {
+	var faultInjections []*policyV1alpha1.FaultInjection
+
+	for _, faultInjectionIface := range c.caches.faultInjection.List() {
+		faultInjection := faultInjectionIface.(*policyV1alpha1.FaultInjection)
+
+		if faultInjection.Namespace != upstreamSvc.Namespace || !c.kubeController.IsMonitoredNamespace(faultInjection.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(faultInjection.Spec.Host, upstreamSvc) {
+			faultInjections = append(faultInjections, faultInjection)
+		}
+	}
+
+	return faultInjections
+}
+
+// GetHeaderModifierPolicy returns the HeaderModifier policy whose host matches the given service.
+// A HeaderModifier policy only applies to services in the same namespace as the policy.
+func (c client) GetHeaderModifierPolicy(svc service.MeshService) *policyV1alpha1.HeaderModifier {
+	for _, headerModifierIface := range c.caches.headerModifier.List() {
+		headerModifier := headerModifierIface.(*policyV1alpha1.HeaderModifier)
+
+		if headerModifier.Namespace != svc.Namespace || !c.kubeController.IsMonitoredNamespace(headerModifier.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(headerModifier.Spec.Host, svc) {
+			return headerModifier
+		}
+	}
+
+	return nil
+}
+
+// GetTrafficMirrorPolicy returns the TrafficMirror policy whose host matches the given root service.
+// A TrafficMirror policy only applies to services in the same namespace as the policy.
+func (c client) GetTrafficMirrorPolicy(rootSvc service.MeshService) *policyV1alpha1.TrafficMirror {
+	for _, trafficMirrorIface := range c.caches.trafficMirror.List() {
+		trafficMirror := trafficMirrorIface.(*policyV1alpha1.TrafficMirror)
+
+		if trafficMirror.Namespace != rootSvc.Namespace || !c.kubeController.IsMonitoredNamespace(trafficMirror.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(trafficMirror.Spec.Host, rootSvc) {
+			return trafficMirror
+		}
+	}
+
+	return nil
+}
+
+// ListTrafficMirrorPoliciesForBackend returns the TrafficMirror policies mirroring requests to the given backend service.
+// The backend of a TrafficMirror policy is in the same namespace as the policy.
+func (c client) ListTrafficMirrorPoliciesForBackend(backendSvc service.MeshService) []*policyV1alpha1.TrafficMirror {
+	var trafficMirrors []*policyV1alpha1.TrafficMirror
+
+	for _, trafficMirrorIface := range c.caches.trafficMirror.List() {
+		trafficMirror := trafficMirrorIface.(*policyV1alpha1.TrafficMirror)
+
+		if trafficMirror.Namespace != backendSvc.Namespace || !c.kubeController.IsMonitoredNamespace(trafficMirror.Namespace) {
+			continue
+		}
+
+		if trafficMirror.Spec.Backend == backendSvc.Name {
+			trafficMirrors = append(trafficMirrors, trafficMirror)
+		}
+	}
+
+	return trafficMirrors
+}
+
+// ListGRPCRouteGroups lists the GRPCRouteGroup resources in the monitored namespaces.
+func (c client) ListGRPCRouteGroups() []*policyV1alpha1.GRPCRouteGroup {
+	var routeGroups []*policyV1alpha1.GRPCRouteGroup
+
+	for _, routeGroupIface := range c.caches.grpcRouteGroup.List() {
+		routeGroup := routeGroupIface.(*policyV1alpha1.GRPCRouteGroup)
+
+		if !c.kubeController.IsMonitoredNamespace(routeGroup.Namespace) {
+			continue
+		}
+
+		routeGroups = append(routeGroups, routeGroup)
+	}
+
+	return routeGroups
+}
+
+// GetGRPCRouteGroup returns the GRPCRouteGroup resource with the given namespaced name of the form <namespace>/<name>,
+// or nil if it does not exist or is not in a monitored namespace.
+func (c client) GetGRPCRouteGroup(namespacedName string) *policyV1alpha1.GRPCRouteGroup {
+	routeGroupIface, exists, err := c.caches.grpcRouteGroup.GetByKey(namespacedName)
+	if !exists || err != nil {
+		return nil
+	}
+
+	routeGroup := routeGroupIface.(*policyV1alpha1.GRPCRouteGroup)
+	if !c.kubeController.IsMonitoredNamespace(routeGroup.Namespace) {
+		return nil
+	}
+
+	return routeGroup
+}
+
+// GetCORSPolicy returns the CORSPolicy policy whose host matches the given service.
+// A CORSPolicy policy only applies to services in the same namespace as the policy.
+func (c client) GetCORSPolicy(svc service.MeshService) *policyV1alpha1.CORSPolicy {
+	for _, corsPolicyIface := range c.caches.corsPolicy.List() {
+		corsPolicy := corsPolicyIface.(*policyV1alpha1.CORSPolicy)
+
+		if corsPolicy.Namespace != svc.Namespace || !c.kubeController.IsMonitoredNamespace(corsPolicy.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(corsPolicy.Spec.Host, svc) {
+			return corsPolicy
+		}
+	}
+
+	return nil
+}
+
+// GetRequestAuthenticationPolicy returns the RequestAuthentication policy whose host matches the given service.
+// A RequestAuthentication policy only applies to services in the same namespace as the policy.
+func (c client) GetRequestAuthenticationPolicy(svc service.MeshService) *policyV1alpha1.RequestAuthentication {
+	for _, requestAuthnIface := range c.caches.requestAuthentication.List() {
+		requestAuthn := requestAuthnIface.(*policyV1alpha1.RequestAuthentication)
+
+		if requestAuthn.Namespace != svc.Namespace || !c.kubeController.IsMonitoredNamespace(requestAuthn.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(requestAuthn.Spec.Host, svc) {
+			return requestAuthn
+		}
+	}
+
+	return nil
+}
+
+// GetAuthorizationPolicy returns the AuthorizationPolicy policy whose host matches the given service.
+// An AuthorizationPolicy policy only applies to services in the same namespace as the policy.
+func (c client) GetAuthorizationPolicy(svc service.MeshService) *policyV1alpha1.AuthorizationPolicy {
+	for _, authzPolicyIface := range c.caches.authorizationPolicy.List() {
+		authzPolicy := authzPolicyIface.(*policyV1alpha1.AuthorizationPolicy)
+
+		if authzPolicy.Namespace != svc.Namespace || !c.kubeController.IsMonitoredNamespace(authzPolicy.Namespace) {
+			continue
+		}
+
+		if hostMatchesService(authzPolicy.Spec.Host, svc) {
+			return authzPolicy
+		}
+	}
+
+	return nil
+}
+
+// ListDenyPolicies returns the Deny policies applied to the given destination identity based on service accounts.
+// A Deny policy applies to the service accounts in the same namespace as the policy.
+func (c client) ListDenyPolicies(destination identity.K8sServiceAccount) []*policyV1alpha1.Deny {
+	var denies []*policyV1alpha1.Deny
+
+	for _, denyIface := range c.caches.deny.List() {
+		deny := denyIface.(*policyV1alpha1.Deny)
+
+		if deny.Namespace != destination.Namespace || !c.kubeController.IsMonitoredNamespace(deny.Namespace) {
+			continue
+		}
+
+		if deny.Spec.ServiceAccount == "" || deny.Spec.ServiceAccount == destination.Name {
+			denies = append(denies, deny)
+		}
+	}
+
+	return denies
+}
+
+// hostMatchesService returns a boolean indicating if the given host, formatted as <service>.<namespace>.svc.cluster.local,
+// refers to the given service.
+func hostMatchesService(host string, svc service.MeshService) bool {
+	hostParts := strings.Split(host, ".")
+	return len(hostParts) >= 2 && hostParts[0] == svc.Name && hostParts[1] == svc.Namespace
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	tassert "github.com/stretchr/testify/assert"
@@ -13,6 +14,7 @@ import (
 	fakePolicyClient "github.com/openservicemesh/osm/pkg/gen/client/policy/clientset/versioned/fake"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/service"
 )
 
 func TestNewPolicyClient(t *testing.T) {
@@ -23,6 +25,8 @@ func TestNewPolicyClient(t *testing.T) {
 	assert.NotNil(client)
 	assert.NotNil(client.informers.egress)
 	assert.NotNil(client.caches.egress)
+	assert.NotNil(client.informers.retry)
+	assert.NotNil(client.caches.retry)
 }
 
 func TestListEgressPoliciesForSourceIdentity(t *testing.T) {
@@ -159,3 +163,857 @@ func TestListEgressPoliciesForSourceIdentity(t *testing.T) {
 		})
 	}
 }
+
+func TestListRetryPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	var numRetries uint32 = 3
+	retrySpec := policyV1alpha1.RetrySpec{
+		Source: policyV1alpha1.RetrySrcDstSpec{
+			Kind:      "ServiceAccount",
+			Name:      "sa-1",
+			Namespace: "test",
+		},
+		Destinations: []policyV1alpha1.RetrySrcDstSpec{
+			{
+				Kind:      "Service",
+				Name:      "s1",
+				Namespace: "test",
+			},
+		},
+		RetryPolicy: policyV1alpha1.RetryPolicySpec{
+			RetryOn:    "5xx",
+			NumRetries: &numRetries,
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		allRetries      []*policyV1alpha1.Retry
+		source          identity.K8sServiceAccount
+		expectedRetries []*policyV1alpha1.Retry
+	}{
+		{
+			name: "matching retry policy not found for source identity test/sa-2",
+			allRetries: []*policyV1alpha1.Retry{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "retry-1",
+						Namespace: "test",
+					},
+					Spec: retrySpec,
+				},
+			},
+			source:          identity.K8sServiceAccount{Name: "sa-2", Namespace: "test"},
+			expectedRetries: nil,
+		},
+		{
+			name: "matching retry policy found for source identity test/sa-1",
+			allRetries: []*policyV1alpha1.Retry{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "retry-1",
+						Namespace: "test",
+					},
+					Spec: retrySpec,
+				},
+			},
+			source: identity.K8sServiceAccount{Name: "sa-1", Namespace: "test"},
+			expectedRetries: []*policyV1alpha1.Retry{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "retry-1",
+						Namespace: "test",
+					},
+					Spec: retrySpec,
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake retry policies
+			for _, retryPolicy := range tc.allRetries {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().Retries(retryPolicy.Namespace).Create(context.TODO(), retryPolicy, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.ListRetryPolicies(tc.source)
+			assert.ElementsMatch(tc.expectedRetries, actual)
+		})
+	}
+}
+
+func TestGetUpstreamTrafficSetting(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	var maxConnections uint32 = 10
+	upstreamTrafficSetting := &policyV1alpha1.UpstreamTrafficSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-setting",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.UpstreamTrafficSettingSpec{
+			Host: "s1.test.svc.cluster.local",
+			ConnectionSettings: &policyV1alpha1.ConnectionSettingsSpec{
+				TCP: &policyV1alpha1.TCPConnectionSettings{
+					MaxConnections: &maxConnections,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		allSettings             []*policyV1alpha1.UpstreamTrafficSetting
+		upstreamSvc             service.MeshService
+		expectedTrafficSettings *policyV1alpha1.UpstreamTrafficSetting
+	}{
+		{
+			name:                    "matching UpstreamTrafficSetting not found for service test/s2",
+			allSettings:             []*policyV1alpha1.UpstreamTrafficSetting{upstreamTrafficSetting},
+			upstreamSvc:             service.MeshService{Name: "s2", Namespace: "test"},
+			expectedTrafficSettings: nil,
+		},
+		{
+			name: "UpstreamTrafficSetting in a different namespace than the service is ignored",
+			allSettings: []*policyV1alpha1.UpstreamTrafficSetting{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "s1-setting",
+						Namespace: "test",
+					},
+					Spec: policyV1alpha1.UpstreamTrafficSettingSpec{
+						Host: "s1.other.svc.cluster.local",
+					},
+				},
+			},
+			upstreamSvc:             service.MeshService{Name: "s1", Namespace: "other"},
+			expectedTrafficSettings: nil,
+		},
+		{
+			name:                    "matching UpstreamTrafficSetting found for service test/s1",
+			allSettings:             []*policyV1alpha1.UpstreamTrafficSetting{upstreamTrafficSetting},
+			upstreamSvc:             service.MeshService{Name: "s1", Namespace: "test"},
+			expectedTrafficSettings: upstreamTrafficSetting,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake UpstreamTrafficSetting policies
+			for _, setting := range tc.allSettings {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().UpstreamTrafficSettings(setting.Namespace).Create(context.TODO(), setting, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.GetUpstreamTrafficSetting(tc.upstreamSvc)
+			assert.Equal(tc.expectedTrafficSettings, actual)
+		})
+	}
+}
+
+func TestGetRateLimitPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	rateLimit := &policyV1alpha1.RateLimit{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-ratelimit",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.RateLimitSpec{
+			Host: "s1.test.svc.cluster.local",
+			Local: &policyV1alpha1.LocalRateLimitSpec{
+				TCP: &policyV1alpha1.TCPLocalRateLimitSpec{
+					Connections: 10,
+					Unit:        "minute",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		allRateLimits     []*policyV1alpha1.RateLimit
+		svc               service.MeshService
+		expectedRateLimit *policyV1alpha1.RateLimit
+	}{
+		{
+			name:              "matching RateLimit policy not found for service test/s2",
+			allRateLimits:     []*policyV1alpha1.RateLimit{rateLimit},
+			svc:               service.MeshService{Name: "s2", Namespace: "test"},
+			expectedRateLimit: nil,
+		},
+		{
+			name: "RateLimit policy in a different namespace than the service is ignored",
+			allRateLimits: []*policyV1alpha1.RateLimit{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "s1-ratelimit",
+						Namespace: "test",
+					},
+					Spec: policyV1alpha1.RateLimitSpec{
+						Host: "s1.other.svc.cluster.local",
+					},
+				},
+			},
+			svc:               service.MeshService{Name: "s1", Namespace: "other"},
+			expectedRateLimit: nil,
+		},
+		{
+			name:              "matching RateLimit policy found for service test/s1",
+			allRateLimits:     []*policyV1alpha1.RateLimit{rateLimit},
+			svc:               service.MeshService{Name: "s1", Namespace: "test"},
+			expectedRateLimit: rateLimit,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake RateLimit policies
+			for _, rl := range tc.allRateLimits {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().RateLimits(rl.Namespace).Create(context.TODO(), rl, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.GetRateLimitPolicy(tc.svc)
+			assert.Equal(tc.expectedRateLimit, actual)
+		})
+	}
+}
+
+func TestListFaultInjectionPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	delayFault := &policyV1alpha1.FaultInjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-delay",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.FaultInjectionSpec{
+			Host: "s1.test.svc.cluster.local",
+			Delay: &policyV1alpha1.FaultDelaySpec{
+				Duration:   metav1.Duration{Duration: 5 * time.Second},
+				Percentage: 50,
+			},
+		},
+	}
+	abortFault := &policyV1alpha1.FaultInjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s2-abort",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.FaultInjectionSpec{
+			Host: "s2.test.svc.cluster.local",
+			Abort: &policyV1alpha1.FaultAbortSpec{
+				HTTPStatus: 503,
+				Percentage: 10,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		allFaultInjections      []*policyV1alpha1.FaultInjection
+		upstreamSvc             service.MeshService
+		expectedFaultInjections []*policyV1alpha1.FaultInjection
+	}{
+		{
+			name:                    "matching FaultInjection policy not found for service test/s3",
+			allFaultInjections:      []*policyV1alpha1.FaultInjection{delayFault, abortFault},
+			upstreamSvc:             service.MeshService{Name: "s3", Namespace: "test"},
+			expectedFaultInjections: nil,
+		},
+		{
+			name:                    "matching FaultInjection policy found for service test/s2",
+			allFaultInjections:      []*policyV1alpha1.FaultInjection{delayFault, abortFault},
+			upstreamSvc:             service.MeshService{Name: "s2", Namespace: "test"},
+			expectedFaultInjections: []*policyV1alpha1.FaultInjection{abortFault},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake FaultInjection policies
+			for _, fault := range tc.allFaultInjections {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().FaultInjections(fault.Namespace).Create(context.TODO(), fault, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.ListFaultInjectionPolicies(tc.upstreamSvc)
+			assert.ElementsMatch(tc.expectedFaultInjections, actual)
+		})
+	}
+}
+
+func TestGetHeaderModifierPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	headerModifier := &policyV1alpha1.HeaderModifier{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-headers",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.HeaderModifierSpec{
+			Host: "s1.test.svc.cluster.local",
+			Outbound: &policyV1alpha1.HTTPHeaderModifierSpec{
+				RequestHeadersToSet: []policyV1alpha1.HTTPHeaderSpec{{Name: "x-tenant", Value: "t1"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		allHeaderModifiers     []*policyV1alpha1.HeaderModifier
+		svc                    service.MeshService
+		expectedHeaderModifier *policyV1alpha1.HeaderModifier
+	}{
+		{
+			name:                   "matching HeaderModifier policy not found for service test/s2",
+			allHeaderModifiers:     []*policyV1alpha1.HeaderModifier{headerModifier},
+			svc:                    service.MeshService{Name: "s2", Namespace: "test"},
+			expectedHeaderModifier: nil,
+		},
+		{
+			name:                   "matching HeaderModifier policy found for service test/s1",
+			allHeaderModifiers:     []*policyV1alpha1.HeaderModifier{headerModifier},
+			svc:                    service.MeshService{Name: "s1", Namespace: "test"},
+			expectedHeaderModifier: headerModifier,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake HeaderModifier policies
+			for _, hm := range tc.allHeaderModifiers {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().HeaderModifiers(hm.Namespace).Create(context.TODO(), hm, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.GetHeaderModifierPolicy(tc.svc)
+			assert.Equal(tc.expectedHeaderModifier, actual)
+		})
+	}
+}
+
+func TestTrafficMirrorPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	trafficMirror := &policyV1alpha1.TrafficMirror{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-mirror",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.TrafficMirrorSpec{
+			Host:       "s1.test.svc.cluster.local",
+			Backend:    "s1-v2",
+			Percentage: 10,
+		},
+	}
+
+	testCases := []struct {
+		name                             string
+		allTrafficMirrors                []*policyV1alpha1.TrafficMirror
+		svc                              service.MeshService
+		expectedTrafficMirror            *policyV1alpha1.TrafficMirror
+		expectedTrafficMirrorsForBackend []*policyV1alpha1.TrafficMirror
+	}{
+		{
+			name:                             "no TrafficMirror policy for service test/s2",
+			allTrafficMirrors:                []*policyV1alpha1.TrafficMirror{trafficMirror},
+			svc:                              service.MeshService{Name: "s2", Namespace: "test"},
+			expectedTrafficMirror:            nil,
+			expectedTrafficMirrorsForBackend: nil,
+		},
+		{
+			name:                             "TrafficMirror policy found for root service test/s1",
+			allTrafficMirrors:                []*policyV1alpha1.TrafficMirror{trafficMirror},
+			svc:                              service.MeshService{Name: "s1", Namespace: "test"},
+			expectedTrafficMirror:            trafficMirror,
+			expectedTrafficMirrorsForBackend: nil,
+		},
+		{
+			name:                             "TrafficMirror policy found for backend service test/s1-v2",
+			allTrafficMirrors:                []*policyV1alpha1.TrafficMirror{trafficMirror},
+			svc:                              service.MeshService{Name: "s1-v2", Namespace: "test"},
+			expectedTrafficMirror:            nil,
+			expectedTrafficMirrorsForBackend: []*policyV1alpha1.TrafficMirror{trafficMirror},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake TrafficMirror policies
+			for _, tm := range tc.allTrafficMirrors {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().TrafficMirrors(tm.Namespace).Create(context.TODO(), tm, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			assert.Equal(tc.expectedTrafficMirror, policyClient.GetTrafficMirrorPolicy(tc.svc))
+			assert.Equal(tc.expectedTrafficMirrorsForBackend, policyClient.ListTrafficMirrorPoliciesForBackend(tc.svc))
+		})
+	}
+}
+
+func TestListUpstreamTrafficSettings(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("unmonitored").Return(false).AnyTimes()
+
+	stop := make(chan struct{})
+
+	monitoredSetting := &policyV1alpha1.UpstreamTrafficSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-setting",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.UpstreamTrafficSettingSpec{
+			Host: "s1.test.svc.cluster.local",
+		},
+	}
+	unmonitoredSetting := &policyV1alpha1.UpstreamTrafficSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s2-setting",
+			Namespace: "unmonitored",
+		},
+		Spec: policyV1alpha1.UpstreamTrafficSettingSpec{
+			Host: "s2.unmonitored.svc.cluster.local",
+		},
+	}
+
+	fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+	for _, setting := range []*policyV1alpha1.UpstreamTrafficSetting{monitoredSetting, unmonitoredSetting} {
+		_, err := fakepolicyClientSet.PolicyV1alpha1().UpstreamTrafficSettings(setting.Namespace).Create(context.TODO(), setting, metav1.CreateOptions{})
+		assert.Nil(err)
+	}
+
+	policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+	assert.Nil(err)
+	assert.NotNil(policyClient)
+
+	actual := policyClient.ListUpstreamTrafficSettings()
+	assert.ElementsMatch([]*policyV1alpha1.UpstreamTrafficSetting{monitoredSetting}, actual)
+}
+
+func TestGRPCRouteGroups(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("unmonitored").Return(false).AnyTimes()
+
+	stop := make(chan struct{})
+
+	monitoredRouteGroup := &policyV1alpha1.GRPCRouteGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "greeter-routes",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.GRPCRouteGroupSpec{
+			Matches: []policyV1alpha1.GRPCMatch{
+				{Name: "say-hello", Service: "helloworld.Greeter", Method: "SayHello"},
+			},
+		},
+	}
+	unmonitoredRouteGroup := &policyV1alpha1.GRPCRouteGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "greeter-routes",
+			Namespace: "unmonitored",
+		},
+		Spec: policyV1alpha1.GRPCRouteGroupSpec{
+			Matches: []policyV1alpha1.GRPCMatch{
+				{Name: "all", Service: "helloworld.Greeter"},
+			},
+		},
+	}
+
+	fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+	for _, routeGroup := range []*policyV1alpha1.GRPCRouteGroup{monitoredRouteGroup, unmonitoredRouteGroup} {
+		_, err := fakepolicyClientSet.PolicyV1alpha1().GRPCRouteGroups(routeGroup.Namespace).Create(context.TODO(), routeGroup, metav1.CreateOptions{})
+		assert.Nil(err)
+	}
+
+	policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+	assert.Nil(err)
+	assert.NotNil(policyClient)
+
+	assert.ElementsMatch([]*policyV1alpha1.GRPCRouteGroup{monitoredRouteGroup}, policyClient.ListGRPCRouteGroups())
+	assert.Equal(monitoredRouteGroup, policyClient.GetGRPCRouteGroup("test/greeter-routes"))
+	assert.Nil(policyClient.GetGRPCRouteGroup("unmonitored/greeter-routes"))
+	assert.Nil(policyClient.GetGRPCRouteGroup("test/unknown"))
+}
+
+func TestGetCORSPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	corsPolicy := &policyV1alpha1.CORSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-cors",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.CORSPolicySpec{
+			Host:         "s1.test.svc.cluster.local",
+			AllowOrigins: []string{"https://example.com"},
+			AllowMethods: []string{"GET", "POST"},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		allCORSPolicies    []*policyV1alpha1.CORSPolicy
+		svc                service.MeshService
+		expectedCORSPolicy *policyV1alpha1.CORSPolicy
+	}{
+		{
+			name:               "matching CORSPolicy policy not found for service test/s2",
+			allCORSPolicies:    []*policyV1alpha1.CORSPolicy{corsPolicy},
+			svc:                service.MeshService{Name: "s2", Namespace: "test"},
+			expectedCORSPolicy: nil,
+		},
+		{
+			name:               "matching CORSPolicy policy found for service test/s1",
+			allCORSPolicies:    []*policyV1alpha1.CORSPolicy{corsPolicy},
+			svc:                service.MeshService{Name: "s1", Namespace: "test"},
+			expectedCORSPolicy: corsPolicy,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake CORSPolicy policies
+			for _, cp := range tc.allCORSPolicies {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().CORSPolicies(cp.Namespace).Create(context.TODO(), cp, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.GetCORSPolicy(tc.svc)
+			assert.Equal(tc.expectedCORSPolicy, actual)
+		})
+	}
+}
+
+func TestGetRequestAuthenticationPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("other").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	requestAuthn := &policyV1alpha1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-jwt",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.RequestAuthenticationSpec{
+			Host: "s1.test.svc.cluster.local",
+			JWTRules: []policyV1alpha1.JWTRule{
+				{
+					Issuer:  "https://issuer.example.com",
+					JWKSURI: "https://issuer.example.com/.well-known/jwks.json",
+				},
+			},
+		},
+	}
+
+	otherNamespaceRequestAuthn := &policyV1alpha1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-jwt",
+			Namespace: "other",
+		},
+		Spec: policyV1alpha1.RequestAuthenticationSpec{
+			Host: "s1.test.svc.cluster.local",
+		},
+	}
+
+	testCases := []struct {
+		name                      string
+		allRequestAuthentications []*policyV1alpha1.RequestAuthentication
+		svc                       service.MeshService
+		expectedRequestAuthn      *policyV1alpha1.RequestAuthentication
+	}{
+		{
+			name:                      "matching RequestAuthentication policy not found for service test/s2",
+			allRequestAuthentications: []*policyV1alpha1.RequestAuthentication{requestAuthn},
+			svc:                       service.MeshService{Name: "s2", Namespace: "test"},
+			expectedRequestAuthn:      nil,
+		},
+		{
+			name:                      "RequestAuthentication policy in a different namespace does not apply to service test/s1",
+			allRequestAuthentications: []*policyV1alpha1.RequestAuthentication{otherNamespaceRequestAuthn},
+			svc:                       service.MeshService{Name: "s1", Namespace: "test"},
+			expectedRequestAuthn:      nil,
+		},
+		{
+			name:                      "matching RequestAuthentication policy found for service test/s1",
+			allRequestAuthentications: []*policyV1alpha1.RequestAuthentication{otherNamespaceRequestAuthn, requestAuthn},
+			svc:                       service.MeshService{Name: "s1", Namespace: "test"},
+			expectedRequestAuthn:      requestAuthn,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake RequestAuthentication policies
+			for _, ra := range tc.allRequestAuthentications {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().RequestAuthentications(ra.Namespace).Create(context.TODO(), ra, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.GetRequestAuthenticationPolicy(tc.svc)
+			assert.Equal(tc.expectedRequestAuthn, actual)
+		})
+	}
+}
+
+func TestGetAuthorizationPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	authzPolicy := &policyV1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s1-authz",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.AuthorizationPolicySpec{
+			Host: "s1.test.svc.cluster.local",
+			Rules: []policyV1alpha1.AuthorizationRule{
+				{
+					When: policyV1alpha1.AuthorizationConditions{
+						SourceIPBlocks: []string{"10.0.0.0/16"},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		allAuthzPolicies    []*policyV1alpha1.AuthorizationPolicy
+		svc                 service.MeshService
+		expectedAuthzPolicy *policyV1alpha1.AuthorizationPolicy
+	}{
+		{
+			name:                "matching AuthorizationPolicy policy not found for service test/s2",
+			allAuthzPolicies:    []*policyV1alpha1.AuthorizationPolicy{authzPolicy},
+			svc:                 service.MeshService{Name: "s2", Namespace: "test"},
+			expectedAuthzPolicy: nil,
+		},
+		{
+			name:                "matching AuthorizationPolicy policy found for service test/s1",
+			allAuthzPolicies:    []*policyV1alpha1.AuthorizationPolicy{authzPolicy},
+			svc:                 service.MeshService{Name: "s1", Namespace: "test"},
+			expectedAuthzPolicy: authzPolicy,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake AuthorizationPolicy policies
+			for _, ap := range tc.allAuthzPolicies {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().AuthorizationPolicies(ap.Namespace).Create(context.TODO(), ap, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.GetAuthorizationPolicy(tc.svc)
+			assert.Equal(tc.expectedAuthzPolicy, actual)
+		})
+	}
+}
+
+func TestListDenyPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("other").Return(true).AnyTimes()
+
+	stop := make(chan struct{})
+
+	denySources := []policyV1alpha1.DenySourceSpec{{Kind: "Namespace", Name: "untrusted"}}
+	namespaceDeny := &policyV1alpha1.Deny{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all-sa", Namespace: "test"},
+		Spec:       policyV1alpha1.DenySpec{Sources: denySources},
+	}
+	serviceAccountDeny := &policyV1alpha1.Deny{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-sa-1", Namespace: "test"},
+		Spec:       policyV1alpha1.DenySpec{ServiceAccount: "sa-1", Sources: denySources},
+	}
+	otherNamespaceDeny := &policyV1alpha1.Deny{
+		ObjectMeta: metav1.ObjectMeta{Name: "deny-all-sa", Namespace: "other"},
+		Spec:       policyV1alpha1.DenySpec{Sources: denySources},
+	}
+	allDenies := []*policyV1alpha1.Deny{namespaceDeny, serviceAccountDeny, otherNamespaceDeny}
+
+	testCases := []struct {
+		name           string
+		destination    identity.K8sServiceAccount
+		expectedDenies []*policyV1alpha1.Deny
+	}{
+		{
+			name:           "Deny policies for the namespace and the service account test/sa-1",
+			destination:    identity.K8sServiceAccount{Name: "sa-1", Namespace: "test"},
+			expectedDenies: []*policyV1alpha1.Deny{namespaceDeny, serviceAccountDeny},
+		},
+		{
+			name:           "Deny policies for the namespace of the service account test/sa-2",
+			destination:    identity.K8sServiceAccount{Name: "sa-2", Namespace: "test"},
+			expectedDenies: []*policyV1alpha1.Deny{namespaceDeny},
+		},
+		{
+			name:           "no Deny policies for the service account unknown/sa-1",
+			destination:    identity.K8sServiceAccount{Name: "sa-1", Namespace: "unknown"},
+			expectedDenies: nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("Running test case %d: %s", i, tc.name), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			fakepolicyClientSet := fakePolicyClient.NewSimpleClientset()
+
+			// Create fake Deny policies
+			for _, deny := range allDenies {
+				_, err := fakepolicyClientSet.PolicyV1alpha1().Denies(deny.Namespace).Create(context.TODO(), deny, metav1.CreateOptions{})
+				assert.Nil(err)
+			}
+
+			policyClient, err := newPolicyClient(fakepolicyClientSet, mockKubeController, stop)
+			assert.Nil(err)
+			assert.NotNil(policyClient)
+
+			actual := policyClient.ListDenyPolicies(tc.destination)
+			assert.ElementsMatch(tc.expectedDenies, actual)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	identity "github.com/openservicemesh/osm/pkg/identity"
+	service "github.com/openservicemesh/osm/pkg/service"
 )
 
 // MockController is a mock of Controller interface
@@ -35,6 +36,132 @@ func (m *MockController) EXPECT() *MockControllerMockRecorder {
 	return m.recorder
 }
 
+// GetAuthorizationPolicy mocks base method
+func (m *MockController) GetAuthorizationPolicy(arg0 service.MeshService) *v1alpha1.AuthorizationPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorizationPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.AuthorizationPolicy)
+	return ret0
+}
+
+// GetAuthorizationPolicy indicates an expected call of GetAuthorizationPolicy
+func (mr *MockControllerMockRecorder) GetAuthorizationPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationPolicy", reflect.TypeOf((*MockController)(nil).GetAuthorizationPolicy), arg0)
+}
+
+// GetCORSPolicy mocks base method
+func (m *MockController) GetCORSPolicy(arg0 service.MeshService) *v1alpha1.CORSPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCORSPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.CORSPolicy)
+	return ret0
+}
+
+// GetCORSPolicy indicates an expected call of GetCORSPolicy
+func (mr *MockControllerMockRecorder) GetCORSPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCORSPolicy", reflect.TypeOf((*MockController)(nil).GetCORSPolicy), arg0)
+}
+
+// GetGRPCRouteGroup mocks base method
+func (m *MockController) GetGRPCRouteGroup(arg0 string) *v1alpha1.GRPCRouteGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGRPCRouteGroup", arg0)
+	ret0, _ := ret[0].(*v1alpha1.GRPCRouteGroup)
+	return ret0
+}
+
+// GetGRPCRouteGroup indicates an expected call of GetGRPCRouteGroup
+func (mr *MockControllerMockRecorder) GetGRPCRouteGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGRPCRouteGroup", reflect.TypeOf((*MockController)(nil).GetGRPCRouteGroup), arg0)
+}
+
+// GetHeaderModifierPolicy mocks base method
+func (m *MockController) GetHeaderModifierPolicy(arg0 service.MeshService) *v1alpha1.HeaderModifier {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeaderModifierPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.HeaderModifier)
+	return ret0
+}
+
+// GetHeaderModifierPolicy indicates an expected call of GetHeaderModifierPolicy
+func (mr *MockControllerMockRecorder) GetHeaderModifierPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaderModifierPolicy", reflect.TypeOf((*MockController)(nil).GetHeaderModifierPolicy), arg0)
+}
+
+// GetRateLimitPolicy mocks base method
+func (m *MockController) GetRateLimitPolicy(arg0 service.MeshService) *v1alpha1.RateLimit {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.RateLimit)
+	return ret0
+}
+
+// GetRateLimitPolicy indicates an expected call of GetRateLimitPolicy
+func (mr *MockControllerMockRecorder) GetRateLimitPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitPolicy", reflect.TypeOf((*MockController)(nil).GetRateLimitPolicy), arg0)
+}
+
+// GetRequestAuthenticationPolicy mocks base method
+func (m *MockController) GetRequestAuthenticationPolicy(arg0 service.MeshService) *v1alpha1.RequestAuthentication {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthenticationPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.RequestAuthentication)
+	return ret0
+}
+
+// GetRequestAuthenticationPolicy indicates an expected call of GetRequestAuthenticationPolicy
+func (mr *MockControllerMockRecorder) GetRequestAuthenticationPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthenticationPolicy", reflect.TypeOf((*MockController)(nil).GetRequestAuthenticationPolicy), arg0)
+}
+
+// GetTrafficMirrorPolicy mocks base method
+func (m *MockController) GetTrafficMirrorPolicy(arg0 service.MeshService) *v1alpha1.TrafficMirror {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrafficMirrorPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.TrafficMirror)
+	return ret0
+}
+
+// GetTrafficMirrorPolicy indicates an expected call of GetTrafficMirrorPolicy
+func (mr *MockControllerMockRecorder) GetTrafficMirrorPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrafficMirrorPolicy", reflect.TypeOf((*MockController)(nil).GetTrafficMirrorPolicy), arg0)
+}
+
+// GetUpstreamTrafficSetting mocks base method
+func (m *MockController) GetUpstreamTrafficSetting(arg0 service.MeshService) *v1alpha1.UpstreamTrafficSetting {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamTrafficSetting", arg0)
+	ret0, _ := ret[0].(*v1alpha1.UpstreamTrafficSetting)
+	return ret0
+}
+
+// GetUpstreamTrafficSetting indicates an expected call of GetUpstreamTrafficSetting
+func (mr *MockControllerMockRecorder) GetUpstreamTrafficSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamTrafficSetting", reflect.TypeOf((*MockController)(nil).GetUpstreamTrafficSetting), arg0)
+}
+
+// ListDenyPolicies mocks base method
+func (m *MockController) ListDenyPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.Deny {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDenyPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.Deny)
+	return ret0
+}
+
+// ListDenyPolicies indicates an expected call of ListDenyPolicies
+func (mr *MockControllerMockRecorder) ListDenyPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDenyPolicies", reflect.TypeOf((*MockController)(nil).ListDenyPolicies), arg0)
+}
+
 // ListEgressPoliciesForSourceIdentity mocks base method
 func (m *MockController) ListEgressPoliciesForSourceIdentity(arg0 identity.K8sServiceAccount) []*v1alpha1.Egress {
 	m.ctrl.T.Helper()
@@ -48,3 +175,73 @@ func (mr *MockControllerMockRecorder) ListEgressPoliciesForSourceIdentity(arg0 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressPoliciesForSourceIdentity", reflect.TypeOf((*MockController)(nil).ListEgressPoliciesForSourceIdentity), arg0)
 }
+
+// ListFaultInjectionPolicies mocks base method
+func (m *MockController) ListFaultInjectionPolicies(arg0 service.MeshService) []*v1alpha1.FaultInjection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFaultInjectionPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.FaultInjection)
+	return ret0
+}
+
+// ListFaultInjectionPolicies indicates an expected call of ListFaultInjectionPolicies
+func (mr *MockControllerMockRecorder) ListFaultInjectionPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFaultInjectionPolicies", reflect.TypeOf((*MockController)(nil).ListFaultInjectionPolicies), arg0)
+}
+
+// ListGRPCRouteGroups mocks base method
+func (m *MockController) ListGRPCRouteGroups() []*v1alpha1.GRPCRouteGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGRPCRouteGroups")
+	ret0, _ := ret[0].([]*v1alpha1.GRPCRouteGroup)
+	return ret0
+}
+
+// ListGRPCRouteGroups indicates an expected call of ListGRPCRouteGroups
+func (mr *MockControllerMockRecorder) ListGRPCRouteGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGRPCRouteGroups", reflect.TypeOf((*MockController)(nil).ListGRPCRouteGroups))
+}
+
+// ListRetryPolicies mocks base method
+func (m *MockController) ListRetryPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.Retry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRetryPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.Retry)
+	return ret0
+}
+
+// ListRetryPolicies indicates an expected call of ListRetryPolicies
+func (mr *MockControllerMockRecorder) ListRetryPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetryPolicies", reflect.TypeOf((*MockController)(nil).ListRetryPolicies), arg0)
+}
+
+// ListTrafficMirrorPoliciesForBackend mocks base method
+func (m *MockController) ListTrafficMirrorPoliciesForBackend(arg0 service.MeshService) []*v1alpha1.TrafficMirror {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrafficMirrorPoliciesForBackend", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.TrafficMirror)
+	return ret0
+}
+
+// ListTrafficMirrorPoliciesForBackend indicates an expected call of ListTrafficMirrorPoliciesForBackend
+func (mr *MockControllerMockRecorder) ListTrafficMirrorPoliciesForBackend(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMirrorPoliciesForBackend", reflect.TypeOf((*MockController)(nil).ListTrafficMirrorPoliciesForBackend), arg0)
+}
+
+// ListUpstreamTrafficSettings mocks base method
+func (m *MockController) ListUpstreamTrafficSettings() []*v1alpha1.UpstreamTrafficSetting {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpstreamTrafficSettings")
+	ret0, _ := ret[0].([]*v1alpha1.UpstreamTrafficSetting)
+	return ret0
+}
+
+// ListUpstreamTrafficSettings indicates an expected call of ListUpstreamTrafficSettings
+func (mr *MockControllerMockRecorder) ListUpstreamTrafficSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpstreamTrafficSettings", reflect.TypeOf((*MockController)(nil).ListUpstreamTrafficSettings))
+}
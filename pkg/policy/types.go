@@ -8,6 +8,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/logger"
+	"github.com/openservicemesh/osm/pkg/service"
 )
 
 var (
@@ -16,12 +17,34 @@ var (
 
 // informerCollection is the type used to represent the collection of informers for the policy.openservicemesh.io API group
 type informerCollection struct {
-	egress cache.SharedIndexInformer
+	egress                 cache.SharedIndexInformer
+	retry                  cache.SharedIndexInformer
+	upstreamTrafficSetting cache.SharedIndexInformer
+	rateLimit              cache.SharedIndexInformer
+	faultInjection         cache.SharedIndexInformer
+	headerModifier         cache.SharedIndexInformer
+	trafficMirror          cache.SharedIndexInformer
+	grpcRouteGroup         cache.SharedIndexInformer
+	corsPolicy             cache.SharedIndexInformer
+	requestAuthentication  cache.SharedIndexInformer
+	authorizationPolicy    cache.SharedIndexInformer
+	deny                   cache.SharedIndexInformer
 }
 
 // cacheCollection is the type used to represent the collection of caches for the policy.openservicemesh.io API group
 type cacheCollection struct {
-	egress cache.Store
+	egress                 cache.Store
+	retry                  cache.Store
+	upstreamTrafficSetting cache.Store
+	rateLimit              cache.Store
+	faultInjection         cache.Store
+	headerModifier         cache.Store
+	trafficMirror          cache.Store
+	grpcRouteGroup         cache.Store
+	corsPolicy             cache.Store
+	requestAuthentication  cache.Store
+	authorizationPolicy    cache.Store
+	deny                   cache.Store
 }
 
 // client is the type used to represent the Kubernetes client for the policy.openservicemesh.io API group
@@ -35,4 +58,46 @@ type client struct {
 type Controller interface {
 	// ListEgressPoliciesForSourceIdentity lists the Egress policies for the given source identity
 	ListEgressPoliciesForSourceIdentity(identity.K8sServiceAccount) []*policyV1alpha1.Egress
+
+	// ListRetryPolicies returns the Retry policies for the given source identity
+	ListRetryPolicies(identity.K8sServiceAccount) []*policyV1alpha1.Retry
+
+	// GetUpstreamTrafficSetting returns the UpstreamTrafficSetting policy for the given upstream service
+	GetUpstreamTrafficSetting(service.MeshService) *policyV1alpha1.UpstreamTrafficSetting
+
+	// ListUpstreamTrafficSettings lists the UpstreamTrafficSetting policies in the monitored namespaces
+	ListUpstreamTrafficSettings() []*policyV1alpha1.UpstreamTrafficSetting
+
+	// GetRateLimitPolicy returns the RateLimit policy for the given service
+	GetRateLimitPolicy(service.MeshService) *policyV1alpha1.RateLimit
+
+	// ListFaultInjectionPolicies lists the FaultInjection policies for the given upstream service
+	ListFaultInjectionPolicies(service.MeshService) []*policyV1alpha1.FaultInjection
+
+	// GetHeaderModifierPolicy returns the HeaderModifier policy for the given service
+	GetHeaderModifierPolicy(service.MeshService) *policyV1alpha1.HeaderModifier
+
+	// GetTrafficMirrorPolicy returns the TrafficMirror policy for the given root service
+	GetTrafficMirrorPolicy(service.MeshService) *policyV1alpha1.TrafficMirror
+
+	// ListTrafficMirrorPoliciesForBackend lists the TrafficMirror policies mirroring requests to the given backend service
+	ListTrafficMirrorPoliciesForBackend(service.MeshService) []*policyV1alpha1.TrafficMirror
+
+	// ListGRPCRouteGroups lists the GRPCRouteGroup resources in the monitored namespaces
+	ListGRPCRouteGroups() []*policyV1alpha1.GRPCRouteGroup
+
+	// GetGRPCRouteGroup returns the GRPCRouteGroup resource with the given namespaced name of the form <namespace>/<name>
+	GetGRPCRouteGroup(string) *policyV1alpha1.GRPCRouteGroup
+
+	// GetCORSPolicy returns the CORSPolicy policy for the given service
+	GetCORSPolicy(service.MeshService) *policyV1alpha1.CORSPolicy
+
+	// GetRequestAuthenticationPolicy returns the RequestAuthentication policy for the given service
+	GetRequestAuthenticationPolicy(service.MeshService) *policyV1alpha1.RequestAuthentication
+
+	// GetAuthorizationPolicy returns the AuthorizationPolicy policy for the given service
+	GetAuthorizationPolicy(service.MeshService) *policyV1alpha1.AuthorizationPolicy
+
+	// ListDenyPolicies lists the Deny policies for the given destination identity
+	ListDenyPolicies(identity.K8sServiceAccount) []*policyV1alpha1.Deny
 }
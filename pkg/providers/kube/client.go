@@ -55,6 +55,10 @@ func (c *Client) ListEndpointsForService(svc service.MeshService) []endpoint.End
 
 	for _, kubernetesEndpoint := range kubernetesEndpoints.Subsets {
 		for _, address := range kubernetesEndpoint.Addresses {
+			var region, zone string
+			if address.NodeName != nil {
+				region, zone = k8s.GetTopologyFromNode(c.kubeController.GetNode(*address.NodeName))
+			}
 			for _, port := range kubernetesEndpoint.Ports {
 				ip := net.ParseIP(address.IP)
 				if ip == nil {
@@ -62,8 +66,10 @@ func (c *Client) ListEndpointsForService(svc service.MeshService) []endpoint.End
 					break
 				}
 				ept := endpoint.Endpoint{
-					IP:   ip,
-					Port: endpoint.Port(port.Port),
+					IP:     ip,
+					Port:   endpoint.Port(port.Port),
+					Region: region,
+					Zone:   zone,
 				}
 				endpoints = append(endpoints, ept)
 			}
@@ -87,6 +87,49 @@ var _ = Describe("Test Kube Client Provider (w/o kubecontroller)", func() {
 		}))
 	})
 
+	It("should set the topology of the endpoints from the labels of their node", func() {
+		nodeName := "node-1"
+		mockKubeController.EXPECT().GetEndpoints(tests.BookbuyerService).Return(&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tests.BookbuyerService.Namespace,
+			},
+			Subsets: []corev1.EndpointSubset{
+				{
+					Addresses: []corev1.EndpointAddress{
+						{
+							IP:       "8.8.8.8",
+							NodeName: &nodeName,
+						},
+					},
+					Ports: []corev1.EndpointPort{
+						{
+							Port: 88,
+						},
+					},
+				},
+			},
+		}, nil)
+		mockKubeController.EXPECT().GetNode(nodeName).Return(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nodeName,
+				Labels: map[string]string{
+					corev1.LabelTopologyRegion: "us-east-1",
+					corev1.LabelTopologyZone:   "us-east-1a",
+				},
+			},
+		})
+		mockConfigurator.EXPECT().GetFeatureFlags().Return(v1alpha1.FeatureFlags{EnableMulticlusterMode: false}).AnyTimes()
+
+		Expect(client.ListEndpointsForService(tests.BookbuyerService)).To(Equal([]endpoint.Endpoint{
+			{
+				IP:     net.IPv4(8, 8, 8, 8),
+				Port:   88,
+				Region: "us-east-1",
+				Zone:   "us-east-1a",
+			},
+		}))
+	})
+
 	It("GetResolvableEndpoints should properly return endpoints based on ClusterIP when set", func() {
 		// If the service has cluster IP, expect the cluster IP + port
 		mockKubeController.EXPECT().GetService(tests.BookbuyerService).Return(&corev1.Service{
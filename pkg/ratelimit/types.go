@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+// GlobalRateLimitConfig implements a generic subset of global rate limiting to configure a remote rate limit service through HttpFilters
+type GlobalRateLimitConfig struct {
+	// Enable enables/disables global rate limiting of inbound traffic.
+	Enable bool
+
+	// Address is the target destination endpoint of the rate limit service.
+	Address string
+
+	// Port is the remote destination port of the rate limit service.
+	Port uint16
+
+	// Domain is the rate limit domain sent to the rate limit service with each request.
+	Domain string
+
+	// Timeout defines the timeout to consider for the rate limit service to reply in time.
+	Timeout time.Duration
+
+	// FailureModeDeny allows specifying if traffic should fail if the rate limit service fails to respond.
+	FailureModeDeny bool
+
+	// Descriptors are the descriptors sent to the rate limit service for each request.
+	Descriptors []v1alpha1.GlobalRateLimitDescriptorSpec
+}
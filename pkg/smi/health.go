@@ -3,7 +3,7 @@ package smi
 import (
 	smiAccess "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
 	smiSpecs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
-	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	smiSplit "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	extensionsClientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 
 	"github.com/openservicemesh/osm/pkg/version"
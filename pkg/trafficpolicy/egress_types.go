@@ -1,5 +1,9 @@
 package trafficpolicy
 
+import (
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
 // EgressTrafficPolicy is the type used to represent the different egress traffic policy configurations
 // applicable to a client of Egress destinations.
 type EgressTrafficPolicy struct {
@@ -78,6 +82,10 @@ type EgressHTTPRouteConfig struct {
 	// RoutingRules defines the list of routes for the Egress HTTP route configuration, and corresponding
 	// rules to be applied to those routes.
 	RoutingRules []*EgressHTTPRoutingRule
+
+	// HeaderModifier defines the header modifications applied to the HTTP requests matching the
+	// Egress HTTP route configuration and their responses.
+	HeaderModifier *policyv1alpha1.HTTPHeaderModifierSpec
 }
 
 // EgressHTTPRoutingRule is the type used to represent an Egress HTTP routing rule with its route and associated permissions
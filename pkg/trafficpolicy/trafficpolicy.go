@@ -8,6 +8,7 @@ import (
 	hashstructure "github.com/mitchellh/hashstructure/v2"
 	"github.com/pkg/errors"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/service"
@@ -79,10 +80,10 @@ func (in *InboundTrafficPolicy) AddRule(route RouteWeightedClusters, allowedServ
 	}
 }
 
-// AddRoute adds a route to an OutboundTrafficPolicy given an HTTP route match and weighted cluster. If a Route with the given HTTP route match
+// AddRoute adds a route to an OutboundTrafficPolicy given an HTTP route match, retry policy and weighted cluster. If a Route with the given HTTP route match
 //	already exists, an error will be returned. If a Route with the given HTTP route match does not exist,
-//	a Route with the given HTTP route match and weighted clusters will be added to the Routes on the OutboundTrafficPolicy
-func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, weightedClusters ...service.WeightedCluster) error {
+//	a Route with the given HTTP route match, retry policy and weighted clusters will be added to the Routes on the OutboundTrafficPolicy
+func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, retryPolicy *policyv1alpha1.RetryPolicySpec, weightedClusters ...service.WeightedCluster) error {
 	wc := mapset.NewSet()
 	for _, c := range weightedClusters {
 		wc.Add(c)
@@ -100,6 +101,7 @@ func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, weight
 	out.Routes = append(out.Routes, &RouteWeightedClusters{
 		HTTPRouteMatch:   httpRouteMatch,
 		WeightedClusters: wc,
+		RetryPolicy:      retryPolicy,
 	})
 	return nil
 }
@@ -119,6 +121,9 @@ func MergeInboundPolicies(allowPartialHostnamesMatch bool, original []*InboundTr
 				if reflect.DeepEqual(or.Hostnames, l.Hostnames) {
 					foundHostnames = true
 					or.Rules = mergeRules(or.Rules, l.Rules)
+					or.RateLimit = mergeRateLimit(or.RateLimit, l.RateLimit)
+					or.HeaderModifier = mergeHeaderModifier(or.HeaderModifier, l.HeaderModifier)
+					or.CORS = mergeCORS(or.CORS, l.CORS)
 				}
 			} else {
 				// If l.Hostnames is a subset of or.Hostnames or vice versa then we need to get a union of the two
@@ -126,6 +131,9 @@ func MergeInboundPolicies(allowPartialHostnamesMatch bool, original []*InboundTr
 					or.Hostnames = hostsUnion
 					foundHostnames = true
 					or.Rules = mergeRules(or.Rules, l.Rules)
+					or.RateLimit = mergeRateLimit(or.RateLimit, l.RateLimit)
+					or.HeaderModifier = mergeHeaderModifier(or.HeaderModifier, l.HeaderModifier)
+					or.CORS = mergeCORS(or.CORS, l.CORS)
 				}
 			}
 		}
@@ -151,6 +159,8 @@ func MergeOutboundPolicies(allowPartialHostnamesMatch bool, original []*Outbound
 					foundHostnames = true
 					mergedRoutes := mergeRoutesWeightedClusters(or.Routes, l.Routes)
 					or.Routes = mergedRoutes
+					or.HeaderModifier = mergeHeaderModifier(or.HeaderModifier, l.HeaderModifier)
+					or.LoadBalancer = mergeLoadBalancer(or.LoadBalancer, l.LoadBalancer)
 				}
 			} else {
 				// If l.Hostnames is a subset of or.Hostnames or vice versa then we need to get a union of the two
@@ -159,6 +169,8 @@ func MergeOutboundPolicies(allowPartialHostnamesMatch bool, original []*Outbound
 					foundHostnames = true
 					mergedRoutes := mergeRoutesWeightedClusters(or.Routes, l.Routes)
 					or.Routes = mergedRoutes
+					or.HeaderModifier = mergeHeaderModifier(or.HeaderModifier, l.HeaderModifier)
+					or.LoadBalancer = mergeLoadBalancer(or.LoadBalancer, l.LoadBalancer)
 				}
 			}
 		}
@@ -169,6 +181,42 @@ func MergeOutboundPolicies(allowPartialHostnamesMatch bool, original []*Outbound
 	return original
 }
 
+// mergeRateLimit returns the rate limit to apply to merged inbound traffic policies.
+// The original rate limit takes precedence over the latest one when both are set.
+func mergeRateLimit(original, latest *policyv1alpha1.RateLimitSpec) *policyv1alpha1.RateLimitSpec {
+	if original != nil {
+		return original
+	}
+	return latest
+}
+
+// mergeHeaderModifier returns the header modifications to apply to merged traffic policies.
+// The original header modifications take precedence over the latest ones when both are set.
+func mergeHeaderModifier(original, latest *policyv1alpha1.HeaderModifierSpec) *policyv1alpha1.HeaderModifierSpec {
+	if original != nil {
+		return original
+	}
+	return latest
+}
+
+// mergeCORS returns the CORS settings to apply to merged inbound traffic policies.
+// The original CORS settings take precedence over the latest ones when both are set.
+func mergeCORS(original, latest *policyv1alpha1.CORSPolicySpec) *policyv1alpha1.CORSPolicySpec {
+	if original != nil {
+		return original
+	}
+	return latest
+}
+
+// mergeLoadBalancer returns the load balancing settings to apply to merged outbound traffic policies.
+// The original load balancing settings take precedence over the latest ones when both are set.
+func mergeLoadBalancer(original, latest *policyv1alpha1.LoadBalancerSpec) *policyv1alpha1.LoadBalancerSpec {
+	if original != nil {
+		return original
+	}
+	return latest
+}
+
 // mergeRules merges the give slices of rules such that there is one Rule for a Route with all allowed service accounts listed in the
 //	returned slice of rules
 func mergeRules(originalRules, latestRules []*Rule) []*Rule {
@@ -6,6 +6,7 @@ import (
 	mapset "github.com/deckarep/golang-set"
 	tassert "github.com/stretchr/testify/assert"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/service"
 )
@@ -235,7 +236,7 @@ func TestAddRoute(t *testing.T) {
 			assert := tassert.New(t)
 
 			outboundPolicy := newTestOutboundPolicy(tc.name, tc.existingRoutes)
-			err := outboundPolicy.AddRoute(tc.givenRouteMatch, tc.givenWeightedClusters...)
+			err := outboundPolicy.AddRoute(tc.givenRouteMatch, nil, tc.givenWeightedClusters...)
 			if tc.expectedErr {
 				assert.NotNil(err)
 			} else {
@@ -429,6 +430,54 @@ func TestMergeInboundPoliciesWithPartialHostnames(t *testing.T) {
 	}
 }
 
+func TestMergeRateLimit(t *testing.T) {
+	assert := tassert.New(t)
+
+	original := &policyv1alpha1.RateLimitSpec{Host: "s1.ns1.svc.cluster.local"}
+	latest := &policyv1alpha1.RateLimitSpec{Host: "s2.ns1.svc.cluster.local"}
+
+	assert.Equal(original, mergeRateLimit(original, latest))
+	assert.Equal(original, mergeRateLimit(original, nil))
+	assert.Equal(latest, mergeRateLimit(nil, latest))
+	assert.Nil(mergeRateLimit(nil, nil))
+}
+
+func TestMergeHeaderModifier(t *testing.T) {
+	assert := tassert.New(t)
+
+	original := &policyv1alpha1.HeaderModifierSpec{Host: "s1.ns1.svc.cluster.local"}
+	latest := &policyv1alpha1.HeaderModifierSpec{Host: "s2.ns1.svc.cluster.local"}
+
+	assert.Equal(original, mergeHeaderModifier(original, latest))
+	assert.Equal(original, mergeHeaderModifier(original, nil))
+	assert.Equal(latest, mergeHeaderModifier(nil, latest))
+	assert.Nil(mergeHeaderModifier(nil, nil))
+}
+
+func TestMergeCORS(t *testing.T) {
+	assert := tassert.New(t)
+
+	original := &policyv1alpha1.CORSPolicySpec{Host: "s1.ns1.svc.cluster.local"}
+	latest := &policyv1alpha1.CORSPolicySpec{Host: "s2.ns1.svc.cluster.local"}
+
+	assert.Equal(original, mergeCORS(original, latest))
+	assert.Equal(original, mergeCORS(original, nil))
+	assert.Equal(latest, mergeCORS(nil, latest))
+	assert.Nil(mergeCORS(nil, nil))
+}
+
+func TestMergeLoadBalancer(t *testing.T) {
+	assert := tassert.New(t)
+
+	original := &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.RingHashLoadBalancer}
+	latest := &policyv1alpha1.LoadBalancerSpec{Type: policyv1alpha1.MaglevLoadBalancer}
+
+	assert.Equal(original, mergeLoadBalancer(original, latest))
+	assert.Equal(original, mergeLoadBalancer(original, nil))
+	assert.Equal(latest, mergeLoadBalancer(nil, latest))
+	assert.Nil(mergeLoadBalancer(nil, nil))
+}
+
 func TestMergeRules(t *testing.T) {
 	testCases := []struct {
 		name          string
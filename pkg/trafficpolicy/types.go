@@ -3,9 +3,13 @@
 package trafficpolicy
 
 import (
+	"time"
+
 	mapset "github.com/deckarep/golang-set"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/service"
 )
 
 // TrafficSpecName is the namespaced name of the SMI TrafficSpec
@@ -28,12 +32,65 @@ const (
 	PathMatchPrefix PathMatchType = iota
 )
 
-// HTTPRouteMatch is a struct to represent an HTTP route match comprised of an HTTP path, path matching type, methods, and headers
+// QueryParamMatchType is the type used to represent the matching type of a query parameter
+type QueryParamMatchType int
+
+const (
+	// QueryParamMatchExact is the type used to specify exact matching of the query parameter value
+	QueryParamMatchExact QueryParamMatchType = iota
+
+	// QueryParamMatchRegex is the type used to specify regex based matching of the query parameter value
+	QueryParamMatchRegex QueryParamMatchType = iota
+
+	// QueryParamMatchPresent is the type used to specify matching the presence of the query parameter regardless of its value
+	QueryParamMatchPresent QueryParamMatchType = iota
+)
+
+// QueryParamMatch is a struct to represent a match on a query parameter of the request
+type QueryParamMatch struct {
+	Name      string              `json:"name:omitempty"`
+	Value     string              `json:"value:omitempty"`
+	MatchType QueryParamMatchType `json:"match_type:omitempty"`
+}
+
+// HTTPRouteMatch is a struct to represent an HTTP route match comprised of an HTTP path, path matching type, methods, headers
+// and query parameters, along with the optional request and idle timeouts, and the optional rewrite or redirect for the matched route
 type HTTPRouteMatch struct {
-	Path          string            `json:"path:omitempty"`
-	PathMatchType PathMatchType     `json:"path_match_type:omitempty"`
-	Methods       []string          `json:"methods:omitempty"`
-	Headers       map[string]string `json:"headers:omitempty"`
+	Path          string             `json:"path:omitempty"`
+	PathMatchType PathMatchType      `json:"path_match_type:omitempty"`
+	Methods       []string           `json:"methods:omitempty"`
+	Headers       map[string]string  `json:"headers:omitempty"`
+	QueryParams   []QueryParamMatch  `json:"query_params:omitempty"`
+	Timeout       *time.Duration     `json:"timeout:omitempty"`
+	IdleTimeout   *time.Duration     `json:"idle_timeout:omitempty"`
+	Rewrite       *HTTPRouteRewrite  `json:"rewrite:omitempty"`
+	Redirect      *HTTPRouteRedirect `json:"redirect:omitempty"`
+}
+
+// HTTPRouteRewrite is a struct to represent the rewrite of the path and host of the requests matching an HTTP route
+// before they are forwarded. At most one of PathPrefix or PathRegex can be specified.
+type HTTPRouteRewrite struct {
+	// PathPrefix replaces the prefix of the path matched by the route. For regex path matches,
+	// the literal prefix of the path regex is replaced.
+	PathPrefix string `json:"path_prefix:omitempty"`
+
+	// PathRegex is the pattern of the path to replace with PathRegexSubstitution
+	PathRegex             string `json:"path_regex:omitempty"`
+	PathRegexSubstitution string `json:"path_regex_substitution:omitempty"`
+
+	// Host replaces the host/authority header
+	Host string `json:"host:omitempty"`
+}
+
+// HTTPRouteRedirect is a struct to represent the redirect returned for the requests matching an HTTP route
+// instead of forwarding them. At most one of Path or PathPrefix can be specified.
+type HTTPRouteRedirect struct {
+	Scheme       string `json:"scheme:omitempty"`
+	Host         string `json:"host:omitempty"`
+	Port         uint32 `json:"port:omitempty"`
+	Path         string `json:"path:omitempty"`
+	PathPrefix   string `json:"path_prefix:omitempty"`
+	ResponseCode uint32 `json:"response_code:omitempty"`
 }
 
 // TCPRouteMatch is a struct to represent a TCP route matching based on ports
@@ -41,30 +98,57 @@ type TCPRouteMatch struct {
 	Ports []int `json:"ports:omitempty"`
 }
 
-// RouteWeightedClusters is a struct of an HTTPRoute, associated weighted clusters and the domains
+// RouteWeightedClusters is a struct of an HTTPRoute, associated weighted clusters and the domains,
+// along with the retry policy, the faults injected into the requests on the route and the mirroring of the requests
 type RouteWeightedClusters struct {
-	HTTPRouteMatch   HTTPRouteMatch `json:"http_route_match:omitempty"`
-	WeightedClusters mapset.Set     `json:"weighted_clusters:omitempty"`
+	HTTPRouteMatch   HTTPRouteMatch                     `json:"http_route_match:omitempty"`
+	WeightedClusters mapset.Set                         `json:"weighted_clusters:omitempty"`
+	RetryPolicy      *policyv1alpha1.RetryPolicySpec    `json:"retry_policy:omitempty"`
+	FaultInjection   *policyv1alpha1.FaultInjectionSpec `json:"fault_injection:omitempty"`
+	Mirror           *RequestMirror                     `json:"mirror:omitempty"`
+}
+
+// RequestMirror is a struct to represent the mirroring of a percentage of the requests on a route to a cluster.
+// The responses to the mirrored requests are discarded.
+type RequestMirror struct {
+	ClusterName service.ClusterName `json:"cluster_name:omitempty"`
+	Percentage  uint32              `json:"percentage:omitempty"`
 }
 
-// InboundTrafficPolicy is a struct that associates incoming traffic on a set of Hostnames with a list of Rules
+// InboundTrafficPolicy is a struct that associates incoming traffic on a set of Hostnames with a list of Rules,
+// the rate limits, the header modifications and the CORS settings applied to the incoming traffic
 type InboundTrafficPolicy struct {
-	Name      string   `json:"name:omitempty"`
-	Hostnames []string `json:"hostnames"`
-	Rules     []*Rule  `json:"rules:omitempty"`
+	Name           string                             `json:"name:omitempty"`
+	Hostnames      []string                           `json:"hostnames"`
+	Rules          []*Rule                            `json:"rules:omitempty"`
+	RateLimit      *policyv1alpha1.RateLimitSpec      `json:"rate_limit:omitempty"`
+	HeaderModifier *policyv1alpha1.HeaderModifierSpec `json:"header_modifier:omitempty"`
+	CORS           *policyv1alpha1.CORSPolicySpec     `json:"cors:omitempty"`
 }
 
 // Rule is a struct that represents which Service Accounts can access a Route
 type Rule struct {
 	Route                  RouteWeightedClusters `json:"route:omitempty"`
 	AllowedServiceAccounts mapset.Set            `json:"allowed_service_accounts:omitempty"`
+
+	// AuthorizationConditions are the conditions on the attributes of a request from an allowed service account,
+	// of which at least one must be matched for the request to be authorized. Requests are authorized based on
+	// their service account only when no conditions are specified.
+	AuthorizationConditions []policyv1alpha1.AuthorizationConditions `json:"authorization_conditions:omitempty"`
+
+	// ShadowAuthorizationConditions are the authorization conditions evaluated in shadow mode, in addition to the
+	// AuthorizationConditions. Requests that would not be authorized are reported without being denied.
+	ShadowAuthorizationConditions []policyv1alpha1.AuthorizationConditions `json:"shadow_authorization_conditions:omitempty"`
 }
 
-// OutboundTrafficPolicy is a struct that associates a list of Routes with outbound traffic on a set of Hostnames
+// OutboundTrafficPolicy is a struct that associates a list of Routes, the header modifications and the load balancing
+// settings applied to the outbound traffic with outbound traffic on a set of Hostnames
 type OutboundTrafficPolicy struct {
-	Name      string                   `json:"name:omitempty"`
-	Hostnames []string                 `json:"hostnames"`
-	Routes    []*RouteWeightedClusters `json:"routes:omitempty"`
+	Name           string                             `json:"name:omitempty"`
+	Hostnames      []string                           `json:"hostnames"`
+	Routes         []*RouteWeightedClusters           `json:"routes:omitempty"`
+	HeaderModifier *policyv1alpha1.HeaderModifierSpec `json:"header_modifier:omitempty"`
+	LoadBalancer   *policyv1alpha1.LoadBalancerSpec   `json:"load_balancer:omitempty"`
 }
 
 // TrafficTargetWithRoutes is a struct to represent an SMI TrafficTarget resource composed of its associated routes
@@ -73,4 +157,23 @@ type TrafficTargetWithRoutes struct {
 	Destination     identity.ServiceIdentity   `json:"destination:omitempty"`
 	Sources         []identity.ServiceIdentity `json:"sources:omitempty"`
 	TCPRouteMatches []TCPRouteMatch            `json:"tcp_route_matches:omitempty"`
+
+	// Shadow indicates the traffic target is evaluated in shadow mode, without being enforced
+	Shadow bool `json:"shadow:omitempty"`
+}
+
+// DenyRule is a struct to represent a Deny policy applied to the inbound traffic of a service account
+type DenyRule struct {
+	Name string `json:"name:omitempty"`
+
+	// Sources are the service accounts the traffic is denied from
+	Sources []identity.K8sServiceAccount `json:"sources:omitempty"`
+
+	// SourceNamespaces are the namespaces of the service accounts the traffic is denied from.
+	// The traffic is denied from all sources when both Sources and SourceNamespaces are empty.
+	SourceNamespaces []string `json:"source_namespaces:omitempty"`
+
+	// HTTPRouteMatches are the HTTP routes the traffic is denied on.
+	// All the traffic, including non-HTTP traffic, is denied when no HTTP route matches are specified.
+	HTTPRouteMatches []HTTPRouteMatch `json:"http_route_matches:omitempty"`
 }
@@ -35,9 +35,15 @@ func init() {
 		Group:   "config.openservicemesh.io",
 		Version: "v1alpha1",
 	}
+	upstreamTrafficSettingGvk := metav1.GroupVersionKind{
+		Kind:    "UpstreamTrafficSetting",
+		Group:   "policy.openservicemesh.io",
+		Version: "v1alpha1",
+	}
 	RegisterValidator(egressGvk.String(), EgressValidator)
 	RegisterValidator(meshConfigGvk.String(), MeshConfigValidator)
 	RegisterValidator(multiClusterServiceGvk.String(), MeshConfigValidator)
+	RegisterValidator(upstreamTrafficSettingGvk.String(), UpstreamTrafficSettingValidator)
 }
 
 // EgressValidator validates the Egress CRD.
@@ -60,6 +66,47 @@ func EgressValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionR
 	return nil, nil
 }
 
+// UpstreamTrafficSettingValidator validates the UpstreamTrafficSetting CRD.
+func UpstreamTrafficSettingValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	upstreamTrafficSetting := &pv1alpha1.UpstreamTrafficSetting{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(upstreamTrafficSetting); err != nil {
+		return nil, err
+	}
+
+	loadBalancer := upstreamTrafficSetting.Spec.LoadBalancer
+	if loadBalancer == nil {
+		return nil, nil
+	}
+
+	switch loadBalancer.Type {
+	case pv1alpha1.RoundRobinLoadBalancer, pv1alpha1.LeastRequestLoadBalancer, pv1alpha1.RandomLoadBalancer:
+		if loadBalancer.ConsistentHash != nil {
+			return nil, fmt.Errorf("LoadBalancer.ConsistentHash is only supported by the RingHash and Maglev load balancers, got: %s", loadBalancer.Type)
+		}
+
+	case pv1alpha1.RingHashLoadBalancer, pv1alpha1.MaglevLoadBalancer:
+		if consistentHash := loadBalancer.ConsistentHash; consistentHash != nil && consistentHash.Cookie != nil {
+			if len(strings.TrimSpace(consistentHash.Cookie.Name)) == 0 {
+				return nil, fmt.Errorf("LoadBalancer.ConsistentHash.Cookie.Name must be specified")
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("LoadBalancer.Type %s is not valid", loadBalancer.Type)
+	}
+
+	if loadBalancer.LeastRequest != nil {
+		if loadBalancer.Type != pv1alpha1.LeastRequestLoadBalancer {
+			return nil, fmt.Errorf("LoadBalancer.LeastRequest is only supported by the LeastRequest load balancer, got: %s", loadBalancer.Type)
+		}
+		if choiceCount := loadBalancer.LeastRequest.ChoiceCount; choiceCount != nil && *choiceCount < 2 {
+			return nil, fmt.Errorf("LoadBalancer.LeastRequest.ChoiceCount %d is lower than 2", *choiceCount)
+		}
+	}
+
+	return nil, nil
+}
+
 // MeshConfigValidator validates the MeshConfig CRD.
 func MeshConfigValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
 	config := &cv1alpha1.MeshConfig{}
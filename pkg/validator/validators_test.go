@@ -424,3 +424,190 @@ func TestMulticlusterServiceValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestUpstreamTrafficSettingValidator(t *testing.T) {
+	assert := tassert.New(t)
+	testCases := []struct {
+		name    string
+		input   *admissionv1.AdmissionRequest
+		expResp *admissionv1.AdmissionResponse
+		expErr  error
+	}{
+		{
+			name: "UpstreamTrafficSetting without load balancing settings passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local"}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  nil,
+		},
+		{
+			name: "UpstreamTrafficSetting with least request load balancing passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "LeastRequest", "leastRequest": {"choiceCount": 4}}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  nil,
+		},
+		{
+			name: "UpstreamTrafficSetting with consistent hash load balancing passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "RingHash", "consistentHash": {"cookie": {"name": "session"}}}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  nil,
+		},
+		{
+			name: "UpstreamTrafficSetting with unknown load balancer type fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "Sticky"}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  errors.New("LoadBalancer.Type Sticky is not valid"),
+		},
+		{
+			name: "UpstreamTrafficSetting with consistent hash keys for a round robin load balancer fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "RoundRobin", "consistentHash": {"sourceIP": true}}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  errors.New("LoadBalancer.ConsistentHash is only supported by the RingHash and Maglev load balancers, got: RoundRobin"),
+		},
+		{
+			name: "UpstreamTrafficSetting with a hashed cookie without a name fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "Maglev", "consistentHash": {"cookie": {"path": "/"}}}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  errors.New("LoadBalancer.ConsistentHash.Cookie.Name must be specified"),
+		},
+		{
+			name: "UpstreamTrafficSetting with least request settings for a random load balancer fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "Random", "leastRequest": {"choiceCount": 4}}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  errors.New("LoadBalancer.LeastRequest is only supported by the LeastRequest load balancer, got: Random"),
+		},
+		{
+			name: "UpstreamTrafficSetting with a least request choice count lower than 2 fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"spec": {"host": "s1.test.svc.cluster.local", "loadBalancer": {"type": "LeastRequest", "leastRequest": {"choiceCount": 1}}}
+					}
+					`),
+				},
+			},
+			expResp: nil,
+			expErr:  errors.New("LoadBalancer.LeastRequest.ChoiceCount 1 is lower than 2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := UpstreamTrafficSettingValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			assert.Equal(tc.expErr, err)
+		})
+	}
+}